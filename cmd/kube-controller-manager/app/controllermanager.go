@@ -47,6 +47,7 @@ import (
 	"k8s.io/kubernetes/pkg/cloudprovider"
 	"k8s.io/kubernetes/pkg/controller"
 	certcontroller "k8s.io/kubernetes/pkg/controller/certificates"
+	confignotifycontroller "k8s.io/kubernetes/pkg/controller/confignotify"
 	"k8s.io/kubernetes/pkg/controller/cronjob"
 	"k8s.io/kubernetes/pkg/controller/daemon"
 	"k8s.io/kubernetes/pkg/controller/deployment"
@@ -261,6 +262,10 @@ func StartControllers(s *options.CMServer, kubeconfig *restclient.Config, rootCl
 		Run(int(s.ConcurrentEndpointSyncs), wait.NeverStop)
 	time.Sleep(wait.Jitter(s.ControllerStartInterval.Duration, ControllerStartJitter))
 
+	go confignotifycontroller.NewConfigNotifyController(sharedInformers.Pods().Informer(), client("confignotify-controller")).
+		Run(1, wait.NeverStop)
+	time.Sleep(wait.Jitter(s.ControllerStartInterval.Duration, ControllerStartJitter))
+
 	go replicationcontroller.NewReplicationManager(
 		sharedInformers.Pods().Informer(),
 		clientBuilder.ClientOrDie("replication-controller"),
@@ -31,10 +31,11 @@ import (
 )
 
 const (
-	DefaultKubeletPodsDirName       = "pods"
-	DefaultKubeletVolumesDirName    = "volumes"
-	DefaultKubeletPluginsDirName    = "plugins"
-	DefaultKubeletContainersDirName = "containers"
+	DefaultKubeletPodsDirName        = "pods"
+	DefaultKubeletVolumesDirName     = "volumes"
+	DefaultKubeletPluginsDirName     = "plugins"
+	DefaultKubeletContainersDirName  = "containers"
+	DefaultKubeletCheckpointsDirName = "checkpoints"
 )
 
 // KubeletServer encapsulates all of the parameters necessary for starting up
@@ -145,6 +146,9 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&s.HostNetworkSources, "host-network-sources", s.HostNetworkSources, "Comma-separated list of sources from which the Kubelet allows pods to use of host network. [default=\"*\"]")
 	fs.StringSliceVar(&s.HostPIDSources, "host-pid-sources", s.HostPIDSources, "Comma-separated list of sources from which the Kubelet allows pods to use the host pid namespace. [default=\"*\"]")
 	fs.StringSliceVar(&s.HostIPCSources, "host-ipc-sources", s.HostIPCSources, "Comma-separated list of sources from which the Kubelet allows pods to use the host ipc namespace. [default=\"*\"]")
+	fs.StringSliceVar(&s.AllowedNotifySignals, "allowed-notify-signals", s.AllowedNotifySignals, "Comma-separated list of POSIX signal names the notify subresource may deliver to a container without a declared notification handler. [default=\"SIGHUP,SIGUSR1,SIGUSR2\"]")
+	fs.Int32Var(&s.NotifyQPS, "notify-qps", s.NotifyQPS, "If > 0, limit notifications delivered to the containers of a single pod to this value per second. If 0, unlimited.")
+	fs.Int32Var(&s.NotifyBurst, "notify-burst", s.NotifyBurst, "Maximum size of a burst of notifications to a single pod, temporarily allows notifications to burst to this number, while still not exceeding notify-qps. Only used if --notify-qps > 0")
 	fs.Int32Var(&s.RegistryPullQPS, "registry-qps", s.RegistryPullQPS, "If > 0, limit registry pull QPS to this value.  If 0, unlimited. [default=5.0]")
 	fs.Int32Var(&s.RegistryBurst, "registry-burst", s.RegistryBurst, "Maximum size of a bursty pulls, temporarily allows pulls to burst to this number, while still not exceeding registry-qps.  Only used if --registry-qps > 0")
 	fs.Int32Var(&s.EventRecordQPS, "event-qps", s.EventRecordQPS, "If > 0, limit event creations per second to this value. If 0, unlimited.")
@@ -157,6 +161,8 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.MarkDeprecated("maximum-dead-containers-per-container", "Use --eviction-hard or --eviction-soft instead. Will be removed in a future version.")
 	fs.Int32Var(&s.MaxContainerCount, "maximum-dead-containers", s.MaxContainerCount, "Maximum number of old instances of containers to retain globally.  Each container takes up some disk space. To disable, set to a negative number.  Default: -1.")
 	fs.MarkDeprecated("maximum-dead-containers", "Use --eviction-hard or --eviction-soft instead. Will be removed in a future version.")
+	fs.StringVar(&s.ContainerLogMaxSize, "container-log-max-size", s.ContainerLogMaxSize, "Maximum size (e.g. 10Mi) of a container log file before it is rotated. Only used when the kubelet manages the container log files. [default=10Mi]")
+	fs.Int32Var(&s.ContainerLogMaxFiles, "container-log-max-files", s.ContainerLogMaxFiles, "Maximum number of log files that can be present for a container, including the file being written to. Must be >= 2. Only used when the kubelet manages the container log files. [default=5]")
 	fs.Int32Var(&s.CAdvisorPort, "cadvisor-port", s.CAdvisorPort, "The port of the localhost cAdvisor endpoint")
 	fs.Int32Var(&s.HealthzPort, "healthz-port", s.HealthzPort, "The port of the localhost healthz endpoint")
 	fs.Var(componentconfig.IPVar{Val: &s.HealthzBindAddress}, "healthz-bind-address", "The IP address for the healthz server to serve on, defaulting to 127.0.0.1 (set to 0.0.0.0 for all interfaces)")
@@ -231,11 +237,16 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.MarkDeprecated("reconcile-cidr", "The old --configure-cbr0 networking mode has been removed, so this flag has no function anymore. Will be removed in v1.6.")
 	fs.Var(&s.SystemReserved, "system-reserved", "A set of ResourceName=ResourceQuantity (e.g. cpu=200m,memory=150G) pairs that describe resources reserved for non-kubernetes components. Currently only cpu and memory are supported. See http://kubernetes.io/docs/user-guide/compute-resources for more detail. [default=none]")
 	fs.Var(&s.KubeReserved, "kube-reserved", "A set of ResourceName=ResourceQuantity (e.g. cpu=200m,memory=150G) pairs that describe resources reserved for kubernetes system components. Currently only cpu and memory are supported. See http://kubernetes.io/docs/user-guide/compute-resources for more detail. [default=none]")
+	fs.DurationVar(&s.ShutdownGracePeriod.Duration, "shutdown-grace-period", s.ShutdownGracePeriod.Duration, "Total duration the kubelet delays an impending node shutdown to terminate pods gracefully. When zero, graceful node shutdown is disabled. [default=0]")
+	fs.DurationVar(&s.ShutdownGracePeriodCriticalPods.Duration, "shutdown-grace-period-critical-pods", s.ShutdownGracePeriodCriticalPods.Duration, "Portion of --shutdown-grace-period reserved for terminating critical pods after all other pods. Must be smaller than --shutdown-grace-period. [default=0]")
+	fs.StringVar(&s.CPUManagerPolicy, "cpu-manager-policy", s.CPUManagerPolicy, "CPU manager policy to use. 'none' preserves the existing behavior, 'static' pins containers of Guaranteed pods with integral CPU requests to exclusive CPUs. [default=none]")
+	fs.DurationVar(&s.CPUManagerReconcilePeriod.Duration, "cpu-manager-reconcile-period", s.CPUManagerReconcilePeriod.Duration, "Period the CPU manager reconciles the CPU assignments with the container cgroups. [default=10s]")
 	fs.BoolVar(&s.RegisterSchedulable, "register-schedulable", s.RegisterSchedulable, "Register the node as schedulable. Won't have any effect if register-node is false. [default=true]")
 	fs.StringVar(&s.ContentType, "kube-api-content-type", s.ContentType, "Content type of requests sent to apiserver.")
 	fs.Int32Var(&s.KubeAPIQPS, "kube-api-qps", s.KubeAPIQPS, "QPS to use while talking with kubernetes apiserver")
 	fs.Int32Var(&s.KubeAPIBurst, "kube-api-burst", s.KubeAPIBurst, "Burst to use while talking with kubernetes apiserver")
 	fs.BoolVar(&s.SerializeImagePulls, "serialize-image-pulls", s.SerializeImagePulls, "Pull images one at a time. We recommend *not* changing the default value on nodes that run docker daemon with version < 1.9 or an Aufs storage backend. Issue #10959 has more details. [default=true]")
+	fs.Int32Var(&s.MaxParallelImagePulls, "max-parallel-image-pulls", s.MaxParallelImagePulls, "Maximum number of images to pull in parallel. Only used when --serialize-image-pulls=false. [default=5]")
 	fs.DurationVar(&s.OutOfDiskTransitionFrequency.Duration, "outofdisk-transition-frequency", s.OutOfDiskTransitionFrequency.Duration, "Duration for which the kubelet has to wait before transitioning out of out-of-disk node condition status. Default: 5m0s")
 	fs.StringVar(&s.NodeIP, "node-ip", s.NodeIP, "IP address of the node. If set, kubelet will use this IP address for the node")
 	fs.BoolVar(&s.EnableCustomMetrics, "enable-custom-metrics", s.EnableCustomMetrics, "Support for gathering custom metrics.")
@@ -246,6 +257,22 @@ func (s *KubeletServer) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&s.EvictionPressureTransitionPeriod.Duration, "eviction-pressure-transition-period", s.EvictionPressureTransitionPeriod.Duration, "Duration for which the kubelet has to wait before transitioning out of an eviction pressure condition.")
 	fs.Int32Var(&s.EvictionMaxPodGracePeriod, "eviction-max-pod-grace-period", s.EvictionMaxPodGracePeriod, "Maximum allowed grace period (in seconds) to use when terminating pods in response to a soft eviction threshold being met.  If negative, defer to pod specified value.")
 	fs.StringVar(&s.EvictionMinimumReclaim, "eviction-minimum-reclaim", s.EvictionMinimumReclaim, "A set of minimum reclaims (e.g. imagefs.available=2Gi) that describes the minimum amount of resource the kubelet will reclaim when performing a pod eviction if that resource is under pressure.")
+	fs.BoolVar(&s.ExperimentalKernelMemcgNotification, "experimental-kernel-memcg-notification", s.ExperimentalKernelMemcgNotification, "If enabled, the kubelet will integrate with the kernel memcg notification to determine if memory eviction thresholds are crossed rather than polling.")
+	fs.BoolVar(&s.ExperimentalEvictionDryRun, "experimental-eviction-dry-run", s.ExperimentalEvictionDryRun, "If enabled, the eviction manager will evaluate thresholds and rank pods, but only record events about the evictions it would have performed.")
+	fs.DurationVar(&s.EvictionMonitoringPeriod.Duration, "eviction-monitoring-period", s.EvictionMonitoringPeriod.Duration, "Duration the eviction manager waits between monitoring passes when the node is healthy.")
+	fs.StringVar(&s.EvictionEmergencyFloor, "eviction-emergency-floor", s.EvictionEmergencyFloor, "A set of eviction thresholds (e.g. memory.available<100Mi) below which pod safe-to-evict annotations are ignored when selecting eviction victims.")
+	fs.BoolVar(&s.ExperimentalEvictionEvictMultiplePods, "experimental-eviction-evict-multiple-pods", s.ExperimentalEvictionEvictMultiplePods, "If enabled, the eviction manager may evict multiple pods in a single monitoring pass under a hard eviction threshold, until the estimated reclaim relieves pressure.")
+	fs.BoolVar(&s.ExperimentalEvictionAdaptiveMonitoring, "experimental-eviction-adaptive-monitoring", s.ExperimentalEvictionAdaptiveMonitoring, "If enabled, the eviction manager monitors more frequently while an eviction threshold is observed, and relaxes back to the configured monitoring period when the node is healthy.")
+	fs.BoolVar(&s.ExperimentalEvictionHonorPodGracePeriod, "experimental-eviction-honor-pod-grace-period", s.ExperimentalEvictionHonorPodGracePeriod, "If enabled, soft evictions honor the pod's full termination grace period even when it exceeds eviction-max-pod-grace-period.")
+	fs.Int32Var(&s.ExperimentalEvictionCordonThreshold, "experimental-eviction-cordon-threshold", s.ExperimentalEvictionCordonThreshold, "Number of hard eviction threshold crossings within a ten minute window after which the kubelet marks the node unschedulable.  Set to 0 to disable.")
+	fs.StringVar(&s.EvictionFilesystemThresholds, "eviction-filesystem-thresholds", s.EvictionFilesystemThresholds, "A set of per-filesystem eviction thresholds identified by mount path (e.g. /var/log<1Gi), each driving its own pressure condition.")
+	fs.StringVar(&s.EvictionCgroupThresholds, "eviction-cgroup-thresholds", s.EvictionCgroupThresholds, "A set of eviction thresholds scoped to a top-level cgroup (e.g. kubepods<500Mi), so evictions react to pod-attributable memory usage rather than host daemons.")
+	fs.StringVar(&s.ExperimentalEvictionAdmissionPolicy, "experimental-eviction-admission-policy", s.ExperimentalEvictionAdmissionPolicy, "Admission policy applied while the node reports memory pressure.  'best-effort' rejects only BestEffort pods, 'burstable' additionally rejects Burstable pods whose memory request exceeds the memory available, 'all' rejects all non-critical pods.")
+	fs.Int32Var(&s.ExperimentalEvictionInactiveFilePercent, "experimental-eviction-inactive-file-percent", s.ExperimentalEvictionInactiveFilePercent, "Percentage of inactive page cache counted as reclaimable when computing the memory.available eviction signal.  100 preserves the kernel working set semantics.")
+	fs.Int32Var(&s.ExperimentalEvictionMaxPerHour, "experimental-eviction-max-per-hour", s.ExperimentalEvictionMaxPerHour, "Maximum number of pods evicted within any one hour window.  Set to 0 to disable the cap.")
+	fs.StringVar(&s.ExperimentalEvictionSoftNotifyPeriod, "experimental-eviction-soft-notify-period", s.ExperimentalEvictionSoftNotifyPeriod, "A set of eviction notify periods (e.g. memory.available=30s) that correspond to how long before a soft eviction threshold triggers that the likely victim pod is pre-notified so it can shed load.")
+	fs.BoolVar(&s.ExperimentalEvictionSkipStaticPods, "experimental-eviction-skip-static-pods", s.ExperimentalEvictionSkipStaticPods, "When enabled, static pods are excluded from eviction ranking since the kubelet immediately restarts them after eviction.")
+	fs.DurationVar(&s.ExperimentalEvictionStatsStaleness.Duration, "experimental-eviction-stats-staleness", s.ExperimentalEvictionStatsStaleness.Duration, "Maximum age of the stats summary before eviction threshold evaluation is skipped, so pods are not evicted based on stale data.  Set to 0 to disable staleness checking.")
 	fs.Int32Var(&s.PodsPerCore, "pods-per-core", s.PodsPerCore, "Number of Pods per core that can run on this Kubelet. The total number of Pods on this Kubelet cannot exceed max-pods, so max-pods will be used if this calculation results in a larger number of Pods allowed on the Kubelet. A value of 0 disables this limit.")
 	fs.BoolVar(&s.ProtectKernelDefaults, "protect-kernel-defaults", s.ProtectKernelDefaults, "Default kubelet behaviour for kernel tuning. If set, kubelet errors if any of kernel tunables is different than kubelet defaults.")
 
@@ -25,6 +25,7 @@ import (
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/intstr"
 	utillabels "k8s.io/kubernetes/pkg/util/labels"
 	"k8s.io/kubernetes/pkg/util/validation/field"
@@ -42,6 +43,8 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 
 		Convert_Slice_string_To_unversioned_Time,
 
+		Convert_Slice_string_To_types_UID,
+
 		Convert_resource_Quantity_To_resource_Quantity,
 
 		Convert_string_To_labels_Selector,
@@ -189,6 +192,16 @@ func Convert_Slice_string_To_unversioned_Time(input *[]string, out *unversioned.
 	return out.UnmarshalQueryParameter(str)
 }
 
+// Convert_Slice_string_To_types_UID allows converting a URL query parameter value
+func Convert_Slice_string_To_types_UID(input *[]string, out *types.UID, s conversion.Scope) error {
+	if len(*input) > 0 {
+		*out = types.UID((*input)[0])
+	} else {
+		*out = ""
+	}
+	return nil
+}
+
 func Convert_string_To_labels_Selector(in *string, out *labels.Selector, s conversion.Scope) error {
 	selector, err := labels.Parse(*in)
 	if err != nil {
@@ -21278,7 +21278,7 @@ func (x *Container) CodecEncodeSelf(e *codec1978.Encoder) {
 		} else {
 			yysep1535 := !z.EncBinary()
 			yy2arr1535 := z.EncBasicHandle().StructToArray
-			var yyq1535 [18]bool
+			var yyq1535 [19]bool
 			_, _, _ = yysep1535, yyq1535, yy2arr1535
 			const yyr1535 bool = false
 			yyq1535[2] = len(x.Command) != 0
@@ -21296,9 +21296,10 @@ func (x *Container) CodecEncodeSelf(e *codec1978.Encoder) {
 			yyq1535[15] = x.Stdin != false
 			yyq1535[16] = x.StdinOnce != false
 			yyq1535[17] = x.TTY != false
+			yyq1535[18] = len(x.Notifications) != 0
 			var yynn1535 int
 			if yyr1535 || yy2arr1535 {
-				r.EncodeArrayStart(18)
+				r.EncodeArrayStart(19)
 			} else {
 				yynn1535 = 3
 				for _, b := range yyq1535 {
@@ -21755,6 +21756,39 @@ func (x *Container) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
+			if yyr1535 || yy2arr1535 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq1535[18] {
+					if x.Notifications == nil {
+						r.EncodeNil()
+					} else {
+						yym1581 := z.EncBinary()
+						_ = yym1581
+						if false {
+						} else {
+							z.EncFallback(x.Notifications)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq1535[18] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("notifications"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					if x.Notifications == nil {
+						r.EncodeNil()
+					} else {
+						yym1582 := z.EncBinary()
+						_ = yym1582
+						if false {
+						} else {
+							z.EncFallback(x.Notifications)
+						}
+					}
+				}
+			}
 			if yyr1535 || yy2arr1535 {
 				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
 			} else {
@@ -21975,6 +22009,18 @@ func (x *Container) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			} else {
 				x.TTY = bool(r.DecodeBool())
 			}
+		case "notifications":
+			if r.TryDecodeAsNil() {
+				x.Notifications = nil
+			} else {
+				yyv1604 := &x.Notifications
+				yym1605 := z.DecBinary()
+				_ = yym1605
+				if false {
+				} else {
+					z.DecFallback(yyv1604, false)
+				}
+			}
 		default:
 			z.DecStructFieldNotFound(-1, yys1582)
 		} // end switch yys1582
@@ -22328,6 +22374,28 @@ func (x *Container) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.TTY = bool(r.DecodeBool())
 	}
+	yyj1606++
+	if yyhl1606 {
+		yyb1606 = yyj1606 > l
+	} else {
+		yyb1606 = r.CheckBreak()
+	}
+	if yyb1606 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Notifications = nil
+	} else {
+		yyv1628 := &x.Notifications
+		yym1629 := z.DecBinary()
+		_ = yym1629
+		if false {
+		} else {
+			z.DecFallback(yyv1628, false)
+		}
+	}
 	for {
 		yyj1606++
 		if yyhl1606 {
@@ -1287,6 +1287,10 @@ type Container struct {
 	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
 	// +optional
 	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+	// Notifications declares the notifications this container accepts via the pod's
+	// notify subresource and how each one is delivered.
+	// +optional
+	Notifications []ContainerNotification `json:"notifications,omitempty"`
 	// Required.
 	// +optional
 	TerminationMessagePath string `json:"terminationMessagePath,omitempty"`
@@ -1337,6 +1341,53 @@ type Lifecycle struct {
 	PreStop *Handler `json:"preStop,omitempty"`
 }
 
+// SignalAction describes a POSIX signal sent to the container's init process.
+type SignalAction struct {
+	// Signal is the name of the signal to send, e.g. SIGUSR1.
+	Signal string `json:"signal"`
+}
+
+// NotificationHandler defines the action taken to deliver a notification to a container.
+// The exec and HTTP variants are the same actions available to lifecycle handlers and
+// share their validation, defaulting and kubelet execution plumbing; only the signal
+// variant is specific to notifications.
+type NotificationHandler struct {
+	// One and only one of the following should be specified.
+	// Exec specifies a command to run inside the container.  The notification name is
+	// appended as the final argument.
+	// +optional
+	Exec *ExecAction `json:"exec,omitempty"`
+	// HTTPGet specifies the http request to perform against the container.
+	// +optional
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+	// Signal specifies a POSIX signal to send to the container's init process.
+	// +optional
+	Signal *SignalAction `json:"signal,omitempty"`
+}
+
+// ContainerNotification associates a named notification with the handler that delivers
+// it.  Notifications not declared by a container are rejected by the pod's notify
+// subresource.
+type ContainerNotification struct {
+	// Name of the notification.  Must be unique within the container.
+	Name string `json:"name"`
+	// Handler invoked when the notification is delivered.
+	Handler NotificationHandler `json:"handler"`
+	// RedeliverOnRestart requests that the kubelet re-send the most recently
+	// delivered notification of this name after the container restarts.
+	// +optional
+	RedeliverOnRestart bool `json:"redeliverOnRestart,omitempty"`
+	// AllowedUsers restricts delivery of this notification to the listed
+	// usernames. An empty list allows every user authorized for the pod's
+	// notify subresource.
+	// +optional
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+	// RequireUnprivileged refuses delivery while the target container runs
+	// privileged, so the handler can never execute with host-level privileges.
+	// +optional
+	RequireUnprivileged bool `json:"requireUnprivileged,omitempty"`
+}
+
 // The below types are used by kube_client and api_server.
 
 type ConditionStatus string
@@ -2590,6 +2641,8 @@ const (
 	NodeMemoryPressure NodeConditionType = "MemoryPressure"
 	// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
 	NodeDiskPressure NodeConditionType = "DiskPressure"
+	// NodePIDPressure means the kubelet is under pressure due to insufficient available PIDs.
+	NodePIDPressure NodeConditionType = "PIDPressure"
 	// NodeNetworkUnavailable means that network for the node is not correctly configured.
 	NodeNetworkUnavailable NodeConditionType = "NetworkUnavailable"
 )
@@ -2897,6 +2950,49 @@ type PodExecOptions struct {
 	Command []string
 }
 
+// PodNotifyOptions is the query options to a Pod's remote notify call
+type PodNotifyOptions struct {
+	unversioned.TypeMeta
+
+	// Container to deliver the notification to.
+	Container string
+
+	// Message is the notification message to deliver.
+	Message string
+
+	// Payload is an optional, size-limited payload passed to the
+	// notification handler.
+	Payload string
+
+	// UID is the expected UID of the pod the notification is addressed to.
+	// If set, delivery is rejected when the running pod has a different UID.
+	UID types.UID
+
+	// Signal is the name of a POSIX signal (e.g. "SIGHUP") to deliver to the
+	// container instead of a declared notification. Mutually exclusive with
+	// Message. The kubelet only delivers signals on its allowlist.
+	Signal string
+
+	// Params is a list of KEY=VALUE pairs substituted for $(KEY) placeholders
+	// in the notification handler before it runs. Every key must appear as a
+	// placeholder in the handler or delivery is rejected.
+	Params []string
+
+	// TimeoutSeconds is the deadline in seconds for the notification handler
+	// to complete. If zero, the kubelet applies its default deadline.
+	TimeoutSeconds int32
+
+	// Containers lists several containers to deliver the notification to in
+	// a single request; "*" selects every container in the pod. Mutually
+	// exclusive with Container. The response lists one outcome per container.
+	Containers []string
+
+	// Follow streams the handler's output as it is produced instead of
+	// returning a buffered result once it completes. Only exec handlers can
+	// stream. Mutually exclusive with Containers.
+	Follow bool
+}
+
 // PodProxyOptions is the query options to a Pod's proxy call
 type PodProxyOptions struct {
 	unversioned.TypeMeta
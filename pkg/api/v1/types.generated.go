@@ -20655,7 +20655,7 @@ func (x *Container) CodecEncodeSelf(e *codec1978.Encoder) {
 		} else {
 			yysep1487 := !z.EncBinary()
 			yy2arr1487 := z.EncBasicHandle().StructToArray
-			var yyq1487 [18]bool
+			var yyq1487 [19]bool
 			_, _, _ = yysep1487, yyq1487, yy2arr1487
 			const yyr1487 bool = false
 			yyq1487[1] = x.Image != ""
@@ -20675,9 +20675,10 @@ func (x *Container) CodecEncodeSelf(e *codec1978.Encoder) {
 			yyq1487[15] = x.Stdin != false
 			yyq1487[16] = x.StdinOnce != false
 			yyq1487[17] = x.TTY != false
+			yyq1487[18] = len(x.Notifications) != 0
 			var yynn1487 int
 			if yyr1487 || yy2arr1487 {
-				r.EncodeArrayStart(18)
+				r.EncodeArrayStart(19)
 			} else {
 				yynn1487 = 1
 				for _, b := range yyq1487 {
@@ -21146,6 +21147,39 @@ func (x *Container) CodecEncodeSelf(e *codec1978.Encoder) {
 					}
 				}
 			}
+			if yyr1487 || yy2arr1487 {
+				z.EncSendContainerState(codecSelfer_containerArrayElem1234)
+				if yyq1487[18] {
+					if x.Notifications == nil {
+						r.EncodeNil()
+					} else {
+						yym1533 := z.EncBinary()
+						_ = yym1533
+						if false {
+						} else {
+							z.EncFallback(x.Notifications)
+						}
+					}
+				} else {
+					r.EncodeNil()
+				}
+			} else {
+				if yyq1487[18] {
+					z.EncSendContainerState(codecSelfer_containerMapKey1234)
+					r.EncodeString(codecSelferC_UTF81234, string("notifications"))
+					z.EncSendContainerState(codecSelfer_containerMapValue1234)
+					if x.Notifications == nil {
+						r.EncodeNil()
+					} else {
+						yym1534 := z.EncBinary()
+						_ = yym1534
+						if false {
+						} else {
+							z.EncFallback(x.Notifications)
+						}
+					}
+				}
+			}
 			if yyr1487 || yy2arr1487 {
 				z.EncSendContainerState(codecSelfer_containerArrayEnd1234)
 			} else {
@@ -21366,6 +21400,18 @@ func (x *Container) codecDecodeSelfFromMap(l int, d *codec1978.Decoder) {
 			} else {
 				x.TTY = bool(r.DecodeBool())
 			}
+		case "notifications":
+			if r.TryDecodeAsNil() {
+				x.Notifications = nil
+			} else {
+				yyv1556 := &x.Notifications
+				yym1557 := z.DecBinary()
+				_ = yym1557
+				if false {
+				} else {
+					z.DecFallback(yyv1556, false)
+				}
+			}
 		default:
 			z.DecStructFieldNotFound(-1, yys1534)
 		} // end switch yys1534
@@ -21719,6 +21765,28 @@ func (x *Container) codecDecodeSelfFromArray(l int, d *codec1978.Decoder) {
 	} else {
 		x.TTY = bool(r.DecodeBool())
 	}
+	yyj1558++
+	if yyhl1558 {
+		yyb1558 = yyj1558 > l
+	} else {
+		yyb1558 = r.CheckBreak()
+	}
+	if yyb1558 {
+		z.DecSendContainerState(codecSelfer_containerArrayEnd1234)
+		return
+	}
+	z.DecSendContainerState(codecSelfer_containerArrayElem1234)
+	if r.TryDecodeAsNil() {
+		x.Notifications = nil
+	} else {
+		yyv1580 := &x.Notifications
+		yym1581 := z.DecBinary()
+		_ = yym1581
+		if false {
+		} else {
+			z.DecFallback(yyv1580, false)
+		}
+	}
 	for {
 		yyj1558++
 		if yyhl1558 {
@@ -1438,6 +1438,10 @@ type Container struct {
 	// Cannot be updated.
 	// +optional
 	Lifecycle *Lifecycle `json:"lifecycle,omitempty" protobuf:"bytes,12,opt,name=lifecycle"`
+	// Notifications declares the notifications this container accepts via the pod's
+	// notify subresource and how each one is delivered.
+	// +optional
+	Notifications []ContainerNotification `json:"notifications,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,19,rep,name=notifications"`
 	// Optional: Path at which the file to which the container's termination message
 	// will be written is mounted into the container's filesystem.
 	// Message written is intended to be brief final status, such as an assertion failure message.
@@ -1517,6 +1521,50 @@ type Lifecycle struct {
 	PreStop *Handler `json:"preStop,omitempty" protobuf:"bytes,2,opt,name=preStop"`
 }
 
+// SignalAction describes a POSIX signal sent to the container's init process.
+type SignalAction struct {
+	// Signal is the name of the signal to send, e.g. SIGUSR1.
+	Signal string `json:"signal" protobuf:"bytes,1,opt,name=signal"`
+}
+
+// NotificationHandler defines the action taken to deliver a notification to a container.
+type NotificationHandler struct {
+	// One and only one of the following should be specified.
+	// Exec specifies a command to run inside the container. The notification name is
+	// appended as the final argument.
+	// +optional
+	Exec *ExecAction `json:"exec,omitempty" protobuf:"bytes,1,opt,name=exec"`
+	// HTTPGet specifies the http request to perform against the container.
+	// +optional
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty" protobuf:"bytes,2,opt,name=httpGet"`
+	// Signal specifies a POSIX signal to send to the container's init process.
+	// +optional
+	Signal *SignalAction `json:"signal,omitempty" protobuf:"bytes,3,opt,name=signal"`
+}
+
+// ContainerNotification associates a named notification with the handler that delivers
+// it. Notifications not declared by a container are rejected by the pod's notify
+// subresource.
+type ContainerNotification struct {
+	// Name of the notification. Must be unique within the container.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Handler invoked when the notification is delivered.
+	Handler NotificationHandler `json:"handler" protobuf:"bytes,2,opt,name=handler"`
+	// RedeliverOnRestart requests that the kubelet re-send the most recently
+	// delivered notification of this name after the container restarts.
+	// +optional
+	RedeliverOnRestart bool `json:"redeliverOnRestart,omitempty" protobuf:"varint,3,opt,name=redeliverOnRestart"`
+	// AllowedUsers restricts delivery of this notification to the listed
+	// usernames. An empty list allows every user authorized for the pod's
+	// notify subresource.
+	// +optional
+	AllowedUsers []string `json:"allowedUsers,omitempty" protobuf:"bytes,4,rep,name=allowedUsers"`
+	// RequireUnprivileged refuses delivery while the target container runs
+	// privileged, so the handler can never execute with host-level privileges.
+	// +optional
+	RequireUnprivileged bool `json:"requireUnprivileged,omitempty" protobuf:"varint,5,opt,name=requireUnprivileged"`
+}
+
 type ConditionStatus string
 
 // These are valid condition statuses. "ConditionTrue" means a resource is in the condition.
@@ -2993,6 +3041,8 @@ const (
 	NodeMemoryPressure NodeConditionType = "MemoryPressure"
 	// NodeDiskPressure means the kubelet is under pressure due to insufficient available disk.
 	NodeDiskPressure NodeConditionType = "DiskPressure"
+	// NodePIDPressure means the kubelet is under pressure due to insufficient available PIDs.
+	NodePIDPressure NodeConditionType = "PIDPressure"
 	// NodeNetworkUnavailable means that network for the node is not correctly configured.
 	NodeNetworkUnavailable NodeConditionType = "NetworkUnavailable"
 )
@@ -3354,6 +3404,58 @@ type PodExecOptions struct {
 	Command []string `json:"command" protobuf:"bytes,6,rep,name=command"`
 }
 
+// PodNotifyOptions is the query options to a Pod's remote notify call.
+type PodNotifyOptions struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// The container to deliver the notification to.
+	// Defaults to only container if there is only one container in the pod.
+	// +optional
+	Container string `json:"container,omitempty" protobuf:"bytes,1,opt,name=container"`
+
+	// Message is the notification message to deliver.
+	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
+
+	// Payload is an optional, size-limited payload passed to the
+	// notification handler.
+	// +optional
+	Payload string `json:"payload,omitempty" protobuf:"bytes,3,opt,name=payload"`
+
+	// UID is the expected UID of the pod the notification is addressed to.
+	// If set, delivery is rejected when the running pod has a different UID.
+	// +optional
+	UID types.UID `json:"uid,omitempty" protobuf:"bytes,4,opt,name=uid,casttype=k8s.io/kubernetes/pkg/types.UID"`
+
+	// Signal is the name of a POSIX signal (e.g. "SIGHUP") to deliver to the
+	// container instead of a declared notification. Mutually exclusive with
+	// message. The kubelet only delivers signals on its allowlist.
+	// +optional
+	Signal string `json:"signal,omitempty" protobuf:"bytes,5,opt,name=signal"`
+
+	// Params is a list of KEY=VALUE pairs substituted for $(KEY) placeholders
+	// in the notification handler before it runs. Every key must appear as a
+	// placeholder in the handler or delivery is rejected.
+	// +optional
+	Params []string `json:"params,omitempty" protobuf:"bytes,6,rep,name=params"`
+
+	// TimeoutSeconds is the deadline in seconds for the notification handler
+	// to complete. If zero, the kubelet applies its default deadline.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty" protobuf:"varint,7,opt,name=timeoutSeconds"`
+
+	// Containers lists several containers to deliver the notification to in
+	// a single request; "*" selects every container in the pod. Mutually
+	// exclusive with container. The response lists one outcome per container.
+	// +optional
+	Containers []string `json:"containers,omitempty" protobuf:"bytes,8,rep,name=containers"`
+
+	// Follow streams the handler's output as it is produced instead of
+	// returning a buffered result once it completes. Only exec handlers can
+	// stream. Mutually exclusive with containers.
+	// +optional
+	Follow bool `json:"follow,omitempty" protobuf:"varint,9,opt,name=follow"`
+}
+
 // PodProxyOptions is the query options to a Pod's proxy call.
 type PodProxyOptions struct {
 	unversioned.TypeMeta `json:",inline"`
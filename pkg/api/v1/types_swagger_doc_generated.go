@@ -229,6 +229,7 @@ var map_Container = map[string]string{
 	"stdin":                  "Whether this container should allocate a buffer for stdin in the container runtime. If this is not set, reads from stdin in the container will always result in EOF. Default is false.",
 	"stdinOnce":              "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF. Default is false",
 	"tty":                    "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true. Default is false.",
+	"notifications":          "Notifications declares the notifications this container accepts via the pod's notify subresource and how each one is delivered.",
 }
 
 func (Container) SwaggerDoc() map[string]string {
@@ -245,6 +246,19 @@ func (ContainerImage) SwaggerDoc() map[string]string {
 	return map_ContainerImage
 }
 
+var map_ContainerNotification = map[string]string{
+	"":        "ContainerNotification associates a named notification with the handler that delivers it. Notifications not declared by a container are rejected by the pod's notify subresource.",
+	"name":    "Name of the notification. Must be unique within the container.",
+	"handler": "Handler invoked when the notification is delivered.",
+	"redeliverOnRestart": "RedeliverOnRestart requests that the kubelet re-send the most recently delivered notification of this name after the container restarts.",
+	"allowedUsers":        "AllowedUsers restricts delivery of this notification to the listed usernames. An empty list allows every user authorized for the pod's notify subresource.",
+	"requireUnprivileged": "RequireUnprivileged refuses delivery while the target container runs privileged, so the handler can never execute with host-level privileges.",
+}
+
+func (ContainerNotification) SwaggerDoc() map[string]string {
+	return map_ContainerNotification
+}
+
 var map_ContainerPort = map[string]string{
 	"":              "ContainerPort represents a network port in a single container.",
 	"name":          "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.",
@@ -944,6 +958,17 @@ func (NodeSystemInfo) SwaggerDoc() map[string]string {
 	return map_NodeSystemInfo
 }
 
+var map_NotificationHandler = map[string]string{
+	"":        "NotificationHandler defines the action taken to deliver a notification to a container.",
+	"exec":    "One and only one of the following should be specified. Exec specifies a command to run inside the container. The notification name is appended as the final argument.",
+	"httpGet": "HTTPGet specifies the http request to perform against the container.",
+	"signal":  "Signal specifies a POSIX signal to send to the container's init process.",
+}
+
+func (NotificationHandler) SwaggerDoc() map[string]string {
+	return map_NotificationHandler
+}
+
 var map_ObjectFieldSelector = map[string]string{
 	"":           "ObjectFieldSelector selects an APIVersioned field of an object.",
 	"apiVersion": "Version of the schema the FieldPath is written in terms of, defaults to \"v1\".",
@@ -1247,6 +1272,23 @@ func (PodLogOptions) SwaggerDoc() map[string]string {
 	return map_PodLogOptions
 }
 
+var map_PodNotifyOptions = map[string]string{
+	"":               "PodNotifyOptions is the query options to a Pod's remote notify call",
+	"container":      "Container to deliver the notification to. Defaults to only container if there is only one container in the pod.",
+	"message":        "Message is the notification message to deliver.",
+	"payload":        "Payload is an optional, size-limited payload passed to the notification handler.",
+	"uid":            "UID is the expected UID of the pod the notification is addressed to. If set, delivery is rejected when the running pod has a different UID.",
+	"signal":         "Signal is the name of a POSIX signal (e.g. \"SIGHUP\") to deliver to the container instead of a declared notification. Mutually exclusive with message. The kubelet only delivers signals on its allowlist.",
+	"params":         "Params is a list of KEY=VALUE pairs substituted for $(KEY) placeholders in the notification handler before it runs. Every key must appear as a placeholder in the handler or delivery is rejected.",
+	"timeoutSeconds": "TimeoutSeconds is the deadline in seconds for the notification handler to complete. If zero, the kubelet applies its default deadline.",
+	"containers":     "Containers lists several containers to deliver the notification to in a single request; \"*\" selects every container in the pod. Mutually exclusive with container. The response lists one outcome per container.",
+	"follow":         "Follow streams the handler's output as it is produced instead of returning a buffered result once it completes. Only exec handlers can stream. Mutually exclusive with containers.",
+}
+
+func (PodNotifyOptions) SwaggerDoc() map[string]string {
+	return map_PodNotifyOptions
+}
+
 var map_PodProxyOptions = map[string]string{
 	"":     "PodProxyOptions is the query options to a Pod's proxy call.",
 	"path": "Path is the URL path to use for the current proxy request to pod.",
@@ -1733,6 +1775,15 @@ func (ServiceStatus) SwaggerDoc() map[string]string {
 	return map_ServiceStatus
 }
 
+var map_SignalAction = map[string]string{
+	"":       "SignalAction describes a POSIX signal sent to the container's init process.",
+	"signal": "Signal is the name of the signal to send, e.g. SIGUSR1.",
+}
+
+func (SignalAction) SwaggerDoc() map[string]string {
+	return map_SignalAction
+}
+
 var map_TCPSocketAction = map[string]string{
 	"":     "TCPSocketAction describes an action based on opening a socket",
 	"port": "Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.",
@@ -75,6 +75,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_Container_To_v1_Container,
 		Convert_v1_ContainerImage_To_api_ContainerImage,
 		Convert_api_ContainerImage_To_v1_ContainerImage,
+		Convert_v1_ContainerNotification_To_api_ContainerNotification,
+		Convert_api_ContainerNotification_To_v1_ContainerNotification,
 		Convert_v1_ContainerPort_To_api_ContainerPort,
 		Convert_api_ContainerPort_To_v1_ContainerPort,
 		Convert_v1_ContainerState_To_api_ContainerState,
@@ -201,6 +203,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_NodeStatus_To_v1_NodeStatus,
 		Convert_v1_NodeSystemInfo_To_api_NodeSystemInfo,
 		Convert_api_NodeSystemInfo_To_v1_NodeSystemInfo,
+		Convert_v1_NotificationHandler_To_api_NotificationHandler,
+		Convert_api_NotificationHandler_To_v1_NotificationHandler,
 		Convert_v1_ObjectFieldSelector_To_api_ObjectFieldSelector,
 		Convert_api_ObjectFieldSelector_To_v1_ObjectFieldSelector,
 		Convert_v1_ObjectMeta_To_api_ObjectMeta,
@@ -249,6 +253,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_PodList_To_v1_PodList,
 		Convert_v1_PodLogOptions_To_api_PodLogOptions,
 		Convert_api_PodLogOptions_To_v1_PodLogOptions,
+		Convert_v1_PodNotifyOptions_To_api_PodNotifyOptions,
+		Convert_api_PodNotifyOptions_To_v1_PodNotifyOptions,
 		Convert_v1_PodProxyOptions_To_api_PodProxyOptions,
 		Convert_api_PodProxyOptions_To_v1_PodProxyOptions,
 		Convert_v1_PodSecurityContext_To_api_PodSecurityContext,
@@ -333,6 +339,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_ServiceSpec_To_v1_ServiceSpec,
 		Convert_v1_ServiceStatus_To_api_ServiceStatus,
 		Convert_api_ServiceStatus_To_v1_ServiceStatus,
+		Convert_v1_SignalAction_To_api_SignalAction,
+		Convert_api_SignalAction_To_v1_SignalAction,
 		Convert_v1_TCPSocketAction_To_api_TCPSocketAction,
 		Convert_api_TCPSocketAction_To_v1_TCPSocketAction,
 		Convert_v1_Taint_To_api_Taint,
@@ -759,6 +767,7 @@ func autoConvert_v1_Container_To_api_Container(in *Container, out *api.Container
 	out.LivenessProbe = (*api.Probe)(unsafe.Pointer(in.LivenessProbe))
 	out.ReadinessProbe = (*api.Probe)(unsafe.Pointer(in.ReadinessProbe))
 	out.Lifecycle = (*api.Lifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.Notifications = *(*[]api.ContainerNotification)(unsafe.Pointer(&in.Notifications))
 	out.TerminationMessagePath = in.TerminationMessagePath
 	out.ImagePullPolicy = api.PullPolicy(in.ImagePullPolicy)
 	out.SecurityContext = (*api.SecurityContext)(unsafe.Pointer(in.SecurityContext))
@@ -787,6 +796,7 @@ func autoConvert_api_Container_To_v1_Container(in *api.Container, out *Container
 	out.LivenessProbe = (*Probe)(unsafe.Pointer(in.LivenessProbe))
 	out.ReadinessProbe = (*Probe)(unsafe.Pointer(in.ReadinessProbe))
 	out.Lifecycle = (*Lifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.Notifications = *(*[]ContainerNotification)(unsafe.Pointer(&in.Notifications))
 	out.TerminationMessagePath = in.TerminationMessagePath
 	out.ImagePullPolicy = PullPolicy(in.ImagePullPolicy)
 	out.SecurityContext = (*SecurityContext)(unsafe.Pointer(in.SecurityContext))
@@ -820,6 +830,36 @@ func Convert_api_ContainerImage_To_v1_ContainerImage(in *api.ContainerImage, out
 	return autoConvert_api_ContainerImage_To_v1_ContainerImage(in, out, s)
 }
 
+func autoConvert_v1_ContainerNotification_To_api_ContainerNotification(in *ContainerNotification, out *api.ContainerNotification, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1_NotificationHandler_To_api_NotificationHandler(&in.Handler, &out.Handler, s); err != nil {
+		return err
+	}
+	out.RedeliverOnRestart = in.RedeliverOnRestart
+	out.AllowedUsers = *(*[]string)(unsafe.Pointer(&in.AllowedUsers))
+	out.RequireUnprivileged = in.RequireUnprivileged
+	return nil
+}
+
+func Convert_v1_ContainerNotification_To_api_ContainerNotification(in *ContainerNotification, out *api.ContainerNotification, s conversion.Scope) error {
+	return autoConvert_v1_ContainerNotification_To_api_ContainerNotification(in, out, s)
+}
+
+func autoConvert_api_ContainerNotification_To_v1_ContainerNotification(in *api.ContainerNotification, out *ContainerNotification, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_api_NotificationHandler_To_v1_NotificationHandler(&in.Handler, &out.Handler, s); err != nil {
+		return err
+	}
+	out.RedeliverOnRestart = in.RedeliverOnRestart
+	out.AllowedUsers = *(*[]string)(unsafe.Pointer(&in.AllowedUsers))
+	out.RequireUnprivileged = in.RequireUnprivileged
+	return nil
+}
+
+func Convert_api_ContainerNotification_To_v1_ContainerNotification(in *api.ContainerNotification, out *ContainerNotification, s conversion.Scope) error {
+	return autoConvert_api_ContainerNotification_To_v1_ContainerNotification(in, out, s)
+}
+
 func autoConvert_v1_ContainerPort_To_api_ContainerPort(in *ContainerPort, out *api.ContainerPort, s conversion.Scope) error {
 	out.Name = in.Name
 	out.HostPort = in.HostPort
@@ -2314,6 +2354,28 @@ func Convert_api_NodeSystemInfo_To_v1_NodeSystemInfo(in *api.NodeSystemInfo, out
 	return autoConvert_api_NodeSystemInfo_To_v1_NodeSystemInfo(in, out, s)
 }
 
+func autoConvert_v1_NotificationHandler_To_api_NotificationHandler(in *NotificationHandler, out *api.NotificationHandler, s conversion.Scope) error {
+	out.Exec = (*api.ExecAction)(unsafe.Pointer(in.Exec))
+	out.HTTPGet = (*api.HTTPGetAction)(unsafe.Pointer(in.HTTPGet))
+	out.Signal = (*api.SignalAction)(unsafe.Pointer(in.Signal))
+	return nil
+}
+
+func Convert_v1_NotificationHandler_To_api_NotificationHandler(in *NotificationHandler, out *api.NotificationHandler, s conversion.Scope) error {
+	return autoConvert_v1_NotificationHandler_To_api_NotificationHandler(in, out, s)
+}
+
+func autoConvert_api_NotificationHandler_To_v1_NotificationHandler(in *api.NotificationHandler, out *NotificationHandler, s conversion.Scope) error {
+	out.Exec = (*ExecAction)(unsafe.Pointer(in.Exec))
+	out.HTTPGet = (*HTTPGetAction)(unsafe.Pointer(in.HTTPGet))
+	out.Signal = (*SignalAction)(unsafe.Pointer(in.Signal))
+	return nil
+}
+
+func Convert_api_NotificationHandler_To_v1_NotificationHandler(in *api.NotificationHandler, out *NotificationHandler, s conversion.Scope) error {
+	return autoConvert_api_NotificationHandler_To_v1_NotificationHandler(in, out, s)
+}
+
 func autoConvert_v1_ObjectFieldSelector_To_api_ObjectFieldSelector(in *ObjectFieldSelector, out *api.ObjectFieldSelector, s conversion.Scope) error {
 	out.APIVersion = in.APIVersion
 	out.FieldPath = in.FieldPath
@@ -2998,6 +3060,40 @@ func Convert_api_PodLogOptions_To_v1_PodLogOptions(in *api.PodLogOptions, out *P
 	return autoConvert_api_PodLogOptions_To_v1_PodLogOptions(in, out, s)
 }
 
+func autoConvert_v1_PodNotifyOptions_To_api_PodNotifyOptions(in *PodNotifyOptions, out *api.PodNotifyOptions, s conversion.Scope) error {
+	out.Container = in.Container
+	out.Message = in.Message
+	out.Payload = in.Payload
+	out.UID = in.UID
+	out.Signal = in.Signal
+	out.Params = *(*[]string)(unsafe.Pointer(&in.Params))
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.Containers = *(*[]string)(unsafe.Pointer(&in.Containers))
+	out.Follow = in.Follow
+	return nil
+}
+
+func Convert_v1_PodNotifyOptions_To_api_PodNotifyOptions(in *PodNotifyOptions, out *api.PodNotifyOptions, s conversion.Scope) error {
+	return autoConvert_v1_PodNotifyOptions_To_api_PodNotifyOptions(in, out, s)
+}
+
+func autoConvert_api_PodNotifyOptions_To_v1_PodNotifyOptions(in *api.PodNotifyOptions, out *PodNotifyOptions, s conversion.Scope) error {
+	out.Container = in.Container
+	out.Message = in.Message
+	out.Payload = in.Payload
+	out.UID = in.UID
+	out.Signal = in.Signal
+	out.Params = *(*[]string)(unsafe.Pointer(&in.Params))
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.Containers = *(*[]string)(unsafe.Pointer(&in.Containers))
+	out.Follow = in.Follow
+	return nil
+}
+
+func Convert_api_PodNotifyOptions_To_v1_PodNotifyOptions(in *api.PodNotifyOptions, out *PodNotifyOptions, s conversion.Scope) error {
+	return autoConvert_api_PodNotifyOptions_To_v1_PodNotifyOptions(in, out, s)
+}
+
 func autoConvert_v1_PodProxyOptions_To_api_PodProxyOptions(in *PodProxyOptions, out *api.PodProxyOptions, s conversion.Scope) error {
 	out.Path = in.Path
 	return nil
@@ -4155,6 +4251,24 @@ func Convert_api_ServiceStatus_To_v1_ServiceStatus(in *api.ServiceStatus, out *S
 	return autoConvert_api_ServiceStatus_To_v1_ServiceStatus(in, out, s)
 }
 
+func autoConvert_v1_SignalAction_To_api_SignalAction(in *SignalAction, out *api.SignalAction, s conversion.Scope) error {
+	out.Signal = in.Signal
+	return nil
+}
+
+func Convert_v1_SignalAction_To_api_SignalAction(in *SignalAction, out *api.SignalAction, s conversion.Scope) error {
+	return autoConvert_v1_SignalAction_To_api_SignalAction(in, out, s)
+}
+
+func autoConvert_api_SignalAction_To_v1_SignalAction(in *api.SignalAction, out *SignalAction, s conversion.Scope) error {
+	out.Signal = in.Signal
+	return nil
+}
+
+func Convert_api_SignalAction_To_v1_SignalAction(in *api.SignalAction, out *SignalAction, s conversion.Scope) error {
+	return autoConvert_api_SignalAction_To_v1_SignalAction(in, out, s)
+}
+
 func autoConvert_v1_TCPSocketAction_To_api_TCPSocketAction(in *TCPSocketAction, out *api.TCPSocketAction, s conversion.Scope) error {
 	out.Port = in.Port
 	return nil
@@ -55,6 +55,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ConfigMapVolumeSource, InType: reflect.TypeOf(&ConfigMapVolumeSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Container, InType: reflect.TypeOf(&Container{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ContainerImage, InType: reflect.TypeOf(&ContainerImage{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ContainerNotification, InType: reflect.TypeOf(&ContainerNotification{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ContainerPort, InType: reflect.TypeOf(&ContainerPort{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ContainerState, InType: reflect.TypeOf(&ContainerState{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ContainerStateRunning, InType: reflect.TypeOf(&ContainerStateRunning{})},
@@ -118,6 +119,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_NodeSpec, InType: reflect.TypeOf(&NodeSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_NodeStatus, InType: reflect.TypeOf(&NodeStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_NodeSystemInfo, InType: reflect.TypeOf(&NodeSystemInfo{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_NotificationHandler, InType: reflect.TypeOf(&NotificationHandler{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ObjectFieldSelector, InType: reflect.TypeOf(&ObjectFieldSelector{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ObjectMeta, InType: reflect.TypeOf(&ObjectMeta{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ObjectReference, InType: reflect.TypeOf(&ObjectReference{})},
@@ -142,6 +144,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodExecOptions, InType: reflect.TypeOf(&PodExecOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodList, InType: reflect.TypeOf(&PodList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodLogOptions, InType: reflect.TypeOf(&PodLogOptions{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodNotifyOptions, InType: reflect.TypeOf(&PodNotifyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodProxyOptions, InType: reflect.TypeOf(&PodProxyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodSecurityContext, InType: reflect.TypeOf(&PodSecurityContext{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PodSignature, InType: reflect.TypeOf(&PodSignature{})},
@@ -184,6 +187,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ServiceProxyOptions, InType: reflect.TypeOf(&ServiceProxyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ServiceSpec, InType: reflect.TypeOf(&ServiceSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ServiceStatus, InType: reflect.TypeOf(&ServiceStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SignalAction, InType: reflect.TypeOf(&SignalAction{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_TCPSocketAction, InType: reflect.TypeOf(&TCPSocketAction{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Taint, InType: reflect.TypeOf(&Taint{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Toleration, InType: reflect.TypeOf(&Toleration{})},
@@ -602,6 +606,17 @@ func DeepCopy_v1_Container(in interface{}, out interface{}, c *conversion.Cloner
 		} else {
 			out.Lifecycle = nil
 		}
+		if in.Notifications != nil {
+			in, out := &in.Notifications, &out.Notifications
+			*out = make([]ContainerNotification, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_ContainerNotification(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Notifications = nil
+		}
 		out.TerminationMessagePath = in.TerminationMessagePath
 		out.ImagePullPolicy = in.ImagePullPolicy
 		if in.SecurityContext != nil {
@@ -636,6 +651,27 @@ func DeepCopy_v1_ContainerImage(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_v1_ContainerNotification(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ContainerNotification)
+		out := out.(*ContainerNotification)
+		out.Name = in.Name
+		if err := DeepCopy_v1_NotificationHandler(&in.Handler, &out.Handler, c); err != nil {
+			return err
+		}
+		out.RedeliverOnRestart = in.RedeliverOnRestart
+		if in.AllowedUsers != nil {
+			in, out := &in.AllowedUsers, &out.AllowedUsers
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.AllowedUsers = nil
+		}
+		out.RequireUnprivileged = in.RequireUnprivileged
+		return nil
+	}
+}
+
 func DeepCopy_v1_ContainerPort(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ContainerPort)
@@ -1852,6 +1888,39 @@ func DeepCopy_v1_NodeSystemInfo(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_v1_NotificationHandler(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*NotificationHandler)
+		out := out.(*NotificationHandler)
+		if in.Exec != nil {
+			in, out := &in.Exec, &out.Exec
+			*out = new(ExecAction)
+			if err := DeepCopy_v1_ExecAction(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Exec = nil
+		}
+		if in.HTTPGet != nil {
+			in, out := &in.HTTPGet, &out.HTTPGet
+			*out = new(HTTPGetAction)
+			if err := DeepCopy_v1_HTTPGetAction(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.HTTPGet = nil
+		}
+		if in.Signal != nil {
+			in, out := &in.Signal, &out.Signal
+			*out = new(SignalAction)
+			**out = **in
+		} else {
+			out.Signal = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_ObjectFieldSelector(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ObjectFieldSelector)
@@ -2515,6 +2584,36 @@ func DeepCopy_v1_PodLogOptions(in interface{}, out interface{}, c *conversion.Cl
 	}
 }
 
+func DeepCopy_v1_PodNotifyOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PodNotifyOptions)
+		out := out.(*PodNotifyOptions)
+		out.TypeMeta = in.TypeMeta
+		out.Container = in.Container
+		out.Message = in.Message
+		out.Payload = in.Payload
+		out.UID = in.UID
+		out.Signal = in.Signal
+		if in.Params != nil {
+			in, out := &in.Params, &out.Params
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.Params = nil
+		}
+		out.TimeoutSeconds = in.TimeoutSeconds
+		if in.Containers != nil {
+			in, out := &in.Containers, &out.Containers
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.Containers = nil
+		}
+		out.Follow = in.Follow
+		return nil
+	}
+}
+
 func DeepCopy_v1_PodProxyOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*PodProxyOptions)
@@ -3494,6 +3593,15 @@ func DeepCopy_v1_ServiceStatus(in interface{}, out interface{}, c *conversion.Cl
 	}
 }
 
+func DeepCopy_v1_SignalAction(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*SignalAction)
+		out := out.(*SignalAction)
+		out.Signal = in.Signal
+		return nil
+	}
+}
+
 func DeepCopy_v1_TCPSocketAction(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*TCPSocketAction)
@@ -232,6 +232,12 @@ func SetObjectDefaults_Pod(in *Pod) {
 				}
 			}
 		}
+		for j := range a.Notifications {
+			b := &a.Notifications[j]
+			if b.Handler.HTTPGet != nil {
+				SetDefaults_HTTPGetAction(b.Handler.HTTPGet)
+			}
+		}
 	}
 	for i := range in.Spec.Containers {
 		a := &in.Spec.Containers[i]
@@ -274,6 +280,12 @@ func SetObjectDefaults_Pod(in *Pod) {
 				}
 			}
 		}
+		for j := range a.Notifications {
+			b := &a.Notifications[j]
+			if b.Handler.HTTPGet != nil {
+				SetDefaults_HTTPGetAction(b.Handler.HTTPGet)
+			}
+		}
 	}
 }
 
@@ -363,6 +375,12 @@ func SetObjectDefaults_PodTemplate(in *PodTemplate) {
 				}
 			}
 		}
+		for j := range a.Notifications {
+			b := &a.Notifications[j]
+			if b.Handler.HTTPGet != nil {
+				SetDefaults_HTTPGetAction(b.Handler.HTTPGet)
+			}
+		}
 	}
 	for i := range in.Template.Spec.Containers {
 		a := &in.Template.Spec.Containers[i]
@@ -405,6 +423,12 @@ func SetObjectDefaults_PodTemplate(in *PodTemplate) {
 				}
 			}
 		}
+		for j := range a.Notifications {
+			b := &a.Notifications[j]
+			if b.Handler.HTTPGet != nil {
+				SetDefaults_HTTPGetAction(b.Handler.HTTPGet)
+			}
+		}
 	}
 }
 
@@ -488,6 +512,12 @@ func SetObjectDefaults_ReplicationController(in *ReplicationController) {
 					}
 				}
 			}
+			for j := range a.Notifications {
+				b := &a.Notifications[j]
+				if b.Handler.HTTPGet != nil {
+					SetDefaults_HTTPGetAction(b.Handler.HTTPGet)
+				}
+			}
 		}
 		for i := range in.Spec.Template.Spec.Containers {
 			a := &in.Spec.Template.Spec.Containers[i]
@@ -530,6 +560,12 @@ func SetObjectDefaults_ReplicationController(in *ReplicationController) {
 					}
 				}
 			}
+			for j := range a.Notifications {
+				b := &a.Notifications[j]
+				if b.Handler.HTTPGet != nil {
+					SetDefaults_HTTPGetAction(b.Handler.HTTPGet)
+				}
+			}
 		}
 	}
 }
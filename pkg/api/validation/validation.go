@@ -1627,6 +1627,54 @@ func validateHandler(handler *api.Handler, fldPath *field.Path) field.ErrorList
 	return allErrors
 }
 
+func validateSignalAction(signal *api.SignalAction, fldPath *field.Path) field.ErrorList {
+	allErrors := field.ErrorList{}
+	if len(signal.Signal) == 0 {
+		allErrors = append(allErrors, field.Required(fldPath.Child("signal"), ""))
+	}
+	return allErrors
+}
+
+// validateNotificationHandler checks a notification handler. The exec and HTTP
+// variants are the same actions available to lifecycle handlers and share
+// their validation; only the signal variant is notification specific.
+func validateNotificationHandler(handler *api.NotificationHandler, fldPath *field.Path) field.ErrorList {
+	if handler.Signal != nil {
+		allErrors := field.ErrorList{}
+		if handler.Exec != nil || handler.HTTPGet != nil {
+			allErrors = append(allErrors, field.Forbidden(fldPath.Child("signal"), "may not specify more than 1 handler type"))
+		}
+		return append(allErrors, validateSignalAction(handler.Signal, fldPath.Child("signal"))...)
+	}
+	return validateHandler(&api.Handler{Exec: handler.Exec, HTTPGet: handler.HTTPGet}, fldPath)
+}
+
+func validateNotifications(notifications []api.ContainerNotification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allNames := sets.String{}
+	for i, notification := range notifications {
+		idxPath := fldPath.Index(i)
+		namePath := idxPath.Child("name")
+		if len(notification.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, ""))
+		} else {
+			allErrs = append(allErrs, ValidateDNS1123Label(notification.Name, namePath)...)
+		}
+		if allNames.Has(notification.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, notification.Name))
+		} else {
+			allNames.Insert(notification.Name)
+		}
+		for j, user := range notification.AllowedUsers {
+			if len(user) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("allowedUsers").Index(j), ""))
+			}
+		}
+		allErrs = append(allErrs, validateNotificationHandler(&notification.Handler, idxPath.Child("handler"))...)
+	}
+	return allErrs
+}
+
 func validateLifecycle(lifecycle *api.Lifecycle, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if lifecycle.PostStart != nil {
@@ -1713,6 +1761,7 @@ func validateContainers(containers []api.Container, volumes sets.String, fldPath
 		if ctr.Lifecycle != nil {
 			allErrs = append(allErrs, validateLifecycle(ctr.Lifecycle, idxPath.Child("lifecycle"))...)
 		}
+		allErrs = append(allErrs, validateNotifications(ctr.Notifications, idxPath.Child("notifications"))...)
 		allErrs = append(allErrs, validateProbe(ctr.LivenessProbe, idxPath.Child("livenessProbe"))...)
 		// Liveness-specific validation
 		if ctr.LivenessProbe != nil && ctr.LivenessProbe.SuccessThreshold != 1 {
@@ -2765,6 +2765,25 @@ func TestValidateContainers(t *testing.T) {
 			},
 			ImagePullPolicy: "IfNotPresent",
 		},
+		{
+			Name:  "notify-123",
+			Image: "image",
+			Notifications: []api.ContainerNotification{
+				{
+					Name: "reload",
+					Handler: api.NotificationHandler{
+						Signal: &api.SignalAction{Signal: "SIGHUP"},
+					},
+				},
+				{
+					Name: "dump-state",
+					Handler: api.NotificationHandler{
+						Exec: &api.ExecAction{Command: []string{"/bin/dump"}},
+					},
+				},
+			},
+			ImagePullPolicy: "IfNotPresent",
+		},
 		{
 			Name:  "resources-test",
 			Image: "image",
@@ -2966,6 +2985,101 @@ func TestValidateContainers(t *testing.T) {
 				ImagePullPolicy: "IfNotPresent",
 			},
 		},
+		"invalid notification, no name.": {
+			{
+				Name:  "notify-123",
+				Image: "image",
+				Notifications: []api.ContainerNotification{
+					{
+						Handler: api.NotificationHandler{
+							Signal: &api.SignalAction{Signal: "SIGHUP"},
+						},
+					},
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"invalid notification, duplicate name.": {
+			{
+				Name:  "notify-123",
+				Image: "image",
+				Notifications: []api.ContainerNotification{
+					{
+						Name: "reload",
+						Handler: api.NotificationHandler{
+							Signal: &api.SignalAction{Signal: "SIGHUP"},
+						},
+					},
+					{
+						Name: "reload",
+						Handler: api.NotificationHandler{
+							Signal: &api.SignalAction{Signal: "SIGUSR1"},
+						},
+					},
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"invalid notification, no action.": {
+			{
+				Name:  "notify-123",
+				Image: "image",
+				Notifications: []api.ContainerNotification{
+					{
+						Name:    "reload",
+						Handler: api.NotificationHandler{},
+					},
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"invalid notification, multiple actions.": {
+			{
+				Name:  "notify-123",
+				Image: "image",
+				Notifications: []api.ContainerNotification{
+					{
+						Name: "reload",
+						Handler: api.NotificationHandler{
+							Exec:   &api.ExecAction{Command: []string{"/bin/reload"}},
+							Signal: &api.SignalAction{Signal: "SIGHUP"},
+						},
+					},
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"invalid notification, no signal.": {
+			{
+				Name:  "notify-123",
+				Image: "image",
+				Notifications: []api.ContainerNotification{
+					{
+						Name: "reload",
+						Handler: api.NotificationHandler{
+							Signal: &api.SignalAction{},
+						},
+					},
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"invalid notification, empty allowed user.": {
+			{
+				Name:  "notify-123",
+				Image: "image",
+				Notifications: []api.ContainerNotification{
+					{
+						Name: "reload",
+						Handler: api.NotificationHandler{
+							Signal: &api.SignalAction{Signal: "SIGHUP"},
+						},
+						AllowedUsers: []string{"alice", ""},
+					},
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
 		"invalid liveness probe, no tcp socket port.": {
 			{
 				Name:  "life-123",
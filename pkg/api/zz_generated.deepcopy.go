@@ -57,6 +57,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ConfigMapVolumeSource, InType: reflect.TypeOf(&ConfigMapVolumeSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_Container, InType: reflect.TypeOf(&Container{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ContainerImage, InType: reflect.TypeOf(&ContainerImage{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ContainerNotification, InType: reflect.TypeOf(&ContainerNotification{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ContainerPort, InType: reflect.TypeOf(&ContainerPort{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ContainerState, InType: reflect.TypeOf(&ContainerState{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ContainerStateRunning, InType: reflect.TypeOf(&ContainerStateRunning{})},
@@ -122,6 +123,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_NodeSpec, InType: reflect.TypeOf(&NodeSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_NodeStatus, InType: reflect.TypeOf(&NodeStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_NodeSystemInfo, InType: reflect.TypeOf(&NodeSystemInfo{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_NotificationHandler, InType: reflect.TypeOf(&NotificationHandler{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ObjectFieldSelector, InType: reflect.TypeOf(&ObjectFieldSelector{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ObjectMeta, InType: reflect.TypeOf(&ObjectMeta{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ObjectReference, InType: reflect.TypeOf(&ObjectReference{})},
@@ -146,6 +148,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodExecOptions, InType: reflect.TypeOf(&PodExecOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodList, InType: reflect.TypeOf(&PodList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodLogOptions, InType: reflect.TypeOf(&PodLogOptions{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodNotifyOptions, InType: reflect.TypeOf(&PodNotifyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodProxyOptions, InType: reflect.TypeOf(&PodProxyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodSecurityContext, InType: reflect.TypeOf(&PodSecurityContext{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PodSignature, InType: reflect.TypeOf(&PodSignature{})},
@@ -188,6 +191,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ServiceProxyOptions, InType: reflect.TypeOf(&ServiceProxyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ServiceSpec, InType: reflect.TypeOf(&ServiceSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ServiceStatus, InType: reflect.TypeOf(&ServiceStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SignalAction, InType: reflect.TypeOf(&SignalAction{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_Sysctl, InType: reflect.TypeOf(&Sysctl{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_TCPSocketAction, InType: reflect.TypeOf(&TCPSocketAction{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_Taint, InType: reflect.TypeOf(&Taint{})},
@@ -607,6 +611,17 @@ func DeepCopy_api_Container(in interface{}, out interface{}, c *conversion.Clone
 		} else {
 			out.Lifecycle = nil
 		}
+		if in.Notifications != nil {
+			in, out := &in.Notifications, &out.Notifications
+			*out = make([]ContainerNotification, len(*in))
+			for i := range *in {
+				if err := DeepCopy_api_ContainerNotification(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Notifications = nil
+		}
 		out.TerminationMessagePath = in.TerminationMessagePath
 		out.ImagePullPolicy = in.ImagePullPolicy
 		if in.SecurityContext != nil {
@@ -641,6 +656,27 @@ func DeepCopy_api_ContainerImage(in interface{}, out interface{}, c *conversion.
 	}
 }
 
+func DeepCopy_api_ContainerNotification(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ContainerNotification)
+		out := out.(*ContainerNotification)
+		out.Name = in.Name
+		if err := DeepCopy_api_NotificationHandler(&in.Handler, &out.Handler, c); err != nil {
+			return err
+		}
+		out.RedeliverOnRestart = in.RedeliverOnRestart
+		if in.AllowedUsers != nil {
+			in, out := &in.AllowedUsers, &out.AllowedUsers
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.AllowedUsers = nil
+		}
+		out.RequireUnprivileged = in.RequireUnprivileged
+		return nil
+	}
+}
+
 func DeepCopy_api_ContainerPort(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ContainerPort)
@@ -1911,6 +1947,39 @@ func DeepCopy_api_NodeSystemInfo(in interface{}, out interface{}, c *conversion.
 	}
 }
 
+func DeepCopy_api_NotificationHandler(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*NotificationHandler)
+		out := out.(*NotificationHandler)
+		if in.Exec != nil {
+			in, out := &in.Exec, &out.Exec
+			*out = new(ExecAction)
+			if err := DeepCopy_api_ExecAction(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.Exec = nil
+		}
+		if in.HTTPGet != nil {
+			in, out := &in.HTTPGet, &out.HTTPGet
+			*out = new(HTTPGetAction)
+			if err := DeepCopy_api_HTTPGetAction(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.HTTPGet = nil
+		}
+		if in.Signal != nil {
+			in, out := &in.Signal, &out.Signal
+			*out = new(SignalAction)
+			**out = **in
+		} else {
+			out.Signal = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_api_ObjectFieldSelector(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ObjectFieldSelector)
@@ -2574,6 +2643,36 @@ func DeepCopy_api_PodLogOptions(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_api_PodNotifyOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PodNotifyOptions)
+		out := out.(*PodNotifyOptions)
+		out.TypeMeta = in.TypeMeta
+		out.Container = in.Container
+		out.Message = in.Message
+		out.Payload = in.Payload
+		out.UID = in.UID
+		out.Signal = in.Signal
+		if in.Params != nil {
+			in, out := &in.Params, &out.Params
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.Params = nil
+		}
+		out.TimeoutSeconds = in.TimeoutSeconds
+		if in.Containers != nil {
+			in, out := &in.Containers, &out.Containers
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.Containers = nil
+		}
+		out.Follow = in.Follow
+		return nil
+	}
+}
+
 func DeepCopy_api_PodProxyOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*PodProxyOptions)
@@ -3530,6 +3629,15 @@ func DeepCopy_api_ServiceStatus(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_api_SignalAction(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*SignalAction)
+		out := out.(*SignalAction)
+		out.Signal = in.Signal
+		return nil
+	}
+}
+
 func DeepCopy_api_Sysctl(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*Sysctl)
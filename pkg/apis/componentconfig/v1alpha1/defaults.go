@@ -210,6 +210,12 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 	if obj.ContainerRuntime == "" {
 		obj.ContainerRuntime = "docker"
 	}
+	if obj.CPUManagerPolicy == "" {
+		obj.CPUManagerPolicy = "none"
+	}
+	if obj.CPUManagerReconcilePeriod == zeroDuration {
+		obj.CPUManagerReconcilePeriod = unversioned.Duration{Duration: 10 * time.Second}
+	}
 	if obj.RuntimeRequestTimeout == zeroDuration {
 		obj.RuntimeRequestTimeout = unversioned.Duration{Duration: 2 * time.Minute}
 	}
@@ -256,6 +262,16 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 	if obj.HostIPCSources == nil {
 		obj.HostIPCSources = []string{kubetypes.AllSource}
 	}
+	if obj.AllowedNotifySignals == nil {
+		obj.AllowedNotifySignals = []string{"SIGHUP", "SIGUSR1", "SIGUSR2"}
+	}
+	if obj.NotifyQPS == nil {
+		temp := int32(1)
+		obj.NotifyQPS = &temp
+	}
+	if obj.NotifyBurst == 0 {
+		obj.NotifyBurst = 10
+	}
 	if obj.HTTPCheckFrequency == zeroDuration {
 		obj.HTTPCheckFrequency = unversioned.Duration{Duration: 20 * time.Second}
 	}
@@ -283,6 +299,13 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 	if obj.MaxPerPodContainerCount == 0 {
 		obj.MaxPerPodContainerCount = 1
 	}
+	if obj.ContainerLogMaxSize == "" {
+		obj.ContainerLogMaxSize = "10Mi"
+	}
+	if obj.ContainerLogMaxFiles == nil {
+		temp := int32(5)
+		obj.ContainerLogMaxFiles = &temp
+	}
 	if obj.MaxOpenFiles == 0 {
 		obj.MaxOpenFiles = 1000000
 	}
@@ -339,6 +362,10 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 	if obj.SerializeImagePulls == nil {
 		obj.SerializeImagePulls = boolVar(true)
 	}
+	if obj.MaxParallelImagePulls == nil {
+		temp := int32(5)
+		obj.MaxParallelImagePulls = &temp
+	}
 	if obj.SeccompProfileRoot == "" {
 		obj.SeccompProfileRoot = filepath.Join(defaultRootDir, "seccomp")
 	}
@@ -374,6 +401,12 @@ func SetDefaults_KubeletConfiguration(obj *KubeletConfiguration) {
 	if obj.EvictionPressureTransitionPeriod == zeroDuration {
 		obj.EvictionPressureTransitionPeriod = unversioned.Duration{Duration: 5 * time.Minute}
 	}
+	if obj.EvictionMonitoringPeriod == zeroDuration {
+		obj.EvictionMonitoringPeriod = unversioned.Duration{Duration: 10 * time.Second}
+	}
+	if obj.ExperimentalEvictionInactiveFilePercent == 0 {
+		obj.ExperimentalEvictionInactiveFilePercent = 100
+	}
 	if obj.SystemReserved == nil {
 		obj.SystemReserved = make(map[string]string)
 	}
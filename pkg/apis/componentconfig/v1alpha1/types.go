@@ -244,6 +244,17 @@ type KubeletConfiguration struct {
 	// hostIPCSources is a comma-separated list of sources from which the
 	// Kubelet allows pods to use the host ipc namespace. Defaults to "*".
 	HostIPCSources []string `json:"hostIPCSources"`
+	// allowedNotifySignals is a comma-separated list of POSIX signal names
+	// that the notify subresource may deliver to a container without a
+	// declared notification handler. Defaults to "SIGHUP,SIGUSR1,SIGUSR2".
+	AllowedNotifySignals []string `json:"allowedNotifySignals"`
+	// notifyQPS is the limit of notifications delivered per second to the
+	// containers of a single pod. If 0, unlimited. Defaults to 1.0.
+	NotifyQPS *int32 `json:"notifyQPS"`
+	// notifyBurst is the maximum size of a burst of notifications,
+	// temporarily allows notifications to burst to this number, while still
+	// not exceeding notifyQPS. Only used if notifyQPS > 0.
+	NotifyBurst int32 `json:"notifyBurst"`
 	// registryPullQPS is the limit of registry pulls per second. If 0,
 	// unlimited. Set to 0 for no limit. Defaults to 5.0.
 	RegistryPullQPS *int32 `json:"registryPullQPS"`
@@ -270,6 +281,13 @@ type KubeletConfiguration struct {
 	// maxContainerCount is the maximum number of old instances of containers
 	// to retain globally. Each container takes up some disk space.
 	MaxContainerCount *int32 `json:"maxContainerCount"`
+	// containerLogMaxSize is the maximum size of a container log file before
+	// it is rotated, e.g. "10Mi". Only used when the kubelet manages the
+	// container log files.
+	ContainerLogMaxSize string `json:"containerLogMaxSize"`
+	// containerLogMaxFiles is the maximum number of log files that can be
+	// present for a container, including the file being written to.
+	ContainerLogMaxFiles *int32 `json:"containerLogMaxFiles"`
 	// cAdvisorPort is the port of the localhost cAdvisor endpoint
 	CAdvisorPort int32 `json:"cAdvisorPort"`
 	// healthzPort is the port of the localhost healthz endpoint
@@ -438,6 +456,9 @@ type KubeletConfiguration struct {
 	// run docker daemon with version  < 1.9 or an Aufs storage backend.
 	// Issue #10959 has more details.
 	SerializeImagePulls *bool `json:"serializeImagePulls"`
+	// maxParallelImagePulls is the maximum number of images to pull in
+	// parallel. Only used when serializeImagePulls is disabled.
+	MaxParallelImagePulls *int32 `json:"maxParallelImagePulls"`
 	// outOfDiskTransitionFrequency is duration for which the kubelet has to
 	// wait before transitioning out of out-of-disk node condition status.
 	OutOfDiskTransitionFrequency unversioned.Duration `json:"outOfDiskTransitionFrequency"`
@@ -462,6 +483,38 @@ type KubeletConfiguration struct {
 	EvictionMaxPodGracePeriod int32 `json:"evictionMaxPodGracePeriod"`
 	// Comma-delimited list of minimum reclaims (e.g. imagefs.available=2Gi) that describes the minimum amount of resource the kubelet will reclaim when performing a pod eviction if that resource is under pressure.
 	EvictionMinimumReclaim string `json:"evictionMinimumReclaim"`
+	// If enabled, the kubelet will integrate with the kernel memcg notification to determine if memory eviction thresholds are crossed rather than polling.
+	ExperimentalKernelMemcgNotification bool `json:"experimentalKernelMemcgNotification"`
+	// If enabled, the eviction manager will only record events about the evictions it would have performed.
+	ExperimentalEvictionDryRun bool `json:"experimentalEvictionDryRun"`
+	// Duration between eviction manager monitoring passes.
+	EvictionMonitoringPeriod unversioned.Duration `json:"evictionMonitoringPeriod"`
+	// If enabled, the eviction manager may evict multiple pods in a single monitoring pass under a hard eviction threshold, until the estimated reclaim relieves pressure.
+	ExperimentalEvictionEvictMultiplePods bool `json:"experimentalEvictionEvictMultiplePods"`
+	// Comma-delimited list of eviction thresholds (e.g. memory.available<100Mi) below which pod safe-to-evict annotations are ignored when selecting eviction victims.
+	EvictionEmergencyFloor string `json:"evictionEmergencyFloor"`
+	// If enabled, the eviction manager tightens its monitoring period while an eviction threshold is observed and relaxes it when the node is healthy.
+	ExperimentalEvictionAdaptiveMonitoring bool `json:"experimentalEvictionAdaptiveMonitoring"`
+	// If enabled, soft evictions honor the pod's full terminationGracePeriodSeconds even when it exceeds evictionMaxPodGracePeriod.
+	ExperimentalEvictionHonorPodGracePeriod bool `json:"experimentalEvictionHonorPodGracePeriod"`
+	// Number of hard eviction threshold crossings within a ten minute window after which the kubelet cordons itself.  A zero value disables cordoning.
+	ExperimentalEvictionCordonThreshold int32 `json:"experimentalEvictionCordonThreshold"`
+	// Comma-delimited list of per-filesystem eviction thresholds identified by mount path (e.g. /var/log<1Gi).
+	EvictionFilesystemThresholds string `json:"evictionFilesystemThresholds"`
+	// Comma-delimited list of eviction thresholds scoped to a top-level cgroup (e.g. kubepods<500Mi), evaluated against limit - usage of the memory cgroup.
+	EvictionCgroupThresholds string `json:"evictionCgroupThresholds"`
+	// The admission policy applied while the node reports memory pressure (best-effort, burstable, all).
+	ExperimentalEvictionAdmissionPolicy string `json:"experimentalEvictionAdmissionPolicy"`
+	// Percentage of inactive page cache counted as reclaimable when computing the memory.available eviction signal.
+	ExperimentalEvictionInactiveFilePercent int32 `json:"experimentalEvictionInactiveFilePercent"`
+	// Maximum number of pods evicted within any one hour window.  Set to 0 to disable the cap.
+	ExperimentalEvictionMaxPerHour int32 `json:"experimentalEvictionMaxPerHour"`
+	// Comma-delimited list of eviction notify periods (e.g. memory.available=30s) for how long before a soft eviction threshold triggers that the likely victim pod is pre-notified.
+	ExperimentalEvictionSoftNotifyPeriod string `json:"experimentalEvictionSoftNotifyPeriod"`
+	// When enabled, static pods are excluded from eviction ranking since the kubelet immediately restarts them after eviction.
+	ExperimentalEvictionSkipStaticPods bool `json:"experimentalEvictionSkipStaticPods"`
+	// Maximum age of the stats summary before eviction threshold evaluation is skipped.  Set to 0 to disable staleness checking.
+	ExperimentalEvictionStatsStaleness unversioned.Duration `json:"experimentalEvictionStatsStaleness"`
 	// Maximum number of pods per core. Cannot exceed MaxPods
 	PodsPerCore int32 `json:"podsPerCore"`
 	// enableControllerAttachDetach enables the Attach/Detach controller to
@@ -478,6 +531,21 @@ type KubeletConfiguration struct {
 	// Currently only cpu and memory are supported. [default=none]
 	// See http://kubernetes.io/docs/user-guide/compute-resources for more detail.
 	KubeReserved map[string]string `json:"kubeReserved"`
+	// shutdownGracePeriod is the total duration the kubelet delays an
+	// impending node shutdown to terminate pods gracefully. When zero,
+	// graceful node shutdown is disabled.
+	ShutdownGracePeriod unversioned.Duration `json:"shutdownGracePeriod"`
+	// shutdownGracePeriodCriticalPods is the portion of shutdownGracePeriod
+	// reserved for terminating critical pods after all other pods.
+	ShutdownGracePeriodCriticalPods unversioned.Duration `json:"shutdownGracePeriodCriticalPods"`
+	// cpuManagerPolicy is the name of the policy the CPU manager uses to
+	// assign CPUs to containers. The default policy, 'none', preserves the
+	// existing behavior. The 'static' policy pins containers of Guaranteed
+	// pods with integral CPU requests to exclusive CPUs.
+	CPUManagerPolicy string `json:"cpuManagerPolicy"`
+	// cpuManagerReconcilePeriod is the period the CPU manager reconciles the
+	// CPU assignments with the container cgroups.
+	CPUManagerReconcilePeriod unversioned.Duration `json:"cpuManagerReconcilePeriod"`
 	// Default behaviour for kernel tuning
 	ProtectKernelDefaults bool `json:"protectKernelDefaults"`
 	// If true, Kubelet ensures a set of iptables rules are present on host.
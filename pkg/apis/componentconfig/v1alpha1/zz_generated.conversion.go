@@ -279,6 +279,11 @@ func autoConvert_v1alpha1_KubeletConfiguration_To_componentconfig_KubeletConfigu
 	out.HostNetworkSources = *(*[]string)(unsafe.Pointer(&in.HostNetworkSources))
 	out.HostPIDSources = *(*[]string)(unsafe.Pointer(&in.HostPIDSources))
 	out.HostIPCSources = *(*[]string)(unsafe.Pointer(&in.HostIPCSources))
+	out.AllowedNotifySignals = *(*[]string)(unsafe.Pointer(&in.AllowedNotifySignals))
+	if err := api.Convert_Pointer_int32_To_int32(&in.NotifyQPS, &out.NotifyQPS, s); err != nil {
+		return err
+	}
+	out.NotifyBurst = in.NotifyBurst
 	if err := api.Convert_Pointer_int32_To_int32(&in.RegistryPullQPS, &out.RegistryPullQPS, s); err != nil {
 		return err
 	}
@@ -295,6 +300,10 @@ func autoConvert_v1alpha1_KubeletConfiguration_To_componentconfig_KubeletConfigu
 	if err := api.Convert_Pointer_int32_To_int32(&in.MaxContainerCount, &out.MaxContainerCount, s); err != nil {
 		return err
 	}
+	out.ContainerLogMaxSize = in.ContainerLogMaxSize
+	if err := api.Convert_Pointer_int32_To_int32(&in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles, s); err != nil {
+		return err
+	}
 	out.CAdvisorPort = in.CAdvisorPort
 	out.HealthzPort = in.HealthzPort
 	out.HealthzBindAddress = in.HealthzBindAddress
@@ -374,6 +383,9 @@ func autoConvert_v1alpha1_KubeletConfiguration_To_componentconfig_KubeletConfigu
 	if err := api.Convert_Pointer_bool_To_bool(&in.SerializeImagePulls, &out.SerializeImagePulls, s); err != nil {
 		return err
 	}
+	if err := api.Convert_Pointer_int32_To_int32(&in.MaxParallelImagePulls, &out.MaxParallelImagePulls, s); err != nil {
+		return err
+	}
 	out.OutOfDiskTransitionFrequency = in.OutOfDiskTransitionFrequency
 	out.NodeIP = in.NodeIP
 	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
@@ -387,12 +399,32 @@ func autoConvert_v1alpha1_KubeletConfiguration_To_componentconfig_KubeletConfigu
 	out.EvictionPressureTransitionPeriod = in.EvictionPressureTransitionPeriod
 	out.EvictionMaxPodGracePeriod = in.EvictionMaxPodGracePeriod
 	out.EvictionMinimumReclaim = in.EvictionMinimumReclaim
+	out.ExperimentalKernelMemcgNotification = in.ExperimentalKernelMemcgNotification
+	out.ExperimentalEvictionDryRun = in.ExperimentalEvictionDryRun
+	out.EvictionMonitoringPeriod = in.EvictionMonitoringPeriod
+	out.ExperimentalEvictionEvictMultiplePods = in.ExperimentalEvictionEvictMultiplePods
+	out.EvictionEmergencyFloor = in.EvictionEmergencyFloor
+	out.ExperimentalEvictionAdaptiveMonitoring = in.ExperimentalEvictionAdaptiveMonitoring
+	out.ExperimentalEvictionHonorPodGracePeriod = in.ExperimentalEvictionHonorPodGracePeriod
+	out.ExperimentalEvictionCordonThreshold = in.ExperimentalEvictionCordonThreshold
+	out.EvictionFilesystemThresholds = in.EvictionFilesystemThresholds
+	out.EvictionCgroupThresholds = in.EvictionCgroupThresholds
+	out.ExperimentalEvictionAdmissionPolicy = in.ExperimentalEvictionAdmissionPolicy
+	out.ExperimentalEvictionInactiveFilePercent = in.ExperimentalEvictionInactiveFilePercent
+	out.ExperimentalEvictionMaxPerHour = in.ExperimentalEvictionMaxPerHour
+	out.ExperimentalEvictionSoftNotifyPeriod = in.ExperimentalEvictionSoftNotifyPeriod
+	out.ExperimentalEvictionSkipStaticPods = in.ExperimentalEvictionSkipStaticPods
+	out.ExperimentalEvictionStatsStaleness = in.ExperimentalEvictionStatsStaleness
 	out.PodsPerCore = in.PodsPerCore
 	if err := api.Convert_Pointer_bool_To_bool(&in.EnableControllerAttachDetach, &out.EnableControllerAttachDetach, s); err != nil {
 		return err
 	}
 	out.SystemReserved = *(*config.ConfigurationMap)(unsafe.Pointer(&in.SystemReserved))
 	out.KubeReserved = *(*config.ConfigurationMap)(unsafe.Pointer(&in.KubeReserved))
+	out.ShutdownGracePeriod = in.ShutdownGracePeriod
+	out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
+	out.CPUManagerPolicy = in.CPUManagerPolicy
+	out.CPUManagerReconcilePeriod = in.CPUManagerReconcilePeriod
 	out.ProtectKernelDefaults = in.ProtectKernelDefaults
 	if err := api.Convert_Pointer_bool_To_bool(&in.MakeIPTablesUtilChains, &out.MakeIPTablesUtilChains, s); err != nil {
 		return err
@@ -448,6 +480,11 @@ func autoConvert_componentconfig_KubeletConfiguration_To_v1alpha1_KubeletConfigu
 	out.HostNetworkSources = *(*[]string)(unsafe.Pointer(&in.HostNetworkSources))
 	out.HostPIDSources = *(*[]string)(unsafe.Pointer(&in.HostPIDSources))
 	out.HostIPCSources = *(*[]string)(unsafe.Pointer(&in.HostIPCSources))
+	out.AllowedNotifySignals = *(*[]string)(unsafe.Pointer(&in.AllowedNotifySignals))
+	if err := api.Convert_int32_To_Pointer_int32(&in.NotifyQPS, &out.NotifyQPS, s); err != nil {
+		return err
+	}
+	out.NotifyBurst = in.NotifyBurst
 	if err := api.Convert_int32_To_Pointer_int32(&in.RegistryPullQPS, &out.RegistryPullQPS, s); err != nil {
 		return err
 	}
@@ -464,6 +501,10 @@ func autoConvert_componentconfig_KubeletConfiguration_To_v1alpha1_KubeletConfigu
 	if err := api.Convert_int32_To_Pointer_int32(&in.MaxContainerCount, &out.MaxContainerCount, s); err != nil {
 		return err
 	}
+	out.ContainerLogMaxSize = in.ContainerLogMaxSize
+	if err := api.Convert_int32_To_Pointer_int32(&in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles, s); err != nil {
+		return err
+	}
 	out.CAdvisorPort = in.CAdvisorPort
 	out.HealthzPort = in.HealthzPort
 	out.HealthzBindAddress = in.HealthzBindAddress
@@ -543,6 +584,9 @@ func autoConvert_componentconfig_KubeletConfiguration_To_v1alpha1_KubeletConfigu
 	if err := api.Convert_bool_To_Pointer_bool(&in.SerializeImagePulls, &out.SerializeImagePulls, s); err != nil {
 		return err
 	}
+	if err := api.Convert_int32_To_Pointer_int32(&in.MaxParallelImagePulls, &out.MaxParallelImagePulls, s); err != nil {
+		return err
+	}
 	out.OutOfDiskTransitionFrequency = in.OutOfDiskTransitionFrequency
 	out.NodeIP = in.NodeIP
 	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
@@ -556,12 +600,32 @@ func autoConvert_componentconfig_KubeletConfiguration_To_v1alpha1_KubeletConfigu
 	out.EvictionPressureTransitionPeriod = in.EvictionPressureTransitionPeriod
 	out.EvictionMaxPodGracePeriod = in.EvictionMaxPodGracePeriod
 	out.EvictionMinimumReclaim = in.EvictionMinimumReclaim
+	out.ExperimentalKernelMemcgNotification = in.ExperimentalKernelMemcgNotification
+	out.ExperimentalEvictionDryRun = in.ExperimentalEvictionDryRun
+	out.EvictionMonitoringPeriod = in.EvictionMonitoringPeriod
+	out.ExperimentalEvictionEvictMultiplePods = in.ExperimentalEvictionEvictMultiplePods
+	out.EvictionEmergencyFloor = in.EvictionEmergencyFloor
+	out.ExperimentalEvictionAdaptiveMonitoring = in.ExperimentalEvictionAdaptiveMonitoring
+	out.ExperimentalEvictionHonorPodGracePeriod = in.ExperimentalEvictionHonorPodGracePeriod
+	out.ExperimentalEvictionCordonThreshold = in.ExperimentalEvictionCordonThreshold
+	out.EvictionFilesystemThresholds = in.EvictionFilesystemThresholds
+	out.EvictionCgroupThresholds = in.EvictionCgroupThresholds
+	out.ExperimentalEvictionAdmissionPolicy = in.ExperimentalEvictionAdmissionPolicy
+	out.ExperimentalEvictionInactiveFilePercent = in.ExperimentalEvictionInactiveFilePercent
+	out.ExperimentalEvictionMaxPerHour = in.ExperimentalEvictionMaxPerHour
+	out.ExperimentalEvictionSoftNotifyPeriod = in.ExperimentalEvictionSoftNotifyPeriod
+	out.ExperimentalEvictionSkipStaticPods = in.ExperimentalEvictionSkipStaticPods
+	out.ExperimentalEvictionStatsStaleness = in.ExperimentalEvictionStatsStaleness
 	out.PodsPerCore = in.PodsPerCore
 	if err := api.Convert_bool_To_Pointer_bool(&in.EnableControllerAttachDetach, &out.EnableControllerAttachDetach, s); err != nil {
 		return err
 	}
 	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
 	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
+	out.ShutdownGracePeriod = in.ShutdownGracePeriod
+	out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
+	out.CPUManagerPolicy = in.CPUManagerPolicy
+	out.CPUManagerReconcilePeriod = in.CPUManagerReconcilePeriod
 	out.ProtectKernelDefaults = in.ProtectKernelDefaults
 	if err := api.Convert_bool_To_Pointer_bool(&in.MakeIPTablesUtilChains, &out.MakeIPTablesUtilChains, s); err != nil {
 		return err
@@ -219,6 +219,21 @@ func DeepCopy_v1alpha1_KubeletConfiguration(in interface{}, out interface{}, c *
 		} else {
 			out.HostIPCSources = nil
 		}
+		if in.AllowedNotifySignals != nil {
+			in, out := &in.AllowedNotifySignals, &out.AllowedNotifySignals
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.AllowedNotifySignals = nil
+		}
+		if in.NotifyQPS != nil {
+			in, out := &in.NotifyQPS, &out.NotifyQPS
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.NotifyQPS = nil
+		}
+		out.NotifyBurst = in.NotifyBurst
 		if in.RegistryPullQPS != nil {
 			in, out := &in.RegistryPullQPS, &out.RegistryPullQPS
 			*out = new(int32)
@@ -251,6 +266,13 @@ func DeepCopy_v1alpha1_KubeletConfiguration(in interface{}, out interface{}, c *
 		} else {
 			out.MaxContainerCount = nil
 		}
+		if in.ContainerLogMaxFiles != nil {
+			in, out := &in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.ContainerLogMaxFiles = nil
+		}
 		out.CAdvisorPort = in.CAdvisorPort
 		out.HealthzPort = in.HealthzPort
 		out.HealthzBindAddress = in.HealthzBindAddress
@@ -378,6 +400,13 @@ func DeepCopy_v1alpha1_KubeletConfiguration(in interface{}, out interface{}, c *
 		} else {
 			out.SerializeImagePulls = nil
 		}
+		if in.MaxParallelImagePulls != nil {
+			in, out := &in.MaxParallelImagePulls, &out.MaxParallelImagePulls
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.MaxParallelImagePulls = nil
+		}
 		out.OutOfDiskTransitionFrequency = in.OutOfDiskTransitionFrequency
 		out.NodeIP = in.NodeIP
 		if in.NodeLabels != nil {
@@ -403,6 +432,22 @@ func DeepCopy_v1alpha1_KubeletConfiguration(in interface{}, out interface{}, c *
 		out.EvictionPressureTransitionPeriod = in.EvictionPressureTransitionPeriod
 		out.EvictionMaxPodGracePeriod = in.EvictionMaxPodGracePeriod
 		out.EvictionMinimumReclaim = in.EvictionMinimumReclaim
+		out.ExperimentalKernelMemcgNotification = in.ExperimentalKernelMemcgNotification
+		out.ExperimentalEvictionDryRun = in.ExperimentalEvictionDryRun
+		out.EvictionMonitoringPeriod = in.EvictionMonitoringPeriod
+		out.ExperimentalEvictionEvictMultiplePods = in.ExperimentalEvictionEvictMultiplePods
+		out.EvictionEmergencyFloor = in.EvictionEmergencyFloor
+		out.ExperimentalEvictionAdaptiveMonitoring = in.ExperimentalEvictionAdaptiveMonitoring
+		out.ExperimentalEvictionHonorPodGracePeriod = in.ExperimentalEvictionHonorPodGracePeriod
+		out.ExperimentalEvictionCordonThreshold = in.ExperimentalEvictionCordonThreshold
+		out.EvictionFilesystemThresholds = in.EvictionFilesystemThresholds
+		out.EvictionCgroupThresholds = in.EvictionCgroupThresholds
+		out.ExperimentalEvictionAdmissionPolicy = in.ExperimentalEvictionAdmissionPolicy
+		out.ExperimentalEvictionInactiveFilePercent = in.ExperimentalEvictionInactiveFilePercent
+		out.ExperimentalEvictionMaxPerHour = in.ExperimentalEvictionMaxPerHour
+		out.ExperimentalEvictionSoftNotifyPeriod = in.ExperimentalEvictionSoftNotifyPeriod
+		out.ExperimentalEvictionSkipStaticPods = in.ExperimentalEvictionSkipStaticPods
+		out.ExperimentalEvictionStatsStaleness = in.ExperimentalEvictionStatsStaleness
 		out.PodsPerCore = in.PodsPerCore
 		if in.EnableControllerAttachDetach != nil {
 			in, out := &in.EnableControllerAttachDetach, &out.EnableControllerAttachDetach
@@ -429,6 +474,10 @@ func DeepCopy_v1alpha1_KubeletConfiguration(in interface{}, out interface{}, c *
 		} else {
 			out.KubeReserved = nil
 		}
+		out.ShutdownGracePeriod = in.ShutdownGracePeriod
+		out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
+		out.CPUManagerPolicy = in.CPUManagerPolicy
+		out.CPUManagerReconcilePeriod = in.CPUManagerReconcilePeriod
 		out.ProtectKernelDefaults = in.ProtectKernelDefaults
 		if in.MakeIPTablesUtilChains != nil {
 			in, out := &in.MakeIPTablesUtilChains, &out.MakeIPTablesUtilChains
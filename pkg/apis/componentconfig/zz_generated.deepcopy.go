@@ -276,6 +276,15 @@ func DeepCopy_componentconfig_KubeletConfiguration(in interface{}, out interface
 		} else {
 			out.HostIPCSources = nil
 		}
+		if in.AllowedNotifySignals != nil {
+			in, out := &in.AllowedNotifySignals, &out.AllowedNotifySignals
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		} else {
+			out.AllowedNotifySignals = nil
+		}
+		out.NotifyQPS = in.NotifyQPS
+		out.NotifyBurst = in.NotifyBurst
 		out.RegistryPullQPS = in.RegistryPullQPS
 		out.RegistryBurst = in.RegistryBurst
 		out.EventRecordQPS = in.EventRecordQPS
@@ -358,6 +367,22 @@ func DeepCopy_componentconfig_KubeletConfiguration(in interface{}, out interface
 		out.EvictionPressureTransitionPeriod = in.EvictionPressureTransitionPeriod
 		out.EvictionMaxPodGracePeriod = in.EvictionMaxPodGracePeriod
 		out.EvictionMinimumReclaim = in.EvictionMinimumReclaim
+		out.ExperimentalKernelMemcgNotification = in.ExperimentalKernelMemcgNotification
+		out.ExperimentalEvictionDryRun = in.ExperimentalEvictionDryRun
+		out.EvictionMonitoringPeriod = in.EvictionMonitoringPeriod
+		out.ExperimentalEvictionEvictMultiplePods = in.ExperimentalEvictionEvictMultiplePods
+		out.EvictionEmergencyFloor = in.EvictionEmergencyFloor
+		out.ExperimentalEvictionAdaptiveMonitoring = in.ExperimentalEvictionAdaptiveMonitoring
+		out.ExperimentalEvictionHonorPodGracePeriod = in.ExperimentalEvictionHonorPodGracePeriod
+		out.ExperimentalEvictionCordonThreshold = in.ExperimentalEvictionCordonThreshold
+		out.EvictionFilesystemThresholds = in.EvictionFilesystemThresholds
+		out.EvictionCgroupThresholds = in.EvictionCgroupThresholds
+		out.ExperimentalEvictionAdmissionPolicy = in.ExperimentalEvictionAdmissionPolicy
+		out.ExperimentalEvictionInactiveFilePercent = in.ExperimentalEvictionInactiveFilePercent
+		out.ExperimentalEvictionMaxPerHour = in.ExperimentalEvictionMaxPerHour
+		out.ExperimentalEvictionSoftNotifyPeriod = in.ExperimentalEvictionSoftNotifyPeriod
+		out.ExperimentalEvictionSkipStaticPods = in.ExperimentalEvictionSkipStaticPods
+		out.ExperimentalEvictionStatsStaleness = in.ExperimentalEvictionStatsStaleness
 		out.PodsPerCore = in.PodsPerCore
 		out.EnableControllerAttachDetach = in.EnableControllerAttachDetach
 		if in.SystemReserved != nil {
@@ -378,6 +403,10 @@ func DeepCopy_componentconfig_KubeletConfiguration(in interface{}, out interface
 		} else {
 			out.KubeReserved = nil
 		}
+		out.ShutdownGracePeriod = in.ShutdownGracePeriod
+		out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
+		out.CPUManagerPolicy = in.CPUManagerPolicy
+		out.CPUManagerReconcilePeriod = in.CPUManagerReconcilePeriod
 		out.ProtectKernelDefaults = in.ProtectKernelDefaults
 		out.MakeIPTablesUtilChains = in.MakeIPTablesUtilChains
 		out.IPTablesMasqueradeBit = in.IPTablesMasqueradeBit
@@ -86,6 +86,9 @@ var _ http.Hijacker = &fancyResponseWriterDelegator{}
 // 2. the response line containing:
 //    - the unique id from 1
 //    - response code
+// Requests for the pods/notify subresource trigger an in-container action, so
+// they additionally log a line with the same id naming the pod, container and
+// notification being delivered; the result is on the matching response line.
 func WithAudit(handler http.Handler, attributeGetter RequestAttributeGetter, out io.Writer) http.Handler {
 	if out == nil {
 		return handler
@@ -120,6 +123,22 @@ func WithAudit(handler http.Handler, attributeGetter RequestAttributeGetter, out
 		if _, err := fmt.Fprint(out, line); err != nil {
 			glog.Errorf("Unable to write audit log: %s, the error is: %v", line, err)
 		}
+		if attribs.GetResource() == "pods" && attribs.GetSubresource() == "notify" {
+			query := req.URL.Query()
+			notification := query.Get("message")
+			if len(notification) == 0 {
+				notification = query.Get("signal")
+			}
+			container := query.Get("container")
+			if len(container) == 0 {
+				container = "<default>"
+			}
+			line := fmt.Sprintf("%s AUDIT: id=%q pod=%q container=%q notification=%q\n",
+				time.Now().Format(time.RFC3339Nano), id, attribs.GetName(), container, notification)
+			if _, err := fmt.Fprint(out, line); err != nil {
+				glog.Errorf("Unable to write audit log: %s, the error is: %v", line, err)
+			}
+		}
 		respWriter := decorateResponseWriter(w, out, id)
 		handler.ServeHTTP(respWriter, req)
 	})
@@ -102,6 +102,37 @@ func TestAudit(t *testing.T) {
 	}
 }
 
+func TestAuditNotify(t *testing.T) {
+	var buf bytes.Buffer
+
+	attributeGetter := NewRequestAttributeGetter(&fakeRequestContextMapper{
+		user: &user.DefaultInfo{Name: "admin"},
+	})
+	handler := WithAudit(&fakeHTTPHandler{}, attributeGetter, &buf)
+
+	req, _ := http.NewRequest("POST", "/api/v1/namespaces/default/pods/foo/notify?container=bar&message=reload", nil)
+	req.RemoteAddr = "127.0.0.1"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	line := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(line) != 3 {
+		t.Fatalf("Unexpected amount of lines in audit log: %d", len(line))
+	}
+	match, err := regexp.MatchString(`[\d\:\-\.\+TZ]+ AUDIT: id="[\w-]+" pod="foo" container="bar" notification="reload"`, line[1])
+	if err != nil {
+		t.Errorf("Unexpected error matching notify line: %v", err)
+	}
+	if !match {
+		t.Errorf("Unexpected notify line of audit: %s", line[1])
+	}
+	match, err = regexp.MatchString(`[\d\:\-\.\+TZ]+ AUDIT: id="[\w-]+" response="200"`, line[2])
+	if err != nil {
+		t.Errorf("Unexpected error matching response line: %v", err)
+	}
+	if !match {
+		t.Errorf("Unexpected response line of audit: %s", line[2])
+	}
+}
+
 type fakeRequestContextMapper struct {
 	user *user.DefaultInfo
 }
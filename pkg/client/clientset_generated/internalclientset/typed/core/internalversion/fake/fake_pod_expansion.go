@@ -44,3 +44,15 @@ func (c *FakePods) GetLogs(name string, opts *api.PodLogOptions) *restclient.Req
 	_, _ = c.Fake.Invokes(action, &api.Pod{})
 	return &restclient.Request{}
 }
+
+func (c *FakePods) Notify(name string, opts *api.PodNotifyOptions) *restclient.Request {
+	action := core.GenericActionImpl{}
+	action.Verb = "create"
+	action.Namespace = c.ns
+	action.Resource = podsResource
+	action.Subresource = "notify"
+	action.Value = opts
+
+	_, _ = c.Fake.Invokes(action, &api.Pod{})
+	return &restclient.Request{}
+}
@@ -25,6 +25,7 @@ import (
 type PodExpansion interface {
 	Bind(binding *api.Binding) error
 	GetLogs(name string, opts *api.PodLogOptions) *restclient.Request
+	Notify(name string, opts *api.PodNotifyOptions) *restclient.Request
 }
 
 // Bind applies the provided binding to the named pod in the current namespace (binding.Namespace is ignored).
@@ -36,3 +37,8 @@ func (c *pods) Bind(binding *api.Binding) error {
 func (c *pods) GetLogs(name string, opts *api.PodLogOptions) *restclient.Request {
 	return c.client.Get().Namespace(c.ns).Name(name).Resource("pods").SubResource("log").VersionedParams(opts, api.ParameterCodec)
 }
+
+// Notify constructs a request for delivering a notification to a pod
+func (c *pods) Notify(name string, opts *api.PodNotifyOptions) *restclient.Request {
+	return c.client.Post().Namespace(c.ns).Name(name).Resource("pods").SubResource("notify").VersionedParams(opts, api.ParameterCodec)
+}
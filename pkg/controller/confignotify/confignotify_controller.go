@@ -0,0 +1,255 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confignotify
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/metrics"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+	"k8s.io/kubernetes/pkg/util/workqueue"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// NotificationAnnotation is the annotation on a ConfigMap naming the
+	// notification to deliver to referencing pods whenever the ConfigMap's
+	// data changes.
+	NotificationAnnotation = "notify.alpha.kubernetes.io/notification"
+)
+
+var (
+	keyFunc = cache.DeletionHandlingMetaNamespaceKeyFunc
+)
+
+// ConfigNotifyController delivers notifications to pods when ConfigMaps they
+// reference change.
+type ConfigNotifyController struct {
+	client clientset.Interface
+
+	configMapStore cache.Store
+	podStore       cache.StoreToPodLister
+
+	configMapController *cache.Controller
+	podController       cache.ControllerInterface
+	// podStoreSynced returns true if the pod store has been synced at least once.
+	// Added as a member to the struct to allow injection for testing.
+	podStoreSynced func() bool
+
+	// ConfigMaps whose referencing pods need to be notified.
+	queue workqueue.RateLimitingInterface
+
+	// notifyPod delivers a notification to a single container. Added as a
+	// member to the struct to allow injection for testing.
+	notifyPod func(namespace, pod, container, message string) error
+}
+
+// NewConfigNotifyController returns a new *ConfigNotifyController.
+func NewConfigNotifyController(podInformer cache.SharedIndexInformer, client clientset.Interface) *ConfigNotifyController {
+	if client != nil && client.Core().RESTClient().GetRateLimiter() != nil {
+		metrics.RegisterMetricAndTrackRateLimiterUsage("confignotify_controller", client.Core().RESTClient().GetRateLimiter())
+	}
+	cnc := &ConfigNotifyController{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "confignotify"),
+	}
+	cnc.notifyPod = func(namespace, pod, container, message string) error {
+		_, err := client.Core().Pods(namespace).Notify(pod, &api.PodNotifyOptions{
+			Container: container,
+			Message:   message,
+		}).DoRaw()
+		return err
+	}
+
+	cnc.configMapStore, cnc.configMapController = cache.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return cnc.client.Core().ConfigMaps(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return cnc.client.Core().ConfigMaps(api.NamespaceAll).Watch(options)
+			},
+		},
+		&api.ConfigMap{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: cnc.updateConfigMap,
+		},
+	)
+
+	cnc.podStore.Indexer = podInformer.GetIndexer()
+	cnc.podController = podInformer.GetController()
+	cnc.podStoreSynced = podInformer.HasSynced
+
+	return cnc
+}
+
+// Run starts the controller; it will not return until stopCh is closed.
+// workers determines how many ConfigMaps are processed in parallel.
+func (cnc *ConfigNotifyController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer cnc.queue.ShutDown()
+
+	go cnc.configMapController.Run(stopCh)
+	go cnc.podController.Run(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, cnc.podStoreSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(cnc.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// old and cur must be *api.ConfigMap types.
+func (cnc *ConfigNotifyController) updateConfigMap(old, cur interface{}) {
+	oldMap := old.(*api.ConfigMap)
+	curMap := cur.(*api.ConfigMap)
+	if curMap.ResourceVersion == oldMap.ResourceVersion {
+		// Periodic resync will send update events for all known ConfigMaps.
+		// Two different versions of the same ConfigMap will always have different RVs.
+		return
+	}
+	if len(curMap.Annotations[NotificationAnnotation]) == 0 {
+		return
+	}
+	if reflect.DeepEqual(oldMap.Data, curMap.Data) {
+		return
+	}
+	key, err := keyFunc(cur)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("Couldn't get key for object %+v: %v", cur, err))
+		return
+	}
+	cnc.queue.Add(key)
+}
+
+// worker runs a worker thread that just dequeues items, processes them, and
+// marks them done.
+func (cnc *ConfigNotifyController) worker() {
+	for cnc.processNextWorkItem() {
+	}
+}
+
+func (cnc *ConfigNotifyController) processNextWorkItem() bool {
+	key, quit := cnc.queue.Get()
+	if quit {
+		return false
+	}
+	defer cnc.queue.Done(key)
+
+	err := cnc.syncConfigMap(key.(string))
+	if err == nil {
+		cnc.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("Sync %v failed with %v", key, err))
+	cnc.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (cnc *ConfigNotifyController) syncConfigMap(key string) error {
+	startTime := time.Now()
+	defer func() {
+		glog.V(4).Infof("Finished notifying for ConfigMap %q. (%v)", key, time.Now().Sub(startTime))
+	}()
+
+	obj, exists, err := cnc.configMapStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The ConfigMap was deleted before we got to it; nothing to notify.
+		return nil
+	}
+	configMap := obj.(*api.ConfigMap)
+	message := configMap.Annotations[NotificationAnnotation]
+	if len(message) == 0 {
+		return nil
+	}
+
+	pods, err := cnc.podStore.Pods(configMap.Namespace).List(labels.Everything())
+	if err != nil {
+		// Since we're getting stuff from a local cache, it is
+		// basically impossible to get this error.
+		return err
+	}
+
+	var errs []error
+	for i := range pods {
+		pod := pods[i]
+		if pod.Status.Phase != api.PodRunning {
+			continue
+		}
+		if !podReferencesConfigMap(pod, configMap.Name) {
+			continue
+		}
+		for j := range pod.Spec.Containers {
+			container := &pod.Spec.Containers[j]
+			if !containerDeclaresNotification(container, message) {
+				continue
+			}
+			if err := cnc.notifyPod(pod.Namespace, pod.Name, container.Name, message); err != nil {
+				errs = append(errs, fmt.Errorf("failed to notify container %q of pod %q: %v", container.Name, pod.Name, err))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// podReferencesConfigMap returns true if the pod mounts the named ConfigMap as
+// a volume or reads it through a container's environment.
+func podReferencesConfigMap(pod *api.Pod, name string) bool {
+	for i := range pod.Spec.Volumes {
+		if source := pod.Spec.Volumes[i].ConfigMap; source != nil && source.Name == name {
+			return true
+		}
+	}
+	for i := range pod.Spec.Containers {
+		for _, env := range pod.Spec.Containers[i].Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containerDeclaresNotification(container *api.Container, name string) bool {
+	for i := range container.Notifications {
+		if container.Notifications[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
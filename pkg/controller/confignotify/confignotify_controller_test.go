@@ -0,0 +1,214 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confignotify
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/controller/informers"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+func newController() *ConfigNotifyController {
+	client := fake.NewSimpleClientset()
+	podInformer := informers.NewPodInformer(client, controller.NoResyncPeriodFunc())
+	return NewConfigNotifyController(podInformer, client)
+}
+
+func newNotifyPod(name, phase string, configMapName string, notifications ...string) *api.Pod {
+	declared := []api.ContainerNotification{}
+	for _, n := range notifications {
+		declared = append(declared, api.ContainerNotification{
+			Name:    n,
+			Handler: api.NotificationHandler{Exec: &api.ExecAction{Command: []string{"handle"}}},
+		})
+	}
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{Name: "ctr", Notifications: declared},
+			},
+		},
+		Status: api.PodStatus{Phase: api.PodPhase(phase)},
+	}
+	if len(configMapName) > 0 {
+		pod.Spec.Volumes = []api.Volume{
+			{
+				Name: "config",
+				VolumeSource: api.VolumeSource{
+					ConfigMap: &api.ConfigMapVolumeSource{
+						LocalObjectReference: api.LocalObjectReference{Name: configMapName},
+					},
+				},
+			},
+		}
+	}
+	return pod
+}
+
+func newConfigMap(name, notification string, data map[string]string) *api.ConfigMap {
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Data:       data,
+	}
+	if len(notification) > 0 {
+		configMap.Annotations = map[string]string{NotificationAnnotation: notification}
+	}
+	return configMap
+}
+
+func TestSyncConfigMapNotifiesReferencingPods(t *testing.T) {
+	testCases := []struct {
+		name         string
+		pods         []*api.Pod
+		notifiedPods sets.String
+	}{
+		{
+			name: "running pod mounting the configmap is notified",
+			pods: []*api.Pod{
+				newNotifyPod("a", string(api.PodRunning), "settings", "reload"),
+			},
+			notifiedPods: sets.NewString("a"),
+		},
+		{
+			name: "pod without the notification handler is skipped",
+			pods: []*api.Pod{
+				newNotifyPod("a", string(api.PodRunning), "settings", "reload"),
+				newNotifyPod("b", string(api.PodRunning), "settings", "dump-state"),
+			},
+			notifiedPods: sets.NewString("a"),
+		},
+		{
+			name: "pod not referencing the configmap is skipped",
+			pods: []*api.Pod{
+				newNotifyPod("a", string(api.PodRunning), "other", "reload"),
+			},
+			notifiedPods: sets.NewString(),
+		},
+		{
+			name: "non-running pod is skipped",
+			pods: []*api.Pod{
+				newNotifyPod("a", string(api.PodPending), "settings", "reload"),
+			},
+			notifiedPods: sets.NewString(),
+		},
+	}
+
+	for _, test := range testCases {
+		cnc := newController()
+		notifiedPods := sets.NewString()
+		cnc.notifyPod = func(namespace, pod, container, message string) error {
+			notifiedPods.Insert(pod)
+			return nil
+		}
+
+		configMap := newConfigMap("settings", "reload", map[string]string{"level": "debug"})
+		cnc.configMapStore.Add(configMap)
+		for _, pod := range test.pods {
+			cnc.podStore.Indexer.Add(pod)
+		}
+
+		key, err := keyFunc(configMap)
+		if err != nil {
+			t.Fatalf("%s: couldn't get key for configmap: %v", test.name, err)
+		}
+		if err := cnc.syncConfigMap(key); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !notifiedPods.Equal(test.notifiedPods) {
+			t.Errorf("%s: expected notified pods %v, got %v", test.name, test.notifiedPods.List(), notifiedPods.List())
+		}
+	}
+}
+
+func TestSyncConfigMapEnvReference(t *testing.T) {
+	cnc := newController()
+	notifiedPods := sets.NewString()
+	cnc.notifyPod = func(namespace, pod, container, message string) error {
+		notifiedPods.Insert(pod)
+		return nil
+	}
+
+	pod := newNotifyPod("a", string(api.PodRunning), "", "reload")
+	pod.Spec.Containers[0].Env = []api.EnvVar{
+		{
+			Name: "LOG_LEVEL",
+			ValueFrom: &api.EnvVarSource{
+				ConfigMapKeyRef: &api.ConfigMapKeySelector{
+					LocalObjectReference: api.LocalObjectReference{Name: "settings"},
+					Key:                  "level",
+				},
+			},
+		},
+	}
+	cnc.podStore.Indexer.Add(pod)
+
+	configMap := newConfigMap("settings", "reload", map[string]string{"level": "debug"})
+	cnc.configMapStore.Add(configMap)
+
+	key, err := keyFunc(configMap)
+	if err != nil {
+		t.Fatalf("couldn't get key for configmap: %v", err)
+	}
+	if err := cnc.syncConfigMap(key); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !notifiedPods.Has("a") {
+		t.Errorf("expected pod reading the configmap through its environment to be notified")
+	}
+}
+
+func TestUpdateConfigMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      *api.ConfigMap
+		cur      *api.ConfigMap
+		enqueued bool
+	}{
+		{
+			name:     "data change enqueues",
+			old:      newConfigMap("settings", "reload", map[string]string{"level": "info"}),
+			cur:      newConfigMap("settings", "reload", map[string]string{"level": "debug"}),
+			enqueued: true,
+		},
+		{
+			name:     "unannotated configmap is ignored",
+			old:      newConfigMap("settings", "", map[string]string{"level": "info"}),
+			cur:      newConfigMap("settings", "", map[string]string{"level": "debug"}),
+			enqueued: false,
+		},
+		{
+			name:     "unchanged data is ignored",
+			old:      newConfigMap("settings", "reload", map[string]string{"level": "info"}),
+			cur:      newConfigMap("settings", "reload", map[string]string{"level": "info"}),
+			enqueued: false,
+		},
+	}
+
+	for _, test := range testCases {
+		cnc := newController()
+		test.cur.ResourceVersion = "2"
+		cnc.updateConfigMap(test.old, test.cur)
+		if enqueued := cnc.queue.Len() > 0; enqueued != test.enqueued {
+			t.Errorf("%s: expected enqueued=%v, got %v", test.name, test.enqueued, enqueued)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package confignotify contains a controller that watches ConfigMaps
+// annotated with a notification name and, whenever the data of such a
+// ConfigMap changes, delivers that notification to every running pod that
+// references the ConfigMap and declares a matching notification handler.
+package confignotify // import "k8s.io/kubernetes/pkg/controller/confignotify"
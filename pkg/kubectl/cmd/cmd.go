@@ -125,6 +125,36 @@ __kubectl_get_containers()
     fi
 }
 
+# Complete the notifications declared by the containers of the pod named in
+# the previous word
+__kubectl_get_notifications()
+{
+    local template
+    template="{{ range .spec.containers }}{{ range .notifications }}{{ .name }} {{ end }}{{ end }}"
+    __debug "${FUNCNAME} nouns are ${nouns[*]}"
+
+    local len="${#nouns[@]}"
+    if [[ ${len} -ne 1 ]]; then
+        return
+    fi
+    local last=${nouns[${len} -1]}
+    local kubectl_out
+    if kubectl_out=$(kubectl get $(__kubectl_override_flags) -o template --template="${template}" pods "${last}" 2>/dev/null); then
+        COMPREPLY=( $( compgen -W "${kubectl_out[*]}" -- "$cur" ) )
+    fi
+}
+
+# Complete a pod first, then the notifications it declares
+__kubectl_require_pod_and_notification()
+{
+    if [[ ${#nouns[@]} -eq 0 ]]; then
+        __kubectl_parse_get pods
+        return 0
+    fi;
+    __kubectl_get_notifications
+    return 0
+}
+
 # Require both a pod and a container to be specified
 __kubectl_require_pod_and_container()
 {
@@ -147,6 +177,10 @@ __custom_func() {
             __kubectl_require_pod_and_container
             return
             ;;
+        kubectl_notify)
+            __kubectl_require_pod_and_notification
+            return
+            ;;
         kubectl_exec | kubectl_port-forward | kubectl_top_pod)
             __kubectl_get_resource_pod
             return
@@ -268,6 +302,7 @@ func NewKubectlCommand(f cmdutil.Factory, in io.Reader, out, err io.Writer) *cob
 				NewCmdLogs(f, out),
 				NewCmdAttach(f, in, out, err),
 				NewCmdExec(f, in, out, err),
+				NewCmdNotify(f, in, out, err),
 				NewCmdPortForward(f, out, err),
 				NewCmdProxy(f, out),
 				NewCmdCp(f, in, out, err),
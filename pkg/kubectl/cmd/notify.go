@@ -0,0 +1,962 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	coreclient "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+var (
+	notify_example = templates.Examples(`
+		# Deliver the 'reload' notification to pod 123456-7890, using the first container by default
+		kubectl notify 123456-7890 reload
+
+		# Deliver the 'reload' notification to ruby-container from pod 123456-7890
+		kubectl notify 123456-7890 reload -c ruby-container
+
+		# Deliver 'reload' only if the pod still is the instance with the given UID
+		kubectl notify 123456-7890 reload --uid=2d392868-1e7d-11e6-a383-42010af00002
+
+		# Deliver 'set-log-level' with a payload read from a file
+		kubectl notify 123456-7890 set-log-level -f payload.json
+
+		# Deliver 'set-log-level' substituting $(LEVEL) in the declared handler
+		kubectl notify 123456-7890 set-log-level --param LEVEL=debug
+
+		# Send SIGHUP to ruby-container without a declared notification handler
+		kubectl notify 123456-7890 --signal=SIGHUP -c ruby-container
+
+		# Deliver 'reload' to every pod of a deployment, at most a quarter of them at a time
+		kubectl notify deployment/my-app reload --max-concurrent=25%
+
+		# Allow the handler 5 seconds to complete and retry up to 3 times on timeout
+		kubectl notify 123456-7890 reload --timeout=5s --retries=3
+
+		# Validate that the pod declares 'reload' without delivering it
+		kubectl notify 123456-7890 reload --dry-run
+
+		# Deliver 'reload' and print the structured result for scripts
+		kubectl notify 123456-7890 reload -o json
+
+		# Deliver 'reload' to every container of the pod in one request
+		kubectl notify 123456-7890 reload --all-containers
+
+		# Stream the 'debug-dump' handler's output until it completes
+		kubectl notify 123456-7890 debug-dump --follow
+
+		# List the notifications each container of the pod declares
+		kubectl notify 123456-7890 --list
+
+		# Broadcast 'node-maintenance-imminent' to every pod on a node that declares it
+		kubectl notify node/worker-1 node-maintenance-imminent`)
+)
+
+const (
+	notifyUsageStr = "expected 'notify (POD | TYPE/NAME) NOTIFICATION' or 'notify (POD | TYPE/NAME) --signal=SIGNAL'.\nPOD or TYPE/NAME and either NOTIFICATION or --signal are required arguments for the notify command"
+)
+
+func NewCmdNotify(f cmdutil.Factory, cmdIn io.Reader, cmdOut, cmdErr io.Writer) *cobra.Command {
+	options := &NotifyOptions{
+		In:  cmdIn,
+		Out: cmdOut,
+		Err: cmdErr,
+	}
+	cmd := &cobra.Command{
+		Use:     "notify (POD | TYPE/NAME) NOTIFICATION [-c CONTAINER]",
+		Short:   "Deliver a notification to a container",
+		Long:    "Deliver a named notification declared by a container in a pod, or fan it out to all pods of a workload.",
+		Example: notify_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.Complete(f, cmd, args))
+			cmdutil.CheckErr(options.Validate())
+			cmdutil.CheckErr(options.Run())
+		},
+	}
+	cmd.Flags().StringVarP(&options.ContainerName, "container", "c", "", "Container name. If omitted, the first container in the pod will be chosen")
+	cmd.Flags().BoolVar(&options.AllContainers, "all-containers", false, "Deliver the notification to every container of the pod in a single request")
+	cmd.Flags().StringVar(&options.UID, "uid", "", "The pod UID the notification is addressed to. Delivery fails if the running pod has a different UID")
+	cmd.Flags().StringVar(&options.Signal, "signal", "", "Deliver a raw POSIX signal (e.g. SIGHUP) to the container instead of a named notification")
+	cmd.Flags().StringSliceVar(&options.Params, "param", nil, "KEY=VALUE pair substituted for the $(KEY) placeholder in the notification handler. May be given several times")
+	cmd.Flags().StringVarP(&options.Filename, "filename", "f", "", "File containing a payload to pass to the notification handler")
+	cmd.Flags().BoolVarP(&options.Stdin, "stdin", "i", false, "Read the notification payload from stdin")
+	cmd.Flags().StringVar(&options.MaxConcurrent, "max-concurrent", "1", "Maximum number of pods notified at the same time when targeting a workload, as an absolute number or a percentage of its pods (e.g. 25%)")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", 0, "The deadline for the notification handler to complete (e.g. 5s). Zero uses the kubelet's default deadline")
+	cmd.Flags().IntVar(&options.Retries, "retries", 0, "Number of times a timed-out or rate-limited delivery is retried with increasing backoff. Only use with idempotent notification handlers")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "Only validate that the pod, container and notification exist; do not deliver anything")
+	cmd.Flags().BoolVar(&options.Follow, "follow", false, "Stream the handler's output as it is produced until it completes. Only exec handlers can stream")
+	cmd.Flags().BoolVar(&options.List, "list", false, "List the notifications each container of the pod declares instead of delivering one")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "", "Output format of the delivery result. One of: json|yaml")
+	return cmd
+}
+
+// NotifyOptions declare the arguments accepted by the Notify command
+type NotifyOptions struct {
+	Namespace     string
+	ResourceArg   string
+	ContainerName string
+	AllContainers bool
+	Message       string
+	UID           string
+	Signal        string
+	Params        []string
+	Filename      string
+	Stdin         bool
+	MaxConcurrent string
+	Timeout       time.Duration
+	Retries       int
+	DryRun        bool
+	Follow        bool
+	List          bool
+	Output        string
+	Payload       []byte
+
+	// retryDelay is the backoff before the first retry, doubling on every
+	// further attempt. It is only overridden by tests.
+	retryDelay time.Duration
+
+	In  io.Reader
+	Out io.Writer
+	Err io.Writer
+
+	Result     *resource.Result
+	PodClient  coreclient.PodsGetter
+	NodeClient coreclient.NodesGetter
+	CoreClient coreclient.CoreInterface
+
+	// action owns the REST plumbing for the notify subresource.
+	action *podAction
+
+	// nodeCache holds nodes already fetched for the notification support
+	// check, so fanning out to many pods gets each node only once.
+	nodeCache map[string]*api.Node
+}
+
+// Complete verifies command line arguments and loads data from the command environment
+func (o *NotifyOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, argsIn []string) error {
+	if o.List || len(o.Signal) > 0 {
+		if len(argsIn) != 1 {
+			return cmdutil.UsageError(cmd, notifyUsageStr)
+		}
+		o.ResourceArg = argsIn[0]
+	} else {
+		if len(argsIn) != 2 {
+			return cmdutil.UsageError(cmd, notifyUsageStr)
+		}
+		o.ResourceArg = argsIn[0]
+		o.Message = argsIn[1]
+	}
+
+	if len(o.Filename) > 0 && o.Stdin {
+		return cmdutil.UsageError(cmd, "may not specify both --filename and --stdin")
+	}
+	if len(o.Filename) > 0 {
+		payload, err := ioutil.ReadFile(o.Filename)
+		if err != nil {
+			return err
+		}
+		o.Payload = payload
+	}
+	if o.Stdin {
+		payload, err := ioutil.ReadAll(o.In)
+		if err != nil {
+			return err
+		}
+		o.Payload = payload
+	}
+
+	if o.retryDelay == 0 {
+		o.retryDelay = time.Second
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	clientset, err := f.ClientSet()
+	if err != nil {
+		return err
+	}
+	o.PodClient = clientset.Core()
+	o.NodeClient = clientset.Core()
+	o.CoreClient = clientset.Core()
+	o.nodeCache = map[string]*api.Node{}
+	o.action = &podAction{
+		client:      clientset.Core().RESTClient(),
+		subresource: "notify",
+		retries:     o.Retries,
+		retryDelay:  o.retryDelay,
+		retryable:   retryableNotifyError,
+	}
+
+	mapper, typer := f.Object()
+	o.Result = resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), f.Decoder(true)).
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		ResourceNames("pods", o.ResourceArg).
+		SingleResourceType().
+		Do()
+
+	return nil
+}
+
+// Validate checks that the provided notify options are specified.
+func (o *NotifyOptions) Validate() error {
+	if len(o.ResourceArg) == 0 {
+		return fmt.Errorf("a pod or workload must be specified")
+	}
+	if o.List {
+		if len(o.Signal) > 0 {
+			return fmt.Errorf("--list and --signal may not both be specified")
+		}
+		if o.AllContainers || o.DryRun || o.Follow || len(o.Params) > 0 || len(o.Payload) > 0 || len(o.Output) > 0 {
+			return fmt.Errorf("--list may not be combined with delivery options")
+		}
+	} else if len(o.Message) == 0 && len(o.Signal) == 0 {
+		return fmt.Errorf("a notification name or a signal must be specified")
+	}
+	if len(o.Signal) > 0 && len(o.Payload) > 0 {
+		return fmt.Errorf("a signal may not carry a payload")
+	}
+	if len(o.Signal) > 0 && len(o.Params) > 0 {
+		return fmt.Errorf("a signal does not accept parameters")
+	}
+	for _, param := range o.Params {
+		if eq := strings.Index(param, "="); eq < 1 {
+			return fmt.Errorf("parameter %q is not of the form KEY=VALUE", param)
+		}
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("--timeout must not be negative")
+	}
+	if len(o.Output) > 0 && o.Output != "json" && o.Output != "yaml" {
+		return fmt.Errorf("--output must be 'json' or 'yaml', not %q", o.Output)
+	}
+	if o.Retries < 0 {
+		return fmt.Errorf("--retries must not be negative")
+	}
+	if o.AllContainers && len(o.ContainerName) > 0 {
+		return fmt.Errorf("--all-containers and --container may not both be specified")
+	}
+	if o.Follow {
+		if o.AllContainers {
+			return fmt.Errorf("--follow and --all-containers may not both be specified")
+		}
+		if len(o.Output) > 0 {
+			return fmt.Errorf("--follow does not produce structured output")
+		}
+		if o.Retries > 0 {
+			return fmt.Errorf("--follow may not be combined with --retries")
+		}
+		if o.Timeout > 0 {
+			return fmt.Errorf("--timeout does not apply to a followed notification")
+		}
+	}
+	if o.Out == nil || o.Err == nil {
+		return fmt.Errorf("both output and error output must be provided")
+	}
+	if o.PodClient == nil {
+		return fmt.Errorf("client must be provided")
+	}
+	return nil
+}
+
+// Run delivers a validated notification to a pod or to all pods of a workload.
+func (o *NotifyOptions) Run() error {
+	obj, err := o.Result.Object()
+	if err != nil {
+		return err
+	}
+
+	if o.List {
+		pod, ok := obj.(*api.Pod)
+		if !ok {
+			return fmt.Errorf("--list may only be used with a single pod")
+		}
+		return o.listNotifications(pod)
+	}
+
+	if pod, ok := obj.(*api.Pod); ok {
+		if len(o.UID) > 0 && string(pod.UID) != o.UID {
+			return fmt.Errorf("pod %s has UID %s, not %s", pod.Name, pod.UID, o.UID)
+		}
+		if pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed {
+			return fmt.Errorf("cannot notify a container in a completed pod; current phase is %s", pod.Status.Phase)
+		}
+		if !o.AllContainers && len(o.ContainerName) == 0 && len(pod.Spec.Containers) > 1 {
+			fmt.Fprintf(o.Err, "Defaulting container name to %s.\n", pod.Spec.Containers[0].Name)
+		}
+		if !o.DryRun {
+			if err := o.checkNotifySupport(pod); err != nil {
+				return err
+			}
+			if o.Follow {
+				return o.followPod(pod)
+			}
+		}
+		results := o.notifyOne(pod)
+		if len(o.Output) > 0 {
+			var err error
+			if o.AllContainers {
+				err = o.printStructured(results)
+			} else {
+				err = o.printStructured(results[0])
+			}
+			if err != nil {
+				return err
+			}
+		} else {
+			for _, result := range results {
+				o.printTextResult(result, false)
+			}
+		}
+		var errs []error
+		for _, result := range results {
+			if len(result.Error) > 0 {
+				if o.AllContainers {
+					errs = append(errs, fmt.Errorf("container %s: %s", result.Container, result.Error))
+				} else {
+					errs = append(errs, fmt.Errorf("%s", result.Error))
+				}
+			}
+		}
+		return utilerrors.NewAggregate(errs)
+	}
+
+	if len(o.UID) > 0 {
+		return fmt.Errorf("--uid may only be used when notifying a single pod")
+	}
+	if o.Follow {
+		return fmt.Errorf("--follow may only be used when notifying a single pod")
+	}
+
+	if node, ok := obj.(*api.Node); ok {
+		return o.notifyNode(node)
+	}
+
+	selector, err := selectorForObject(obj)
+	if err != nil {
+		return err
+	}
+	podList, err := o.PodClient.Pods(o.Namespace).List(api.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	targets := []*api.Pod{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != api.PodRunning {
+			fmt.Fprintf(o.Err, "Skipping pod %s in phase %s.\n", pod.Name, pod.Status.Phase)
+			continue
+		}
+		targets = append(targets, pod)
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no running pods found for %s", o.ResourceArg)
+	}
+	if !o.DryRun {
+		for _, pod := range targets {
+			if err := o.checkNotifySupport(pod); err != nil {
+				return err
+			}
+		}
+	}
+
+	concurrent, err := o.concurrentNotifications(len(targets))
+	if err != nil {
+		return err
+	}
+
+	results := []NotifyResult{}
+	for i := 0; i < len(targets); i += concurrent {
+		end := i + concurrent
+		if end > len(targets) {
+			end = len(targets)
+		}
+		batch := targets[i:end]
+		batchResults := make([][]NotifyResult, len(batch))
+		var wg sync.WaitGroup
+		for j := range batch {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				batchResults[j] = o.notifyOne(batch[j])
+			}(j)
+		}
+		wg.Wait()
+		for _, podResults := range batchResults {
+			if len(o.Output) == 0 {
+				for _, result := range podResults {
+					o.printTextResult(result, true)
+				}
+			}
+			results = append(results, podResults...)
+		}
+	}
+	if len(o.Output) > 0 {
+		if err := o.printStructured(results); err != nil {
+			return err
+		}
+	}
+	var errs []error
+	for _, result := range results {
+		if len(result.Error) > 0 {
+			if o.AllContainers {
+				errs = append(errs, fmt.Errorf("pod %s container %s: %s", result.Pod, result.Container, result.Error))
+			} else {
+				errs = append(errs, fmt.Errorf("pod %s: %s", result.Pod, result.Error))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// NotifyResult is the structured outcome of one delivery attempt, as printed
+// by -o json|yaml.
+type NotifyResult struct {
+	// Pod is the name of the targeted pod.
+	Pod string `json:"pod"`
+	// Container is the container the notification was addressed to.
+	Container string `json:"container"`
+	// Notification is the notification name, or "signal:<NAME>" for a raw
+	// signal.
+	Notification string `json:"notification"`
+	// Status is Delivered, Failed, or Valid for a --dry-run validation.
+	Status string `json:"status"`
+	// Code is the exit code or HTTP status the handler reported.
+	Code int `json:"code,omitempty"`
+	// Output is the handler's captured output.
+	Output string `json:"output,omitempty"`
+	// Error describes why the delivery or validation failed.
+	Error string `json:"error,omitempty"`
+}
+
+const (
+	notifyStatusDelivered = "Delivered"
+	notifyStatusFailed    = "Failed"
+	notifyStatusValid     = "Valid"
+)
+
+// notifyOne delivers the notification to a single pod — to one container or,
+// with --all-containers, to every container in one request — or only validates
+// the targets under --dry-run. It is safe for concurrent use.
+func (o *NotifyOptions) notifyOne(pod *api.Pod) []NotifyResult {
+	if o.AllContainers {
+		return o.notifyAllContainers(pod)
+	}
+	result := NotifyResult{
+		Pod:          pod.Name,
+		Container:    o.containerFor(pod),
+		Notification: o.notificationName(),
+	}
+	if o.DryRun {
+		if err := o.validateTarget(pod, result.Container); err != nil {
+			result.Status = notifyStatusFailed
+			result.Error = err.Error()
+		} else {
+			result.Status = notifyStatusValid
+		}
+		return []NotifyResult{result}
+	}
+	response, err := o.notifyPod(pod)
+	if response != nil {
+		result.Code = response.Code
+		result.Output = string(response.Output)
+	}
+	if err != nil {
+		result.Status = notifyStatusFailed
+		result.Error = err.Error()
+		return []NotifyResult{result}
+	}
+	result.Status = notifyStatusDelivered
+	return []NotifyResult{result}
+}
+
+// notifyAllContainers delivers the notification to every container of the pod
+// with a single notify request and reports one result per container. Under
+// --dry-run each container is validated locally instead.
+func (o *NotifyOptions) notifyAllContainers(pod *api.Pod) []NotifyResult {
+	if o.DryRun {
+		results := make([]NotifyResult, 0, len(pod.Spec.Containers))
+		for _, container := range pod.Spec.Containers {
+			result := NotifyResult{
+				Pod:          pod.Name,
+				Container:    container.Name,
+				Notification: o.notificationName(),
+			}
+			if err := o.validateTarget(pod, container.Name); err != nil {
+				result.Status = notifyStatusFailed
+				result.Error = err.Error()
+			} else {
+				result.Status = notifyStatusValid
+			}
+			results = append(results, result)
+		}
+		return results
+	}
+	failure := func(err error) []NotifyResult {
+		return []NotifyResult{{
+			Pod:          pod.Name,
+			Container:    "*",
+			Notification: o.notificationName(),
+			Status:       notifyStatusFailed,
+			Error:        err.Error(),
+		}}
+	}
+	body, err := o.doNotify(pod, &api.PodNotifyOptions{
+		Containers:     []string{"*"},
+		Message:        o.Message,
+		Signal:         o.Signal,
+		Params:         o.Params,
+		Payload:        string(o.Payload),
+		UID:            types.UID(o.UID),
+		TimeoutSeconds: int32((o.Timeout + time.Second - 1) / time.Second),
+	})
+	if err != nil {
+		return failure(err)
+	}
+	var responses []kubecontainer.ContainerNotificationResult
+	if err := json.Unmarshal(body, &responses); err != nil {
+		return failure(fmt.Errorf("error parsing notify response: %v", err))
+	}
+	results := make([]NotifyResult, 0, len(responses))
+	for _, response := range responses {
+		result := NotifyResult{
+			Pod:          pod.Name,
+			Container:    response.Container,
+			Notification: o.notificationName(),
+			Code:         response.Code,
+			Output:       string(response.Output),
+		}
+		switch {
+		case len(response.Error) > 0:
+			result.Status = notifyStatusFailed
+			result.Error = response.Error
+		case !notifyHandlerSucceeded(pod, response.Container, o.Message, response.Code):
+			result.Status = notifyStatusFailed
+			result.Error = fmt.Sprintf("handler failed with code %d", response.Code)
+		default:
+			result.Status = notifyStatusDelivered
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// listNotifications prints the notifications each container of the pod
+// declares, so valid notification names can be discovered without reading
+// the pod's manifest.
+func (o *NotifyOptions) listNotifications(pod *api.Pod) error {
+	w := kubectl.GetNewTabWriter(o.Out)
+	defer w.Flush()
+	fmt.Fprintln(w, "CONTAINER\tNOTIFICATION\tHANDLER")
+	for _, container := range pod.Spec.Containers {
+		for _, notification := range container.Notifications {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", container.Name, notification.Name, describeNotificationHandler(&notification.Handler))
+		}
+	}
+	return nil
+}
+
+// describeNotificationHandler renders the short human-readable form of a
+// declared handler for --list.
+func describeNotificationHandler(handler *api.NotificationHandler) string {
+	switch {
+	case handler.Exec != nil:
+		return fmt.Sprintf("exec %v", handler.Exec.Command)
+	case handler.HTTPGet != nil:
+		return fmt.Sprintf("http-get %s", handler.HTTPGet.Path)
+	case handler.Signal != nil:
+		return fmt.Sprintf("signal %s", handler.Signal.Signal)
+	}
+	return "<none>"
+}
+
+// validateTarget checks that the pod declares the targeted container and
+// notification without contacting the kubelet.
+func (o *NotifyOptions) validateTarget(pod *api.Pod, containerName string) error {
+	var container *api.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == containerName {
+			container = &pod.Spec.Containers[i]
+			break
+		}
+	}
+	if container == nil {
+		return fmt.Errorf("container %q not found in pod %s", containerName, pod.Name)
+	}
+	if len(o.Signal) > 0 {
+		return nil
+	}
+	for _, notification := range container.Notifications {
+		if notification.Name == o.Message {
+			return nil
+		}
+	}
+	return fmt.Errorf("container %q does not declare notification %q", containerName, o.Message)
+}
+
+// checkNotifySupport fails fast when the node running the pod does not
+// advertise notification support, or lacks the delivery mode the targeted
+// handler needs. Unscheduled pods and unreadable nodes are left for the
+// server to reject.
+func (o *NotifyOptions) checkNotifySupport(pod *api.Pod) error {
+	if len(pod.Spec.NodeName) == 0 {
+		return nil
+	}
+	node, ok := o.nodeCache[pod.Spec.NodeName]
+	if !ok {
+		var err error
+		node, err = o.NodeClient.Nodes().Get(pod.Spec.NodeName)
+		if err != nil {
+			// The server validates delivery anyway; do not require node read
+			// access just for the fast failure.
+			node = nil
+		}
+		o.nodeCache[pod.Spec.NodeName] = node
+	}
+	if node == nil {
+		return nil
+	}
+	supported, ok := node.Annotations[kubecontainer.NotifySupportedAnnotation]
+	if !ok {
+		return fmt.Errorf("node %s does not advertise notification support; its runtime may not implement notify", pod.Spec.NodeName)
+	}
+	mode := o.deliveryMode(pod)
+	if len(mode) == 0 {
+		return nil
+	}
+	for _, m := range strings.Split(supported, ",") {
+		if m == mode {
+			return nil
+		}
+	}
+	return fmt.Errorf("node %s does not support %s notification delivery; it supports %s", pod.Spec.NodeName, mode, supported)
+}
+
+// deliveryMode resolves which delivery mode the targeted handler uses: exec,
+// http or signal. It returns the empty string when the mode cannot be
+// determined locally, leaving the decision to the kubelet.
+func (o *NotifyOptions) deliveryMode(pod *api.Pod) string {
+	if len(o.Signal) > 0 {
+		return "signal"
+	}
+	if o.AllContainers {
+		return ""
+	}
+	containerName := o.containerFor(pod)
+	for _, c := range pod.Spec.Containers {
+		if c.Name != containerName {
+			continue
+		}
+		for _, n := range c.Notifications {
+			if n.Name != o.Message {
+				continue
+			}
+			switch {
+			case n.Handler.Exec != nil:
+				return "exec"
+			case n.Handler.HTTPGet != nil:
+				return "http"
+			case n.Handler.Signal != nil:
+				return "signal"
+			}
+		}
+	}
+	return ""
+}
+
+// printStructured renders results in the format requested by --output. A
+// single pod target prints one object, a workload target prints a list.
+func (o *NotifyOptions) printStructured(results interface{}) error {
+	var data []byte
+	var err error
+	switch o.Output {
+	case "json":
+		data, err = json.MarshalIndent(results, "", "  ")
+		data = append(data, '\n')
+	case "yaml":
+		data, err = yaml.Marshal(results)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = o.Out.Write(data)
+	return err
+}
+
+// printTextResult writes the default human-readable outcome for one pod. The
+// prefix is used when fanning out to several pods.
+func (o *NotifyOptions) printTextResult(result NotifyResult, prefix bool) {
+	target := "pod/" + result.Pod
+	if o.AllContainers {
+		target += "/" + result.Container
+	}
+	if result.Status == notifyStatusValid {
+		fmt.Fprintf(o.Out, "%s: notification %q validated (dry run)\n", target, result.Notification)
+		return
+	}
+	if len(result.Output) > 0 {
+		if prefix || o.AllContainers {
+			fmt.Fprintf(o.Out, "%s:\n", target)
+		}
+		o.Out.Write([]byte(result.Output))
+	}
+}
+
+// containerFor resolves the container the notification is addressed to,
+// defaulting to the pod's first container.
+func (o *NotifyOptions) containerFor(pod *api.Pod) string {
+	if len(o.ContainerName) > 0 {
+		return o.ContainerName
+	}
+	return pod.Spec.Containers[0].Name
+}
+
+// notificationName is the name reported for the delivery, using a prefix to
+// tell raw signals apart from declared notifications.
+func (o *NotifyOptions) notificationName() string {
+	if len(o.Signal) > 0 {
+		return "signal:" + o.Signal
+	}
+	return o.Message
+}
+
+// notifyPod delivers the notification to a single pod and returns the result
+// reported by its handler. It is safe for concurrent use.
+func (o *NotifyOptions) notifyPod(pod *api.Pod) (*kubecontainer.NotificationResult, error) {
+	containerName := o.containerFor(pod)
+
+	body, err := o.doNotify(pod, &api.PodNotifyOptions{
+		Container:      containerName,
+		Message:        o.Message,
+		Signal:         o.Signal,
+		Params:         o.Params,
+		Payload:        string(o.Payload),
+		UID:            types.UID(o.UID),
+		TimeoutSeconds: int32((o.Timeout + time.Second - 1) / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &kubecontainer.NotificationResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, fmt.Errorf("error parsing notify response: %v", err)
+	}
+	name := o.Message
+	if len(o.Signal) > 0 {
+		name = o.Signal
+	}
+	if !notifyHandlerSucceeded(pod, containerName, o.Message, result.Code) {
+		return result, fmt.Errorf("notification %q handler in container %q failed with code %d", name, containerName, result.Code)
+	}
+	return result, nil
+}
+
+// followPod delivers the notification and copies the handler's output to the
+// command's output as it is produced, until the handler completes.
+func (o *NotifyOptions) followPod(pod *api.Pod) error {
+	return o.action.stream(pod.Namespace, pod.Name, &api.PodNotifyOptions{
+		Container: o.containerFor(pod),
+		Message:   o.Message,
+		Signal:    o.Signal,
+		Params:    o.Params,
+		Payload:   string(o.Payload),
+		UID:       types.UID(o.UID),
+		Follow:    true,
+	}, o.Out)
+}
+
+// notifyNode broadcasts a named notification through the kubelet's notifyAll
+// endpoint, behind the API server's node proxy, to every pod on the node that
+// declares a handler for it. The kubelet rolls the broadcast out one
+// container at a time, so its per-pod rate limits still apply.
+func (o *NotifyOptions) notifyNode(node *api.Node) error {
+	if len(o.Signal) > 0 {
+		return fmt.Errorf("a raw signal cannot be broadcast to a node; use a named notification")
+	}
+	if len(o.ContainerName) > 0 || o.AllContainers {
+		return fmt.Errorf("a node broadcast reaches every container that declares the notification; container flags do not apply")
+	}
+	if o.DryRun {
+		return fmt.Errorf("--dry-run may only be used when notifying pods")
+	}
+	req := o.CoreClient.RESTClient().Post().
+		Resource("nodes").
+		Name(node.Name).
+		SubResource("proxy").
+		Suffix("notifyAll", o.Message)
+	if len(o.Payload) > 0 {
+		req = req.Param("payload", string(o.Payload))
+	}
+	body, err := req.DoRaw()
+	if err != nil {
+		return err
+	}
+	var responses []kubecontainer.BroadcastNotificationResult
+	if err := json.Unmarshal(body, &responses); err != nil {
+		return fmt.Errorf("error parsing notify response: %v", err)
+	}
+	results := make([]NotifyResult, 0, len(responses))
+	for _, response := range responses {
+		result := NotifyResult{
+			Pod:          response.Namespace + "/" + response.Pod,
+			Container:    response.Container,
+			Notification: o.Message,
+			Code:         response.Code,
+			Output:       string(response.Output),
+		}
+		if len(response.Error) > 0 {
+			result.Status = notifyStatusFailed
+			result.Error = response.Error
+		} else {
+			result.Status = notifyStatusDelivered
+		}
+		results = append(results, result)
+	}
+	var errs []error
+	for _, result := range results {
+		if len(o.Output) == 0 && len(result.Output) > 0 {
+			fmt.Fprintf(o.Out, "pod/%s/%s:\n", result.Pod, result.Container)
+			o.Out.Write([]byte(result.Output))
+		}
+		if len(result.Error) > 0 {
+			errs = append(errs, fmt.Errorf("pod %s container %s: %s", result.Pod, result.Container, result.Error))
+		}
+	}
+	if len(o.Output) > 0 {
+		if err := o.printStructured(results); err != nil {
+			return err
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// doNotify delivers the given notify options to a pod, retrying transient
+// failures as directed by --retries and rewording rate-limit and deadline
+// errors. It is safe for concurrent use.
+func (o *NotifyOptions) doNotify(pod *api.Pod, opts *api.PodNotifyOptions) ([]byte, error) {
+	body, err := o.action.do(pod.Namespace, pod.Name, opts)
+	if apierrors.IsTooManyRequests(err) {
+		return nil, fmt.Errorf("the kubelet is rate limiting notifications to pod %s; retry later", pod.Name)
+	}
+	if isNotifyTimeout(err) {
+		return nil, fmt.Errorf("the notification handler in pod %s did not complete before the deadline", pod.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// isNotifyTimeout reports whether a delivery failed because the handler missed
+// its deadline on the kubelet.
+func isNotifyTimeout(err error) bool {
+	if status, ok := err.(apierrors.APIStatus); ok {
+		return status.Status().Code == http.StatusGatewayTimeout
+	}
+	return false
+}
+
+// retryableNotifyError reports whether a delivery failure is transient enough
+// to retry: the kubelet rate limited the pod or the handler missed its
+// deadline.
+func retryableNotifyError(err error) bool {
+	return apierrors.IsTooManyRequests(err) || isNotifyTimeout(err)
+}
+
+// concurrentNotifications resolves the --max-concurrent flag against the
+// number of targeted pods, treating a percentage like a rolling update treats
+// maxUnavailable.
+func (o *NotifyOptions) concurrentNotifications(total int) (int, error) {
+	value := intstr.FromString(o.MaxConcurrent)
+	if i, err := strconv.Atoi(o.MaxConcurrent); err == nil {
+		value = intstr.FromInt(i)
+	}
+	concurrent, err := intstr.GetValueFromIntOrPercent(&value, total, true)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for --max-concurrent: %v", err)
+	}
+	if concurrent < 1 {
+		concurrent = 1
+	}
+	return concurrent, nil
+}
+
+// selectorForObject returns the label selector a workload uses to own its
+// pods.
+func selectorForObject(obj runtime.Object) (labels.Selector, error) {
+	switch t := obj.(type) {
+	case *api.ReplicationController:
+		return labels.SelectorFromSet(t.Spec.Selector), nil
+	case *extensions.Deployment:
+		return unversioned.LabelSelectorAsSelector(t.Spec.Selector)
+	case *extensions.ReplicaSet:
+		return unversioned.LabelSelectorAsSelector(t.Spec.Selector)
+	default:
+		gvks, _, err := api.Scheme.ObjectKinds(obj)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("cannot notify %v: the object has no pod selector", gvks[0])
+	}
+}
+
+// notifyHandlerSucceeded interprets the result code reported by the kubelet.
+// Exec and signal handlers report a process exit code, while HTTP handlers
+// report the HTTP status returned by the container.
+func notifyHandlerSucceeded(pod *api.Pod, containerName, message string, code int) bool {
+	for _, c := range pod.Spec.Containers {
+		if c.Name != containerName {
+			continue
+		}
+		for _, n := range c.Notifications {
+			if n.Name == message && n.Handler.HTTPGet != nil {
+				return code >= 200 && code < 400
+			}
+		}
+	}
+	return code == 0
+}
@@ -0,0 +1,654 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/client/restclient/fake"
+	cmdtesting "k8s.io/kubernetes/pkg/kubectl/cmd/testing"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestNotifyArgs(t *testing.T) {
+	tests := []struct {
+		args            []string
+		p               *NotifyOptions
+		name            string
+		expectError     bool
+		expectedTarget  string
+		expectedMessage string
+		expectedPayload string
+	}{
+		{
+			p:           &NotifyOptions{},
+			expectError: true,
+			name:        "empty",
+		},
+		{
+			p:           &NotifyOptions{},
+			args:        []string{"foo"},
+			expectError: true,
+			name:        "no notification",
+		},
+		{
+			p:               &NotifyOptions{},
+			args:            []string{"foo", "reload"},
+			expectedTarget:  "foo",
+			expectedMessage: "reload",
+			name:            "pod and notification",
+		},
+		{
+			p:               &NotifyOptions{Stdin: true, In: strings.NewReader(`{"level":"debug"}`)},
+			args:            []string{"foo", "set-log-level"},
+			expectedTarget:  "foo",
+			expectedMessage: "set-log-level",
+			expectedPayload: `{"level":"debug"}`,
+			name:            "payload from stdin",
+		},
+		{
+			p:           &NotifyOptions{Stdin: true, Filename: "payload.json"},
+			args:        []string{"foo", "reload"},
+			expectError: true,
+			name:        "both filename and stdin",
+		},
+		{
+			p:              &NotifyOptions{Signal: "SIGHUP"},
+			args:           []string{"foo"},
+			expectedTarget: "foo",
+			name:           "signal instead of notification",
+		},
+		{
+			p:           &NotifyOptions{Signal: "SIGHUP"},
+			args:        []string{"foo", "reload"},
+			expectError: true,
+			name:        "signal and notification",
+		},
+	}
+	for _, test := range tests {
+		f, tf, _, ns := cmdtesting.NewAPIFactory()
+		tf.Client = &fake.RESTClient{
+			NegotiatedSerializer: ns,
+			Client:               fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) { return nil, nil }),
+		}
+		tf.Namespace = "test"
+		tf.ClientConfig = defaultClientConfig()
+
+		cmd := &cobra.Command{}
+		options := test.p
+		err := options.Complete(f, cmd, test.args)
+		if test.expectError && err == nil {
+			t.Errorf("unexpected non-error (%s)", test.name)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("unexpected error: %v (%s)", err, test.name)
+		}
+		if err != nil {
+			continue
+		}
+		if options.ResourceArg != test.expectedTarget {
+			t.Errorf("expected: %s, got: %s (%s)", test.expectedTarget, options.ResourceArg, test.name)
+		}
+		if options.Message != test.expectedMessage {
+			t.Errorf("expected: %s, got: %s (%s)", test.expectedMessage, options.Message, test.name)
+		}
+		if string(options.Payload) != test.expectedPayload {
+			t.Errorf("expected: %s, got: %s (%s)", test.expectedPayload, options.Payload, test.name)
+		}
+	}
+}
+
+func TestNotifyUIDMismatch(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+	pod.UID = "2d392868-1e7d-11e6-a383-42010af00002"
+
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == podPath && m == "GET":
+				body := objBody(codec, pod)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{
+		UID: "00000000-0000-0000-0000-000000000000",
+		Out: bufOut,
+		Err: bufErr,
+	}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"foo", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	err := options.Run()
+	if err == nil {
+		t.Fatalf("expected error for UID mismatch, got none")
+	}
+	if !strings.Contains(err.Error(), string(pod.UID)) {
+		t.Errorf("expected error to mention the running pod's UID, got: %v", err)
+	}
+}
+
+func TestNotifyFanOut(t *testing.T) {
+	version := registered.GroupOrDie(api.GroupName).GroupVersion.Version
+	rcPath := "/namespaces/test/replicationcontrollers/my-app"
+	podsPath := "/api/" + version + "/namespaces/test/pods"
+
+	rc := &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: "my-app", Namespace: "test"},
+		Spec: api.ReplicationControllerSpec{
+			Selector: map[string]string{"app": "my-app"},
+		},
+	}
+	newPod := func(name string, phase api.PodPhase) api.Pod {
+		return api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: name, Namespace: "test", Labels: map[string]string{"app": "my-app"}},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{Name: "bar"}},
+			},
+			Status: api.PodStatus{Phase: phase},
+		}
+	}
+	pods := &api.PodList{Items: []api.Pod{
+		newPod("a", api.PodRunning),
+		newPod("b", api.PodRunning),
+		newPod("c", api.PodSucceeded),
+	}}
+
+	var lock sync.Mutex
+	notified := []string{}
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == rcPath && m == "GET":
+				body := objBody(codec, rc)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case p == podsPath && m == "GET":
+				body := objBody(codec, pods)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/notify") && m == "POST":
+				parts := strings.Split(p, "/")
+				lock.Lock()
+				notified = append(notified, parts[len(parts)-2])
+				lock.Unlock()
+				body := ioutil.NopCloser(bytes.NewBufferString(`{"code":0}`))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{
+		Out: bufOut,
+		Err: bufErr,
+	}
+	cmd := &cobra.Command{}
+	cmd.Flags().String("max-concurrent", "1", "")
+	options.MaxConcurrent = "1"
+	if err := options.Complete(f, cmd, []string{"replicationcontrollers/my-app", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"a", "b"}
+	if !reflect.DeepEqual(notified, expected) {
+		t.Errorf("expected pods %v to be notified, got %v", expected, notified)
+	}
+	if !strings.Contains(bufErr.String(), "Skipping pod c") {
+		t.Errorf("expected the completed pod to be reported as skipped, got: %q", bufErr.String())
+	}
+}
+
+func TestNotifyDryRun(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+	pod.Spec.Containers[0].Notifications = []api.ContainerNotification{
+		{Name: "reload", Handler: api.NotificationHandler{Exec: &api.ExecAction{Command: []string{"reload"}}}},
+	}
+
+	tests := []struct {
+		name        string
+		message     string
+		expectError string
+	}{
+		{name: "declared notification validates", message: "reload"},
+		{name: "undeclared notification fails", message: "debug-dump", expectError: `does not declare notification "debug-dump"`},
+	}
+	for _, test := range tests {
+		f, tf, codec, ns := cmdtesting.NewAPIFactory()
+		tf.Client = &fake.RESTClient{
+			NegotiatedSerializer: ns,
+			Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				switch p, m := req.URL.Path, req.Method; {
+				case p == podPath && m == "GET":
+					body := objBody(codec, pod)
+					return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+				default:
+					t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+					return nil, nil
+				}
+			}),
+		}
+		tf.Namespace = "test"
+		tf.ClientConfig = defaultClientConfig()
+		bufOut := bytes.NewBuffer([]byte{})
+		bufErr := bytes.NewBuffer([]byte{})
+
+		options := &NotifyOptions{
+			DryRun: true,
+			Out:    bufOut,
+			Err:    bufErr,
+		}
+		cmd := &cobra.Command{}
+		if err := options.Complete(f, cmd, []string{"foo", test.message}); err != nil {
+			t.Fatal(err)
+		}
+		err := options.Run()
+		if len(test.expectError) == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			if !strings.Contains(bufOut.String(), "validated (dry run)") {
+				t.Errorf("%s: expected a validation message, got: %q", test.name, bufOut.String())
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.expectError) {
+			t.Errorf("%s: expected error containing %q, got: %v", test.name, test.expectError, err)
+		}
+	}
+}
+
+func TestNotifyStructuredOutput(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == podPath && m == "GET":
+				body := objBody(codec, pod)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/notify") && m == "POST":
+				body := ioutil.NopCloser(bytes.NewBufferString(`{"code":0,"output":"cmVsb2FkZWQ="}`))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{
+		Output: "json",
+		Out:    bufOut,
+		Err:    bufErr,
+	}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"foo", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := NotifyResult{}
+	if err := json.Unmarshal(bufOut.Bytes(), &result); err != nil {
+		t.Fatalf("error parsing output %q: %v", bufOut.String(), err)
+	}
+	expected := NotifyResult{
+		Pod:          "foo",
+		Container:    "bar",
+		Notification: "reload",
+		Status:       "Delivered",
+		Output:       "reloaded",
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %#v, got %#v", expected, result)
+	}
+}
+
+func TestNotifyUnsupportedNode(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+	pod.Spec.NodeName = "node1"
+	node := &api.Node{ObjectMeta: api.ObjectMeta{Name: "node1"}}
+
+	notified := false
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == podPath && m == "GET":
+				body := objBody(codec, pod)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/nodes/node1") && m == "GET":
+				body := objBody(codec, node)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/notify") && m == "POST":
+				notified = true
+				body := ioutil.NopCloser(bytes.NewBufferString(`{"code":0}`))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{Out: bufOut, Err: bufErr}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"foo", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	err := options.Run()
+	if err == nil || !strings.Contains(err.Error(), "does not advertise notification support") {
+		t.Fatalf("expected an unsupported node error, got %v", err)
+	}
+	if notified {
+		t.Errorf("expected no delivery to an unsupported node")
+	}
+
+	// An advertising node lets the delivery through.
+	node.Annotations = map[string]string{kubecontainer.NotifySupportedAnnotation: kubecontainer.NotifySupportedHandlers}
+	options = &NotifyOptions{Out: bufOut, Err: bufErr}
+	if err := options.Complete(f, cmd, []string{"foo", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !notified {
+		t.Errorf("expected a delivery to a supported node")
+	}
+}
+
+func TestNotifyNodeBroadcast(t *testing.T) {
+	node := &api.Node{ObjectMeta: api.ObjectMeta{Name: "node1"}}
+
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case strings.HasSuffix(p, "/nodes/node1") && m == "GET":
+				body := objBody(codec, node)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/nodes/node1/proxy/notifyAll/node-maintenance-imminent") && m == "POST":
+				body := ioutil.NopCloser(bytes.NewBufferString(`[{"pod":"foo","namespace":"test","container":"bar","code":0,"output":"ZHJhaW5pbmc="},{"pod":"qux","namespace":"test","container":"baz","error":"container not running"}]`))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{Out: bufOut, Err: bufErr}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"node/node1", "node-maintenance-imminent"}); err != nil {
+		t.Fatal(err)
+	}
+	err := options.Run()
+	if err == nil || !strings.Contains(err.Error(), "pod test/qux container baz: container not running") {
+		t.Fatalf("expected the failed delivery to be reported, got %v", err)
+	}
+	if !strings.Contains(bufOut.String(), "draining") {
+		t.Errorf("expected the handler output to be printed, got:\n%s", bufOut.String())
+	}
+}
+
+func TestNotifyList(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+	pod.Spec.Containers[0].Notifications = []api.ContainerNotification{
+		{Name: "reload", Handler: api.NotificationHandler{Exec: &api.ExecAction{Command: []string{"/bin/reload"}}}},
+		{Name: "rotate", Handler: api.NotificationHandler{Signal: &api.SignalAction{Signal: "SIGUSR1"}}},
+	}
+	pod.Spec.Containers = append(pod.Spec.Containers, api.Container{
+		Name: "extra",
+		Notifications: []api.ContainerNotification{
+			{Name: "dump-state", Handler: api.NotificationHandler{HTTPGet: &api.HTTPGetAction{Path: "/dump", Port: intstr.FromInt(8080)}}},
+		},
+	})
+
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == podPath && m == "GET":
+				body := objBody(codec, pod)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{
+		List: true,
+		Out:  bufOut,
+		Err:  bufErr,
+	}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"foo"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := bufOut.String()
+	for _, expected := range []string{
+		"CONTAINER", "NOTIFICATION", "HANDLER",
+		"reload", "exec [/bin/reload]",
+		"rotate", "signal SIGUSR1",
+		"extra", "dump-state", "http-get /dump",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+}
+
+func TestNotifyAllContainers(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+	pod.Spec.Containers = append(pod.Spec.Containers, api.Container{Name: "extra"})
+
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == podPath && m == "GET":
+				body := objBody(codec, pod)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/notify") && m == "POST":
+				if got := req.URL.Query()["containers"]; !reflect.DeepEqual(got, []string{"*"}) {
+					t.Errorf("expected containers=[*], got %v", got)
+				}
+				body := ioutil.NopCloser(bytes.NewBufferString(`[{"container":"bar","code":0,"output":"cmVsb2FkZWQ="},{"container":"extra","error":"container not running"}]`))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{
+		AllContainers: true,
+		Output:        "json",
+		Out:           bufOut,
+		Err:           bufErr,
+	}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"foo", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	err := options.Run()
+	if err == nil || !strings.Contains(err.Error(), "container extra: container not running") {
+		t.Fatalf("expected the failed container in the error, got %v", err)
+	}
+	results := []NotifyResult{}
+	if err := json.Unmarshal(bufOut.Bytes(), &results); err != nil {
+		t.Fatalf("error parsing output %q: %v", bufOut.String(), err)
+	}
+	expected := []NotifyResult{
+		{Pod: "foo", Container: "bar", Notification: "reload", Status: "Delivered", Output: "reloaded"},
+		{Pod: "foo", Container: "extra", Notification: "reload", Status: "Failed", Error: "container not running"},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected %#v, got %#v", expected, results)
+	}
+}
+
+func TestNotifyRetries(t *testing.T) {
+	podPath := "/namespaces/test/pods/foo"
+	pod := execPod()
+
+	attempts := 0
+	f, tf, codec, ns := cmdtesting.NewAPIFactory()
+	tf.Client = &fake.RESTClient{
+		NegotiatedSerializer: ns,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == podPath && m == "GET":
+				body := objBody(codec, pod)
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			case strings.HasSuffix(p, "/notify") && m == "POST":
+				attempts++
+				if attempts == 1 {
+					body := ioutil.NopCloser(bytes.NewBufferString(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"TooManyRequests","code":429}`))
+					return &http.Response{StatusCode: 429, Header: defaultHeader(), Body: body}, nil
+				}
+				body := ioutil.NopCloser(bytes.NewBufferString(`{"code":0}`))
+				return &http.Response{StatusCode: 200, Header: defaultHeader(), Body: body}, nil
+			default:
+				t.Errorf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	tf.ClientConfig = defaultClientConfig()
+	bufOut := bytes.NewBuffer([]byte{})
+	bufErr := bytes.NewBuffer([]byte{})
+
+	options := &NotifyOptions{
+		Retries:    2,
+		retryDelay: time.Millisecond,
+		Out:        bufOut,
+		Err:        bufErr,
+	}
+	cmd := &cobra.Command{}
+	if err := options.Complete(f, cmd, []string{"foo", "reload"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := options.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 delivery attempts, got %d", attempts)
+	}
+}
+
+func TestNotifyHandlerSucceeded(t *testing.T) {
+	pod := execPod()
+	pod.Spec.Containers[0].Notifications = []api.ContainerNotification{
+		{Name: "reload", Handler: api.NotificationHandler{HTTPGet: &api.HTTPGetAction{Port: intstr.FromInt(8080)}}},
+		{Name: "dump-state", Handler: api.NotificationHandler{Exec: &api.ExecAction{Command: []string{"dump"}}}},
+	}
+
+	tests := []struct {
+		name      string
+		message   string
+		code      int
+		succeeded bool
+	}{
+		{name: "http ok", message: "reload", code: 200, succeeded: true},
+		{name: "http error", message: "reload", code: 500, succeeded: false},
+		{name: "exec ok", message: "dump-state", code: 0, succeeded: true},
+		{name: "exec failed", message: "dump-state", code: 2, succeeded: false},
+		{name: "undeclared", message: "other", code: 0, succeeded: true},
+	}
+	for _, test := range tests {
+		if got := notifyHandlerSucceeded(pod, "bar", test.message, test.code); got != test.succeeded {
+			t.Errorf("%s: expected %v, got %v", test.name, test.succeeded, got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/restclient"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// podAction issues requests against a pod "action" subresource — a POST
+// subresource like notify that runs something in the pod and reports a
+// result. It owns the REST plumbing (request construction, the options
+// codec, retries and streaming) so future subresources such as checkpoint
+// or flush-logs do not have to copy it.
+type podAction struct {
+	client      restclient.Interface
+	subresource string
+
+	// retries and retryDelay bound how often a transient failure is retried,
+	// with the delay doubling on every further attempt. retryable decides
+	// which failures count as transient; a nil retryable disables retries.
+	retries    int
+	retryDelay time.Duration
+	retryable  func(error) bool
+}
+
+// request builds the subresource request for one pod from versioned options.
+func (a *podAction) request(namespace, name string, opts runtime.Object) *restclient.Request {
+	return a.client.Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name).
+		SubResource(a.subresource).
+		VersionedParams(opts, api.ParameterCodec)
+}
+
+// do issues the action and returns the buffered response, retrying transient
+// failures as configured. It is safe for concurrent use.
+func (a *podAction) do(namespace, name string, opts runtime.Object) ([]byte, error) {
+	var body []byte
+	var err error
+	delay := a.retryDelay
+	for attempt := 0; ; attempt++ {
+		body, err = a.request(namespace, name, opts).DoRaw()
+		if err == nil || attempt >= a.retries || a.retryable == nil || !a.retryable(err) {
+			break
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+	return body, err
+}
+
+// stream issues the action and copies the response to out as it is produced,
+// until the server ends the stream.
+func (a *podAction) stream(namespace, name string, opts runtime.Object, out io.Writer) error {
+	stream, err := a.request(namespace, name, opts).Stream()
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	_, err = io.Copy(out, stream)
+	return err
+}
@@ -54,6 +54,19 @@ type NodeStats struct {
 	// Stats about the underlying container runtime.
 	// +optional
 	Runtime *RuntimeStats `json:"runtime,omitempty"`
+	// Stats about the rlimit of system.
+	// +optional
+	Rlimit *RlimitStats `json:"rlimit,omitempty"`
+}
+
+// RlimitStats are stats rlimit of OS.
+type RlimitStats struct {
+	Time unversioned.Time `json:"time"`
+
+	// The max PID of OS.
+	MaxPID *int64 `json:"maxpid,omitempty"`
+	// The number of running process in the OS.
+	NumOfRunningProcesses *int64 `json:"curproc,omitempty"`
 }
 
 // Stats pertaining to the underlying container runtime.
@@ -126,15 +139,34 @@ type PodReference struct {
 type NetworkStats struct {
 	// The time at which these stats were updated.
 	Time unversioned.Time `json:"time"`
+
+	// Stats for the default interface, if found
+	InterfaceStats `json:",inline"`
+
+	// Stats for all interfaces.
+	// +optional
+	Interfaces []InterfaceStats `json:"interfaces,omitempty"`
+}
+
+// InterfaceStats contains resource value data about a single network interface.
+type InterfaceStats struct {
+	// The name of the interface
+	Name string `json:"name"`
 	// Cumulative count of bytes received.
 	// +optional
 	RxBytes *uint64 `json:"rxBytes,omitempty"`
+	// Cumulative count of packets received.
+	// +optional
+	RxPackets *uint64 `json:"rxPackets,omitempty"`
 	// Cumulative count of receive errors encountered.
 	// +optional
 	RxErrors *uint64 `json:"rxErrors,omitempty"`
 	// Cumulative count of bytes transmitted.
 	// +optional
 	TxBytes *uint64 `json:"txBytes,omitempty"`
+	// Cumulative count of packets transmitted.
+	// +optional
+	TxPackets *uint64 `json:"txPackets,omitempty"`
 	// Cumulative count of transmit errors encountered.
 	// +optional
 	TxErrors *uint64 `json:"txErrors,omitempty"`
@@ -178,6 +210,13 @@ type MemoryStats struct {
 	// Cumulative number of major page faults.
 	// +optional
 	MajorPageFaults *uint64 `json:"majorPageFaults,omitempty"`
+	// The amount of swap currently in use, if swap is enabled.
+	// +optional
+	SwapUsageBytes *uint64 `json:"swapUsageBytes,omitempty"`
+	// Available swap for use.  This is defined as the swap limit - swapUsageBytes.
+	// If the swap limit is undefined, the available bytes is omitted.
+	// +optional
+	SwapAvailableBytes *uint64 `json:"swapAvailableBytes,omitempty"`
 }
 
 // VolumeStats contains data about Volume filesystem usage.
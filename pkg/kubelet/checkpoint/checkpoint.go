@@ -0,0 +1,206 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package checkpoint persists kubelet state to disk so it survives kubelet
+// restarts. It keeps a record of the pods admitted to the node, which lets
+// the kubelet readmit pods after a restart without re-running the admission
+// predicates, so transient resource pressure at startup does not reject pods
+// that were already running on the node.
+package checkpoint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/types"
+)
+
+const checkpointFileSuffix = ".checkpoint"
+
+// Store persists key/value checkpoints in a directory, one file per key.
+// Writes are atomic, so a checkpoint is either fully written or absent.
+type Store interface {
+	// Write persists the data under the given key.
+	Write(key string, data []byte) error
+	// Read returns the data stored under the given key.
+	Read(key string) ([]byte, error)
+	// Delete removes the checkpoint for the given key. It is not an error
+	// to delete a key that does not exist.
+	Delete(key string) error
+	// List returns the stored keys.
+	List() ([]string, error)
+}
+
+// fileStore implements Store on the local filesystem.
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore returns a Store backed by files under the given directory,
+// creating the directory if necessary.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint directory %q: %v", dir, err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) Write(key string, data []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	// Write to a temporary file and rename it into place, so that readers
+	// never observe a partially written checkpoint.
+	tmpFile, err := ioutil.TempFile(s.dir, key)
+	if err != nil {
+		return err
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), s.checkpointPath(key))
+}
+
+func (s *fileStore) Read(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(s.checkpointPath(key))
+}
+
+func (s *fileStore) Delete(key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	if err := os.Remove(s.checkpointPath(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) List() ([]string, error) {
+	keys := []string{}
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), checkpointFileSuffix) {
+			continue
+		}
+		keys = append(keys, strings.TrimSuffix(f.Name(), checkpointFileSuffix))
+	}
+	return keys, nil
+}
+
+func (s *fileStore) checkpointPath(key string) string {
+	return filepath.Join(s.dir, key+checkpointFileSuffix)
+}
+
+// validateKey rejects keys that would escape the checkpoint directory.
+func validateKey(key string) error {
+	if key == "" || strings.ContainsAny(key, "/\\") || strings.HasPrefix(key, ".") {
+		return fmt.Errorf("invalid checkpoint key %q", key)
+	}
+	return nil
+}
+
+// Manager tracks the pods admitted to the node across kubelet restarts.
+type Manager interface {
+	// WritePodAdmission records that the pod was admitted to the node.
+	WritePodAdmission(uid types.UID) error
+	// DeletePodAdmission removes the admission record of the pod.
+	DeletePodAdmission(uid types.UID) error
+	// WasAdmitted returns true if the pod was admitted before the kubelet
+	// (re)started.
+	WasAdmitted(uid types.UID) bool
+	// GarbageCollect removes admission records of pods the kubelet no
+	// longer knows about.
+	GarbageCollect(activeUIDs map[types.UID]bool)
+}
+
+type managerImpl struct {
+	store Store
+
+	lock sync.Mutex
+	// admitted holds the admission records restored from disk at startup.
+	// Records written during this kubelet run are not added, since their
+	// pods have already passed admission.
+	admitted map[types.UID]bool
+}
+
+// NewManager returns a Manager persisting to the given directory and restores
+// the admission records of a previous kubelet run, if any.
+func NewManager(dir string) (Manager, error) {
+	store, err := NewFileStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := store.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checkpoints in %q: %v", dir, err)
+	}
+	admitted := make(map[types.UID]bool)
+	for _, key := range keys {
+		admitted[types.UID(key)] = true
+	}
+	return &managerImpl{store: store, admitted: admitted}, nil
+}
+
+func (m *managerImpl) WritePodAdmission(uid types.UID) error {
+	return m.store.Write(string(uid), []byte{})
+}
+
+func (m *managerImpl) DeletePodAdmission(uid types.UID) error {
+	m.lock.Lock()
+	delete(m.admitted, uid)
+	m.lock.Unlock()
+	return m.store.Delete(string(uid))
+}
+
+func (m *managerImpl) WasAdmitted(uid types.UID) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.admitted[uid]
+}
+
+func (m *managerImpl) GarbageCollect(activeUIDs map[types.UID]bool) {
+	keys, err := m.store.List()
+	if err != nil {
+		glog.Errorf("Failed to list checkpoints for garbage collection: %v", err)
+		return
+	}
+	for _, key := range keys {
+		if activeUIDs[types.UID(key)] {
+			continue
+		}
+		if err := m.DeletePodAdmission(types.UID(key)); err != nil {
+			glog.Errorf("Failed to remove stale checkpoint %q: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/types"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore() failed: %v", err)
+	}
+
+	if err := store.Write("key1", []byte("data1")); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	if err := store.Write("key2", []byte("data2")); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	data, err := store.Read("key1")
+	if err != nil {
+		t.Fatalf("Read() failed: %v", err)
+	}
+	if string(data) != "data1" {
+		t.Errorf("Read() returned %q, expected %q", data, "data1")
+	}
+
+	keys, err := store.List()
+	if err != nil {
+		t.Fatalf("List() failed: %v", err)
+	}
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"key1", "key2"}) {
+		t.Errorf("List() returned %v", keys)
+	}
+
+	if err := store.Delete("key1"); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	// Deleting a missing key is not an error.
+	if err := store.Delete("key1"); err != nil {
+		t.Errorf("Delete() of a missing key failed: %v", err)
+	}
+	if _, err := store.Read("key1"); !os.IsNotExist(err) {
+		t.Errorf("Read() of a deleted key returned %v, expected not exist", err)
+	}
+
+	// Keys that could escape the checkpoint directory are rejected.
+	for _, key := range []string{"", "../escape", "a/b", ".hidden"} {
+		if err := store.Write(key, []byte{}); err == nil {
+			t.Errorf("Write(%q) should have failed", key)
+		}
+	}
+}
+
+func TestManagerRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	uid := types.UID("12345678-1234-1234-1234-123456789012")
+	manager, err := NewManager(dir)
+	if err != nil {
+		t.Fatalf("NewManager() failed: %v", err)
+	}
+	if err := manager.WritePodAdmission(uid); err != nil {
+		t.Fatalf("WritePodAdmission() failed: %v", err)
+	}
+	// Pods admitted during this run have already passed admission, only
+	// records from a previous run count.
+	if manager.WasAdmitted(uid) {
+		t.Errorf("WasAdmitted() should not report pods admitted during this run")
+	}
+
+	// A new manager simulates a kubelet restart and restores the record.
+	restarted, err := NewManager(dir)
+	if err != nil {
+		t.Fatalf("NewManager() failed: %v", err)
+	}
+	if !restarted.WasAdmitted(uid) {
+		t.Errorf("WasAdmitted() should report pods admitted before the restart")
+	}
+
+	// Garbage collection keeps active pods and removes stale records.
+	restarted.GarbageCollect(map[types.UID]bool{uid: true})
+	if !restarted.WasAdmitted(uid) {
+		t.Errorf("GarbageCollect() removed the record of an active pod")
+	}
+	restarted.GarbageCollect(map[types.UID]bool{})
+	if restarted.WasAdmitted(uid) {
+		t.Errorf("GarbageCollect() kept the record of a deleted pod")
+	}
+}
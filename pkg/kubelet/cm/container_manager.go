@@ -58,6 +58,11 @@ type NodeConfig struct {
 	CgroupDriver          string
 	ProtectKernelDefaults bool
 	EnableCRI             bool
+	// Resources reserved for non-pod components. When CgroupsPerQOS is
+	// enabled, the limits of the node allocatable cgroup are derived by
+	// subtracting these reservations from the machine capacity.
+	SystemReserved api.ResourceList
+	KubeReserved   api.ResourceList
 }
 
 type Status struct {
@@ -220,6 +220,12 @@ func NewContainerManager(mountUtil mount.Interface, cadvisorInterface cadvisor.I
 		if !cgroupManager.Exists(CgroupName(nodeConfig.CgroupRoot)) {
 			return nil, fmt.Errorf("invalid configuration: cgroup-root doesn't exist: %v", err)
 		}
+
+		// Nest all pods below a node allocatable cgroup so limits derived
+		// from the system and kube reservations can be enforced on pods as
+		// a group. The QoS containers, and thereby all pod cgroups, are
+		// created under this cgroup root.
+		nodeConfig.CgroupRoot = path.Join(nodeConfig.CgroupRoot, defaultNodeAllocatableCgroupName)
 	}
 	return &containerManagerImpl{
 		cadvisorInterface: cadvisorInterface,
@@ -360,6 +366,12 @@ func (cm *containerManagerImpl) setupNode() error {
 
 	// Setup top level qos containers only if CgroupsPerQOS flag is specified as true
 	if cm.NodeConfig.CgroupsPerQOS {
+		if err := cm.createNodeAllocatableCgroups(); err != nil {
+			return err
+		}
+		if err := cm.enforceNodeAllocatableCgroups(); err != nil {
+			return err
+		}
 		qosContainersInfo, err := InitQOS(cm.NodeConfig.CgroupDriver, cm.NodeConfig.CgroupRoot, cm.subsystems)
 		if err != nil {
 			return fmt.Errorf("failed to initialise top level QOS containers: %v", err)
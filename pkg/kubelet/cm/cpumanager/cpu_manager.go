@@ -0,0 +1,209 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cpumanager pins containers of Guaranteed pods with integral CPU
+// requests to exclusive CPUs. Assignments are checkpointed to disk so they
+// survive kubelet restarts, and a reconciliation loop re-applies them to the
+// container cgroups so drift (e.g. after a container restart) is corrected.
+package cpumanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	cadvisorapi "github.com/google/cadvisor/info/v1"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/kubelet/status"
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+// ActivePodsFunc returns the pods bound to the kubelet that are active.
+type ActivePodsFunc func() []*api.Pod
+
+// ContainerCPUSetUpdater applies a cpuset, in the Linux cpuset list format,
+// to a running container. It is implemented by container runtimes that
+// support updating the resources of a running container.
+type ContainerCPUSetUpdater interface {
+	UpdateContainerCPUSet(containerID string, cpus string) error
+}
+
+// Manager assigns CPUs to containers and keeps the container cgroups in sync
+// with the assignments.
+type Manager interface {
+	// Start validates the checkpointed state against the policy and begins
+	// the reconciliation loop.
+	Start(activePods ActivePodsFunc, podStatusProvider status.PodStatusProvider, updater ContainerCPUSetUpdater) error
+	// AddContainer assigns CPUs to the container, if the policy grants it
+	// an exclusive assignment.
+	AddContainer(pod *api.Pod, container *api.Container, containerID string) error
+	// RemoveContainer returns the CPUs of the container to the shared pool.
+	RemoveContainer(containerID string) error
+}
+
+type manager struct {
+	lock sync.Mutex
+
+	policy          Policy
+	state           State
+	reconcilePeriod time.Duration
+
+	activePods        ActivePodsFunc
+	podStatusProvider status.PodStatusProvider
+	updater           ContainerCPUSetUpdater
+}
+
+var _ Manager = &manager{}
+
+// NewManager returns a CPU manager using the given policy, checkpointing its
+// state under stateDir. numReservedCPUs is the size of the node's CPU
+// reservation, which the static policy keeps out of exclusive assignments.
+func NewManager(policyName string, reconcilePeriod time.Duration, machineInfo *cadvisorapi.MachineInfo, numReservedCPUs int, stateDir string) (Manager, error) {
+	var policy Policy
+	switch policyName {
+	case PolicyNone:
+		policy = NewNonePolicy()
+	case PolicyStatic:
+		allCPUs := cpusFromMachineInfo(machineInfo)
+		var err error
+		policy, err = NewStaticPolicy(allCPUs, numReservedCPUs)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown CPU manager policy %q", policyName)
+	}
+
+	state, err := newStateCheckpoint(stateDir, policy.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &manager{
+		policy:          policy,
+		state:           state,
+		reconcilePeriod: reconcilePeriod,
+	}, nil
+}
+
+func (m *manager) Start(activePods ActivePodsFunc, podStatusProvider status.PodStatusProvider, updater ContainerCPUSetUpdater) error {
+	glog.V(2).Infof("Starting CPU manager with policy %q, reconcile period %v", m.policy.Name(), m.reconcilePeriod)
+	if err := m.policy.Start(m.state); err != nil {
+		return fmt.Errorf("CPU manager policy %q failed to start: %v. Remove the %q checkpoint if the node's CPUs changed", m.policy.Name(), err, cpuManagerCheckpointKey)
+	}
+	if m.policy.Name() == PolicyNone {
+		// Nothing to reconcile, every container keeps its runtime defaults.
+		return nil
+	}
+	if updater == nil {
+		return fmt.Errorf("the %q CPU manager policy requires a container runtime that can update container cpusets", m.policy.Name())
+	}
+	m.activePods = activePods
+	m.podStatusProvider = podStatusProvider
+	m.updater = updater
+	go wait.Until(m.reconcileState, m.reconcilePeriod, wait.NeverStop)
+	return nil
+}
+
+func (m *manager) AddContainer(pod *api.Pod, container *api.Container, containerID string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.policy.AddContainer(m.state, pod, container, containerID)
+}
+
+func (m *manager) RemoveContainer(containerID string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.policy.RemoveContainer(m.state, containerID)
+}
+
+// reconcileState assigns CPUs to new containers, reclaims the CPUs of dead
+// ones and re-applies the cpusets to the running containers, correcting any
+// drift in the container cgroups.
+func (m *manager) reconcileState() {
+	runningContainers := make(map[string]bool)
+	for _, pod := range m.activePods() {
+		podStatus, ok := m.podStatusProvider.GetPodStatus(pod.UID)
+		if !ok {
+			continue
+		}
+		containers := append([]api.Container{}, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		for i := range containers {
+			container := &containers[i]
+			containerID, running := findRunningContainer(&podStatus, container.Name)
+			if !running {
+				continue
+			}
+			runningContainers[containerID] = true
+			if err := m.AddContainer(pod, container, containerID); err != nil {
+				glog.Errorf("Failed to assign CPUs to container %q in pod %q: %v", container.Name, pod.Name, err)
+				continue
+			}
+			cpus := m.state.GetCPUSetOrDefault(containerID)
+			if cpus.IsEmpty() {
+				continue
+			}
+			if err := m.updater.UpdateContainerCPUSet(containerID, cpus.String()); err != nil {
+				glog.Errorf("Failed to update cpuset of container %q in pod %q to %q: %v", container.Name, pod.Name, cpus, err)
+			}
+		}
+	}
+	// Reclaim the CPUs of containers that are gone.
+	for containerID := range m.state.GetAssignments() {
+		if runningContainers[containerID] {
+			continue
+		}
+		if err := m.RemoveContainer(containerID); err != nil {
+			glog.Errorf("Failed to reclaim CPUs of container %q: %v", containerID, err)
+		}
+	}
+}
+
+// findRunningContainer returns the runtime id of the named container if it is
+// currently running.
+func findRunningContainer(podStatus *api.PodStatus, name string) (containerID string, running bool) {
+	statuses := append([]api.ContainerStatus{}, podStatus.InitContainerStatuses...)
+	statuses = append(statuses, podStatus.ContainerStatuses...)
+	for i := range statuses {
+		containerStatus := &statuses[i]
+		if containerStatus.Name != name || containerStatus.State.Running == nil {
+			continue
+		}
+		return kubecontainer.ParseContainerID(containerStatus.ContainerID).ID, true
+	}
+	return "", false
+}
+
+// cpusFromMachineInfo returns the set of online CPUs of the node.
+func cpusFromMachineInfo(machineInfo *cadvisorapi.MachineInfo) cpuset.CPUSet {
+	cpus := []int{}
+	for _, node := range machineInfo.Topology {
+		for _, core := range node.Cores {
+			cpus = append(cpus, core.Threads...)
+		}
+	}
+	if len(cpus) == 0 {
+		// Fall back to the CPU count when no topology was discovered.
+		for cpu := 0; cpu < machineInfo.NumCores; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpuset.NewCPUSet(cpus...)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+	"k8s.io/kubernetes/pkg/types"
+)
+
+// fakeUpdater records the cpusets applied to containers.
+type fakeUpdater struct {
+	updates map[string]string
+}
+
+func (f *fakeUpdater) UpdateContainerCPUSet(containerID string, cpus string) error {
+	f.updates[containerID] = cpus
+	return nil
+}
+
+// fakePodStatusProvider serves canned pod statuses.
+type fakePodStatusProvider struct {
+	statuses map[types.UID]api.PodStatus
+}
+
+func (f *fakePodStatusProvider) GetPodStatus(uid types.UID) (api.PodStatus, bool) {
+	status, found := f.statuses[uid]
+	return status, found
+}
+
+func TestReconcileState(t *testing.T) {
+	policy, err := NewStaticPolicy(cpuset.NewCPUSet(0, 1, 2, 3), 1)
+	if err != nil {
+		t.Fatalf("NewStaticPolicy() failed: %v", err)
+	}
+	pod := makePod("2", "100Mi")
+	pod.UID = types.UID("pod1")
+	activePods := []*api.Pod{pod}
+	statusProvider := &fakePodStatusProvider{statuses: map[types.UID]api.PodStatus{
+		pod.UID: {
+			ContainerStatuses: []api.ContainerStatus{
+				{
+					Name:        "c1",
+					ContainerID: "docker://cid1",
+					State:       api.ContainerState{Running: &api.ContainerStateRunning{}},
+				},
+			},
+		},
+	}}
+	updater := &fakeUpdater{updates: map[string]string{}}
+
+	m := &manager{
+		policy:            policy,
+		state:             newStateMemory(),
+		activePods:        func() []*api.Pod { return activePods },
+		podStatusProvider: statusProvider,
+		updater:           updater,
+	}
+	if err := m.policy.Start(m.state); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	// The running container gets an exclusive assignment and its cgroup is
+	// updated to match.
+	m.reconcileState()
+	assigned, found := m.state.GetCPUSet("cid1")
+	if !found || assigned.Size() != 2 {
+		t.Fatalf("expected 2 exclusive CPUs for cid1, got %q", assigned)
+	}
+	if updater.updates["cid1"] != assigned.String() {
+		t.Errorf("container cpuset updated to %q, expected %q", updater.updates["cid1"], assigned)
+	}
+
+	// Once the pod is gone, its CPUs return to the shared pool.
+	activePods = []*api.Pod{}
+	m.reconcileState()
+	if _, found := m.state.GetCPUSet("cid1"); found {
+		t.Errorf("assignment of removed container still present")
+	}
+	if !m.state.GetDefaultCPUSet().Equals(cpuset.NewCPUSet(0, 1, 2, 3)) {
+		t.Errorf("shared pool %q was not restored", m.state.GetDefaultCPUSet())
+	}
+}
+
+func TestStateCheckpointRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cpumanager-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := newStateCheckpoint(dir, PolicyStatic)
+	if err != nil {
+		t.Fatalf("newStateCheckpoint() failed: %v", err)
+	}
+	s.SetDefaultCPUSet(cpuset.NewCPUSet(0, 1))
+	s.SetCPUSet("cid1", cpuset.NewCPUSet(2, 3))
+
+	// A new instance simulates a kubelet restart and restores the state.
+	restored, err := newStateCheckpoint(dir, PolicyStatic)
+	if err != nil {
+		t.Fatalf("newStateCheckpoint() failed: %v", err)
+	}
+	if !restored.GetDefaultCPUSet().Equals(cpuset.NewCPUSet(0, 1)) {
+		t.Errorf("restored shared pool %q, expected %q", restored.GetDefaultCPUSet(), cpuset.NewCPUSet(0, 1))
+	}
+	if assigned, found := restored.GetCPUSet("cid1"); !found || !assigned.Equals(cpuset.NewCPUSet(2, 3)) {
+		t.Errorf("restored assignment %q, expected %q", assigned, cpuset.NewCPUSet(2, 3))
+	}
+
+	// State written by another policy is discarded.
+	discarded, err := newStateCheckpoint(dir, PolicyNone)
+	if err != nil {
+		t.Fatalf("newStateCheckpoint() failed: %v", err)
+	}
+	if !discarded.GetDefaultCPUSet().IsEmpty() || len(discarded.GetAssignments()) != 0 {
+		t.Errorf("state of another policy was not discarded")
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// Policy decides which CPUs a container may run on.
+type Policy interface {
+	// Name returns the name of the policy.
+	Name() string
+	// Start initializes or validates the state before the policy is used.
+	Start(s State) error
+	// AddContainer updates the state with the CPU assignment of a new
+	// container, if the policy grants it one.
+	AddContainer(s State, pod *api.Pod, container *api.Container, containerID string) error
+	// RemoveContainer removes the CPU assignment of a container, returning
+	// its CPUs to the shared pool.
+	RemoveContainer(s State, containerID string) error
+}
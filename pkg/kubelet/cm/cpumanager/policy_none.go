@@ -0,0 +1,51 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// PolicyNone is the name of the none policy.
+const PolicyNone = "none"
+
+// nonePolicy preserves the existing behavior: no exclusive CPU assignments,
+// every container runs on the shared pool.
+type nonePolicy struct{}
+
+var _ Policy = &nonePolicy{}
+
+// NewNonePolicy returns a policy that assigns no exclusive CPUs.
+func NewNonePolicy() Policy {
+	return &nonePolicy{}
+}
+
+func (p *nonePolicy) Name() string {
+	return PolicyNone
+}
+
+func (p *nonePolicy) Start(s State) error {
+	return nil
+}
+
+func (p *nonePolicy) AddContainer(s State, pod *api.Pod, container *api.Container, containerID string) error {
+	return nil
+}
+
+func (p *nonePolicy) RemoveContainer(s State, containerID string) error {
+	return nil
+}
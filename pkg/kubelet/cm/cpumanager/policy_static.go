@@ -0,0 +1,143 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+	"k8s.io/kubernetes/pkg/kubelet/qos"
+)
+
+// PolicyStatic is the name of the static policy.
+const PolicyStatic = "static"
+
+// staticPolicy assigns exclusive CPUs to Guaranteed pods whose containers
+// request an integral number of CPUs. The assigned CPUs are removed from the
+// shared pool, so no other container is scheduled onto them. All other
+// containers run on the shared pool. A number of CPUs equal to the node's CPU
+// reservation stays in the shared pool at all times, so system daemons and
+// the kubelet itself are never starved.
+type staticPolicy struct {
+	// allCPUs is the set of online CPUs on the node.
+	allCPUs cpuset.CPUSet
+	// reserved is the set of CPUs that are never assigned exclusively,
+	// sized after the node's CPU reservation.
+	reserved cpuset.CPUSet
+}
+
+var _ Policy = &staticPolicy{}
+
+// NewStaticPolicy returns a policy that assigns exclusive CPUs out of the
+// given set, keeping numReservedCPUs of them in the shared pool at all times.
+func NewStaticPolicy(allCPUs cpuset.CPUSet, numReservedCPUs int) (Policy, error) {
+	if numReservedCPUs < 1 {
+		return nil, fmt.Errorf("the static CPU manager policy requires a CPU reservation of at least one CPU, set --kube-reserved or --system-reserved")
+	}
+	if numReservedCPUs >= allCPUs.Size() {
+		return nil, fmt.Errorf("the CPU reservation of %d CPUs leaves no CPUs for exclusive assignment on a node with %d CPUs", numReservedCPUs, allCPUs.Size())
+	}
+	// Reserve the lowest-numbered CPUs, which is where system daemons are
+	// conventionally pinned.
+	reserved := cpuset.NewCPUSet(allCPUs.ToSlice()[:numReservedCPUs]...)
+	glog.Infof("Static CPU manager policy reserved CPUs %q for the shared pool", reserved)
+	return &staticPolicy{allCPUs: allCPUs, reserved: reserved}, nil
+}
+
+func (p *staticPolicy) Name() string {
+	return PolicyStatic
+}
+
+func (p *staticPolicy) Start(s State) error {
+	if s.GetDefaultCPUSet().IsEmpty() && len(s.GetAssignments()) == 0 {
+		// No state was checkpointed, everything starts in the shared pool.
+		s.SetDefaultCPUSet(p.allCPUs)
+		return nil
+	}
+	// Validate the checkpointed state against the machine: the shared pool
+	// and the exclusive assignments must partition the online CPUs.
+	seen := s.GetDefaultCPUSet()
+	for containerID, assigned := range s.GetAssignments() {
+		if !seen.Intersection(assigned).IsEmpty() {
+			return fmt.Errorf("checkpointed CPU assignment of container %q overlaps other assignments", containerID)
+		}
+		seen = seen.Union(assigned)
+	}
+	if !seen.Equals(p.allCPUs) {
+		return fmt.Errorf("checkpointed CPU state %q does not match the online CPUs %q", seen, p.allCPUs)
+	}
+	return nil
+}
+
+func (p *staticPolicy) AddContainer(s State, pod *api.Pod, container *api.Container, containerID string) error {
+	numCPUs := guaranteedCPUs(pod, container)
+	if numCPUs == 0 {
+		// The container runs on the shared pool.
+		return nil
+	}
+	if _, found := s.GetCPUSet(containerID); found {
+		// The container was already assigned CPUs, e.g. before a restart.
+		return nil
+	}
+	assigned, err := p.allocateCPUs(s, numCPUs)
+	if err != nil {
+		return err
+	}
+	s.SetCPUSet(containerID, assigned)
+	glog.V(2).Infof("Static CPU manager policy assigned CPUs %q to container %q", assigned, containerID)
+	return nil
+}
+
+func (p *staticPolicy) RemoveContainer(s State, containerID string) error {
+	assigned, found := s.GetCPUSet(containerID)
+	if !found {
+		return nil
+	}
+	s.Delete(containerID)
+	s.SetDefaultCPUSet(s.GetDefaultCPUSet().Union(assigned))
+	glog.V(2).Infof("Static CPU manager policy returned CPUs %q of container %q to the shared pool", assigned, containerID)
+	return nil
+}
+
+// allocateCPUs takes numCPUs out of the shared pool, never touching the
+// reserved CPUs.
+func (p *staticPolicy) allocateCPUs(s State, numCPUs int) (cpuset.CPUSet, error) {
+	assignable := s.GetDefaultCPUSet().Difference(p.reserved)
+	if numCPUs > assignable.Size() {
+		return cpuset.NewCPUSet(), fmt.Errorf("not enough CPUs available for exclusive assignment, requested %d but only %d assignable", numCPUs, assignable.Size())
+	}
+	assigned := cpuset.NewCPUSet(assignable.ToSlice()[:numCPUs]...)
+	s.SetDefaultCPUSet(s.GetDefaultCPUSet().Difference(assigned))
+	return assigned, nil
+}
+
+// guaranteedCPUs returns the number of CPUs the container gets exclusively,
+// or zero if it is not entitled to any: only containers of Guaranteed pods
+// requesting an integral number of CPUs are pinned.
+func guaranteedCPUs(pod *api.Pod, container *api.Container) int {
+	if qos.GetPodQOS(pod) != qos.Guaranteed {
+		return 0
+	}
+	cpuQuantity := container.Resources.Requests[api.ResourceCPU]
+	if cpuQuantity.Value()*1000 != cpuQuantity.MilliValue() {
+		return 0
+	}
+	return int(cpuQuantity.Value())
+}
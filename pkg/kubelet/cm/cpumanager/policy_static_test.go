@@ -0,0 +1,178 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+)
+
+// makePod returns a pod with a single container requesting the given amount
+// of CPU and memory for both requests and limits.
+func makePod(cpu, memory string) *api.Pod {
+	resources := api.ResourceList{
+		api.ResourceCPU:    resource.MustParse(cpu),
+		api.ResourceMemory: resource.MustParse(memory),
+	}
+	return &api.Pod{
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name: "c1",
+					Resources: api.ResourceRequirements{
+						Requests: resources,
+						Limits:   resources,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGuaranteedCPUs(t *testing.T) {
+	testCases := []struct {
+		description string
+		pod         *api.Pod
+		expected    int
+	}{
+		{
+			description: "integral request of a Guaranteed pod",
+			pod:         makePod("2", "100Mi"),
+			expected:    2,
+		},
+		{
+			description: "fractional request of a Guaranteed pod",
+			pod:         makePod("1500m", "100Mi"),
+			expected:    0,
+		},
+		{
+			description: "burstable pod",
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name: "c1",
+							Resources: api.ResourceRequirements{
+								Requests: api.ResourceList{
+									api.ResourceCPU: resource.MustParse("2"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: 0,
+		},
+		{
+			description: "best effort pod",
+			pod:         &api.Pod{Spec: api.PodSpec{Containers: []api.Container{{Name: "c1"}}}},
+			expected:    0,
+		},
+	}
+	for _, tc := range testCases {
+		pod := tc.pod
+		if result := guaranteedCPUs(pod, &pod.Spec.Containers[0]); result != tc.expected {
+			t.Errorf("%s: expected %d exclusive CPUs, got %d", tc.description, tc.expected, result)
+		}
+	}
+}
+
+func TestStaticPolicyAddRemove(t *testing.T) {
+	policy, err := NewStaticPolicy(cpuset.NewCPUSet(0, 1, 2, 3), 1)
+	if err != nil {
+		t.Fatalf("NewStaticPolicy() failed: %v", err)
+	}
+	s := newStateMemory()
+	if err := policy.Start(s); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	if !s.GetDefaultCPUSet().Equals(cpuset.NewCPUSet(0, 1, 2, 3)) {
+		t.Fatalf("unexpected initial shared pool %q", s.GetDefaultCPUSet())
+	}
+
+	pod := makePod("2", "100Mi")
+	if err := policy.AddContainer(s, pod, &pod.Spec.Containers[0], "cid1"); err != nil {
+		t.Fatalf("AddContainer() failed: %v", err)
+	}
+	assigned, found := s.GetCPUSet("cid1")
+	if !found || assigned.Size() != 2 {
+		t.Fatalf("expected 2 exclusive CPUs, got %q", assigned)
+	}
+	if assigned.Contains(0) {
+		t.Errorf("assignment %q includes reserved CPU 0", assigned)
+	}
+	if !s.GetDefaultCPUSet().Equals(cpuset.NewCPUSet(0, 1, 2, 3).Difference(assigned)) {
+		t.Errorf("shared pool %q was not reduced by the assignment %q", s.GetDefaultCPUSet(), assigned)
+	}
+
+	// A second exclusive container cannot fit anymore.
+	if err := policy.AddContainer(s, pod, &pod.Spec.Containers[0], "cid2"); err == nil {
+		t.Errorf("AddContainer() should have failed, only reserved CPUs are left")
+	}
+
+	// Removing the container returns its CPUs to the shared pool.
+	if err := policy.RemoveContainer(s, "cid1"); err != nil {
+		t.Fatalf("RemoveContainer() failed: %v", err)
+	}
+	if _, found := s.GetCPUSet("cid1"); found {
+		t.Errorf("assignment of removed container still present")
+	}
+	if !s.GetDefaultCPUSet().Equals(cpuset.NewCPUSet(0, 1, 2, 3)) {
+		t.Errorf("shared pool %q was not restored", s.GetDefaultCPUSet())
+	}
+}
+
+func TestStaticPolicyStartValidation(t *testing.T) {
+	policy, err := NewStaticPolicy(cpuset.NewCPUSet(0, 1, 2, 3), 1)
+	if err != nil {
+		t.Fatalf("NewStaticPolicy() failed: %v", err)
+	}
+
+	// A checkpointed state that does not cover the online CPUs is rejected.
+	s := newStateMemory()
+	s.SetDefaultCPUSet(cpuset.NewCPUSet(0, 1))
+	if err := policy.Start(s); err == nil {
+		t.Errorf("Start() should have rejected state not covering the online CPUs")
+	}
+
+	// Overlapping assignments are rejected.
+	s = newStateMemory()
+	s.SetDefaultCPUSet(cpuset.NewCPUSet(0, 1, 2))
+	s.SetCPUSet("cid1", cpuset.NewCPUSet(2, 3))
+	if err := policy.Start(s); err == nil {
+		t.Errorf("Start() should have rejected overlapping assignments")
+	}
+
+	// A consistent checkpointed state is accepted.
+	s = newStateMemory()
+	s.SetDefaultCPUSet(cpuset.NewCPUSet(0, 1))
+	s.SetCPUSet("cid1", cpuset.NewCPUSet(2, 3))
+	if err := policy.Start(s); err != nil {
+		t.Errorf("Start() rejected a consistent state: %v", err)
+	}
+
+	// The static policy requires a CPU reservation.
+	if _, err := NewStaticPolicy(cpuset.NewCPUSet(0, 1), 0); err == nil {
+		t.Errorf("NewStaticPolicy() should require a CPU reservation")
+	}
+	if _, err := NewStaticPolicy(cpuset.NewCPUSet(0, 1), 2); err == nil {
+		t.Errorf("NewStaticPolicy() should reject a reservation covering all CPUs")
+	}
+}
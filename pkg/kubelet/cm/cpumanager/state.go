@@ -0,0 +1,108 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"sync"
+
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+)
+
+// State keeps track of the CPUs assigned exclusively to containers and of the
+// shared pool of CPUs available to everything else.
+type State interface {
+	// GetCPUSet returns the CPUs assigned exclusively to the container.
+	GetCPUSet(containerID string) (cpuset.CPUSet, bool)
+	// GetCPUSetOrDefault returns the CPUs assigned exclusively to the
+	// container, or the shared pool if it has no exclusive assignment.
+	GetCPUSetOrDefault(containerID string) cpuset.CPUSet
+	// GetDefaultCPUSet returns the shared pool of CPUs.
+	GetDefaultCPUSet() cpuset.CPUSet
+	// GetAssignments returns the exclusive assignments of all containers.
+	GetAssignments() map[string]cpuset.CPUSet
+	// SetCPUSet assigns the CPUs exclusively to the container.
+	SetCPUSet(containerID string, cpus cpuset.CPUSet)
+	// SetDefaultCPUSet replaces the shared pool of CPUs.
+	SetDefaultCPUSet(cpus cpuset.CPUSet)
+	// Delete removes the exclusive assignment of the container.
+	Delete(containerID string)
+}
+
+// stateMemory holds the assignments in memory.
+type stateMemory struct {
+	lock          sync.RWMutex
+	assignments   map[string]cpuset.CPUSet
+	defaultCPUSet cpuset.CPUSet
+}
+
+var _ State = &stateMemory{}
+
+// newStateMemory returns an empty in-memory State.
+func newStateMemory() *stateMemory {
+	return &stateMemory{
+		assignments:   map[string]cpuset.CPUSet{},
+		defaultCPUSet: cpuset.NewCPUSet(),
+	}
+}
+
+func (s *stateMemory) GetCPUSet(containerID string) (cpuset.CPUSet, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	cpus, found := s.assignments[containerID]
+	return cpus, found
+}
+
+func (s *stateMemory) GetCPUSetOrDefault(containerID string) cpuset.CPUSet {
+	if cpus, found := s.GetCPUSet(containerID); found {
+		return cpus
+	}
+	return s.GetDefaultCPUSet()
+}
+
+func (s *stateMemory) GetDefaultCPUSet() cpuset.CPUSet {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.defaultCPUSet
+}
+
+func (s *stateMemory) GetAssignments() map[string]cpuset.CPUSet {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	assignments := make(map[string]cpuset.CPUSet, len(s.assignments))
+	for containerID, cpus := range s.assignments {
+		assignments[containerID] = cpus
+	}
+	return assignments
+}
+
+func (s *stateMemory) SetCPUSet(containerID string, cpus cpuset.CPUSet) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.assignments[containerID] = cpus
+}
+
+func (s *stateMemory) SetDefaultCPUSet(cpus cpuset.CPUSet) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.defaultCPUSet = cpus
+}
+
+func (s *stateMemory) Delete(containerID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.assignments, containerID)
+}
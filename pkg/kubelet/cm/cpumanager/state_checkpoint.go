@@ -0,0 +1,136 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpumanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/kubelet/checkpoint"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpuset"
+)
+
+// cpuManagerCheckpointKey is the key the CPU manager state is stored under in
+// the kubelet's checkpoint directory.
+const cpuManagerCheckpointKey = "cpu_manager_state"
+
+// stateData is the serialized form of the CPU manager state.
+type stateData struct {
+	PolicyName    string            `json:"policyName"`
+	DefaultCPUSet string            `json:"defaultCpuSet"`
+	Entries       map[string]string `json:"entries,omitempty"`
+}
+
+// stateCheckpoint is a State that persists every mutation to the kubelet's
+// checkpoint directory, so CPU assignments survive kubelet restarts.
+type stateCheckpoint struct {
+	*stateMemory
+	store      checkpoint.Store
+	policyName string
+}
+
+var _ State = &stateCheckpoint{}
+
+// newStateCheckpoint returns a State persisting to the given checkpoint
+// directory, restoring the state of a previous kubelet run if it was written
+// by the same policy.
+func newStateCheckpoint(stateDir, policyName string) (State, error) {
+	store, err := checkpoint.NewFileStore(stateDir)
+	if err != nil {
+		return nil, err
+	}
+	sc := &stateCheckpoint{
+		stateMemory: newStateMemory(),
+		store:       store,
+		policyName:  policyName,
+	}
+	if err := sc.restore(); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// restore loads the checkpointed state, if any, into memory.
+func (sc *stateCheckpoint) restore() error {
+	data, err := sc.store.Read(cpuManagerCheckpointKey)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read CPU manager checkpoint: %v", err)
+	}
+	var checkpointed stateData
+	if err := json.Unmarshal(data, &checkpointed); err != nil {
+		return fmt.Errorf("failed to decode CPU manager checkpoint: %v", err)
+	}
+	if checkpointed.PolicyName != sc.policyName {
+		glog.Warningf("Discarding CPU manager checkpoint written by policy %q, current policy is %q", checkpointed.PolicyName, sc.policyName)
+		return nil
+	}
+	defaultCPUSet, err := cpuset.Parse(checkpointed.DefaultCPUSet)
+	if err != nil {
+		return fmt.Errorf("invalid default cpuset in CPU manager checkpoint: %v", err)
+	}
+	sc.stateMemory.SetDefaultCPUSet(defaultCPUSet)
+	for containerID, cpus := range checkpointed.Entries {
+		assigned, err := cpuset.Parse(cpus)
+		if err != nil {
+			return fmt.Errorf("invalid cpuset in CPU manager checkpoint for container %q: %v", containerID, err)
+		}
+		sc.stateMemory.SetCPUSet(containerID, assigned)
+	}
+	glog.V(2).Infof("Restored CPU manager state from checkpoint (default cpuset %q, %d assignments)", defaultCPUSet, len(checkpointed.Entries))
+	return nil
+}
+
+// persist writes the current state to the checkpoint store.
+func (sc *stateCheckpoint) persist() {
+	checkpointed := stateData{
+		PolicyName:    sc.policyName,
+		DefaultCPUSet: sc.stateMemory.GetDefaultCPUSet().String(),
+		Entries:       map[string]string{},
+	}
+	for containerID, cpus := range sc.stateMemory.GetAssignments() {
+		checkpointed.Entries[containerID] = cpus.String()
+	}
+	data, err := json.Marshal(checkpointed)
+	if err != nil {
+		glog.Errorf("Failed to encode CPU manager checkpoint: %v", err)
+		return
+	}
+	if err := sc.store.Write(cpuManagerCheckpointKey, data); err != nil {
+		glog.Errorf("Failed to write CPU manager checkpoint: %v", err)
+	}
+}
+
+func (sc *stateCheckpoint) SetCPUSet(containerID string, cpus cpuset.CPUSet) {
+	sc.stateMemory.SetCPUSet(containerID, cpus)
+	sc.persist()
+}
+
+func (sc *stateCheckpoint) SetDefaultCPUSet(cpus cpuset.CPUSet) {
+	sc.stateMemory.SetDefaultCPUSet(cpus)
+	sc.persist()
+}
+
+func (sc *stateCheckpoint) Delete(containerID string) {
+	sc.stateMemory.Delete(containerID)
+	sc.persist()
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cpuset provides an immutable set of CPU ids and conversion to and
+// from the Linux cpuset list format (e.g. "0-3,8,10-11") used by cgroups.
+package cpuset
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CPUSet is an immutable set of CPU ids.
+type CPUSet struct {
+	elems map[int]struct{}
+}
+
+// NewCPUSet returns a CPUSet containing the given CPU ids.
+func NewCPUSet(cpus ...int) CPUSet {
+	s := CPUSet{elems: map[int]struct{}{}}
+	for _, cpu := range cpus {
+		s.elems[cpu] = struct{}{}
+	}
+	return s
+}
+
+// Size returns the number of CPUs in the set.
+func (s CPUSet) Size() int {
+	return len(s.elems)
+}
+
+// IsEmpty returns true if the set contains no CPUs.
+func (s CPUSet) IsEmpty() bool {
+	return len(s.elems) == 0
+}
+
+// Contains returns true if the given CPU is in the set.
+func (s CPUSet) Contains(cpu int) bool {
+	_, found := s.elems[cpu]
+	return found
+}
+
+// Equals returns true if both sets contain exactly the same CPUs.
+func (s CPUSet) Equals(other CPUSet) bool {
+	if len(s.elems) != len(other.elems) {
+		return false
+	}
+	for cpu := range s.elems {
+		if !other.Contains(cpu) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a new set containing the CPUs of both sets.
+func (s CPUSet) Union(other CPUSet) CPUSet {
+	result := NewCPUSet()
+	for cpu := range s.elems {
+		result.elems[cpu] = struct{}{}
+	}
+	for cpu := range other.elems {
+		result.elems[cpu] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns a new set containing the CPUs present in both sets.
+func (s CPUSet) Intersection(other CPUSet) CPUSet {
+	result := NewCPUSet()
+	for cpu := range s.elems {
+		if other.Contains(cpu) {
+			result.elems[cpu] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set containing the CPUs of this set that are not
+// in the other set.
+func (s CPUSet) Difference(other CPUSet) CPUSet {
+	result := NewCPUSet()
+	for cpu := range s.elems {
+		if !other.Contains(cpu) {
+			result.elems[cpu] = struct{}{}
+		}
+	}
+	return result
+}
+
+// ToSlice returns the CPUs of the set as a sorted slice.
+func (s CPUSet) ToSlice() []int {
+	result := []int{}
+	for cpu := range s.elems {
+		result = append(result, cpu)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// String returns the set in the Linux cpuset list format, e.g. "0-3,8".
+func (s CPUSet) String() string {
+	if s.IsEmpty() {
+		return ""
+	}
+	cpus := s.ToSlice()
+
+	var result bytes.Buffer
+	rangeStart := cpus[0]
+	rangeEnd := cpus[0]
+	emit := func() {
+		if result.Len() > 0 {
+			result.WriteString(",")
+		}
+		if rangeStart == rangeEnd {
+			result.WriteString(strconv.Itoa(rangeStart))
+		} else {
+			result.WriteString(fmt.Sprintf("%d-%d", rangeStart, rangeEnd))
+		}
+	}
+	for _, cpu := range cpus[1:] {
+		if cpu == rangeEnd+1 {
+			rangeEnd = cpu
+			continue
+		}
+		emit()
+		rangeStart, rangeEnd = cpu, cpu
+	}
+	emit()
+	return result.String()
+}
+
+// Parse converts the Linux cpuset list format into a CPUSet. The empty string
+// parses to the empty set.
+func Parse(s string) (CPUSet, error) {
+	result := NewCPUSet()
+	if s == "" {
+		return result, nil
+	}
+	for _, element := range strings.Split(s, ",") {
+		if !strings.Contains(element, "-") {
+			cpu, err := strconv.Atoi(element)
+			if err != nil {
+				return NewCPUSet(), fmt.Errorf("invalid cpuset %q: %v", s, err)
+			}
+			result.elems[cpu] = struct{}{}
+			continue
+		}
+		bounds := strings.SplitN(element, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return NewCPUSet(), fmt.Errorf("invalid cpuset %q: %v", s, err)
+		}
+		last, err := strconv.Atoi(bounds[1])
+		if err != nil {
+			return NewCPUSet(), fmt.Errorf("invalid cpuset %q: %v", s, err)
+		}
+		if first > last {
+			return NewCPUSet(), fmt.Errorf("invalid cpuset %q: range %q is reversed", s, element)
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			result.elems[cpu] = struct{}{}
+		}
+	}
+	return result, nil
+}
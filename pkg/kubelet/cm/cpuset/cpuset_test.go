@@ -0,0 +1,97 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpuset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCPUSetString(t *testing.T) {
+	testCases := []struct {
+		set      CPUSet
+		expected string
+	}{
+		{NewCPUSet(), ""},
+		{NewCPUSet(5), "5"},
+		{NewCPUSet(0, 1, 2, 3), "0-3"},
+		{NewCPUSet(3, 1, 0, 2), "0-3"},
+		{NewCPUSet(0, 2, 4), "0,2,4"},
+		{NewCPUSet(0, 1, 2, 8, 10, 11), "0-2,8,10-11"},
+	}
+	for _, tc := range testCases {
+		if result := tc.set.String(); result != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, result)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		cpusetString string
+		expected     CPUSet
+	}{
+		{"", NewCPUSet()},
+		{"5", NewCPUSet(5)},
+		{"0-3", NewCPUSet(0, 1, 2, 3)},
+		{"0,2,4", NewCPUSet(0, 2, 4)},
+		{"0-2,8,10-11", NewCPUSet(0, 1, 2, 8, 10, 11)},
+	}
+	for _, tc := range testCases {
+		result, err := Parse(tc.cpusetString)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", tc.cpusetString, err)
+			continue
+		}
+		if !result.Equals(tc.expected) {
+			t.Errorf("Parse(%q) returned %q, expected %q", tc.cpusetString, result, tc.expected)
+		}
+	}
+
+	for _, invalid := range []string{"nonsense", "0-", "-1", "2-1", "1,,2"} {
+		if _, err := Parse(invalid); err == nil {
+			t.Errorf("Parse(%q) should have failed", invalid)
+		}
+	}
+}
+
+func TestCPUSetOperations(t *testing.T) {
+	a := NewCPUSet(0, 1, 2, 3)
+	b := NewCPUSet(2, 3, 4, 5)
+
+	if result := a.Union(b); !result.Equals(NewCPUSet(0, 1, 2, 3, 4, 5)) {
+		t.Errorf("Union returned %q", result)
+	}
+	if result := a.Intersection(b); !result.Equals(NewCPUSet(2, 3)) {
+		t.Errorf("Intersection returned %q", result)
+	}
+	if result := a.Difference(b); !result.Equals(NewCPUSet(0, 1)) {
+		t.Errorf("Difference returned %q", result)
+	}
+	if !a.Contains(0) || a.Contains(4) {
+		t.Errorf("Contains misreported membership in %q", a)
+	}
+	if a.Equals(b) || !a.Equals(NewCPUSet(3, 2, 1, 0)) {
+		t.Errorf("Equals misreported equality of %q and %q", a, b)
+	}
+	if result := a.ToSlice(); !reflect.DeepEqual(result, []int{0, 1, 2, 3}) {
+		t.Errorf("ToSlice returned %v", result)
+	}
+	if NewCPUSet().Size() != 0 || a.Size() != 4 || !NewCPUSet().IsEmpty() {
+		t.Errorf("Size or IsEmpty misreported set cardinality")
+	}
+}
@@ -0,0 +1,114 @@
+// +build linux
+
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cm
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
+)
+
+const (
+	// defaultNodeAllocatableCgroupName is the name of the cgroup, relative to
+	// the configured cgroup root, under which all pods are placed when the
+	// QoS cgroup hierarchy is enabled.
+	defaultNodeAllocatableCgroupName = "kubepods"
+)
+
+// createNodeAllocatableCgroups creates the top level cgroup that parents all
+// pod cgroups. It must be called before the QoS containers are created since
+// those are nested under it.
+func (cm *containerManagerImpl) createNodeAllocatableCgroups() error {
+	cgroupManager := NewCgroupManager(cm.subsystems, cm.NodeConfig.CgroupDriver)
+	cgroupConfig := &CgroupConfig{
+		Name:               CgroupName(cm.NodeConfig.CgroupRoot),
+		ResourceParameters: &ResourceConfig{},
+	}
+	if cgroupManager.Exists(cgroupConfig.Name) {
+		return nil
+	}
+	if err := cgroupManager.Create(cgroupConfig); err != nil {
+		return fmt.Errorf("failed to create node allocatable cgroup %v: %v", cgroupConfig.Name, err)
+	}
+	return nil
+}
+
+// enforceNodeAllocatableCgroups applies limits derived from the system and
+// kube reservations to the cgroup that parents all pods. Host daemons outside
+// that cgroup keep their reservations even when pods would otherwise consume
+// the whole machine.
+func (cm *containerManagerImpl) enforceNodeAllocatableCgroups() error {
+	info, err := cm.cadvisorInterface.MachineInfo()
+	if err != nil {
+		return fmt.Errorf("failed to get machine info: %v", err)
+	}
+	capacity := cadvisor.CapacityFromMachineInfo(info)
+	allocatable, err := nodeAllocatable(capacity, cm.NodeConfig.SystemReserved, cm.NodeConfig.KubeReserved)
+	if err != nil {
+		return err
+	}
+	cgroupConfig := &CgroupConfig{
+		Name:               CgroupName(cm.NodeConfig.CgroupRoot),
+		ResourceParameters: cgroupConfigFromResourceList(allocatable),
+	}
+	glog.V(2).Infof("Enforcing node allocatable on cgroup %v with limits %+v", cgroupConfig.Name, cgroupConfig.ResourceParameters)
+	cgroupManager := NewCgroupManager(cm.subsystems, cm.NodeConfig.CgroupDriver)
+	if err := cgroupManager.Update(cgroupConfig); err != nil {
+		return fmt.Errorf("failed to enforce node allocatable on cgroup %v: %v", cgroupConfig.Name, err)
+	}
+	return nil
+}
+
+// nodeAllocatable subtracts the system and kube reservations from the machine
+// capacity. An error is returned if the reservations exceed the capacity of
+// any resource, so misconfigured reservations are caught on startup instead of
+// producing an unusable node.
+func nodeAllocatable(capacity, systemReserved, kubeReserved api.ResourceList) (api.ResourceList, error) {
+	result := api.ResourceList{}
+	for name, quantity := range capacity {
+		systemReservation := systemReserved[name]
+		kubeReservation := kubeReserved[name]
+		value := quantity.Copy()
+		value.Sub(systemReservation)
+		value.Sub(kubeReservation)
+		if value.Sign() < 0 {
+			return nil, fmt.Errorf("invalid configuration: resource %q has capacity %s which is smaller than the sum of system reserved %s and kube reserved %s", name, quantity.String(), systemReservation.String(), kubeReservation.String())
+		}
+		result[name] = *value
+	}
+	return result, nil
+}
+
+// cgroupConfigFromResourceList converts the given resource list to the cgroup
+// resource parameters that enforce it. Only cpu and memory are supported.
+func cgroupConfigFromResourceList(rl api.ResourceList) *ResourceConfig {
+	rc := &ResourceConfig{}
+	if q, exists := rl[api.ResourceMemory]; exists {
+		memoryLimit := q.Value()
+		rc.Memory = &memoryLimit
+	}
+	if q, exists := rl[api.ResourceCPU]; exists {
+		cpuShares := MilliCPUToShares(q.MilliValue())
+		rc.CpuShares = &cpuShares
+	}
+	return rc
+}
@@ -0,0 +1,112 @@
+// +build linux
+
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cm
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestNodeAllocatable(t *testing.T) {
+	testCases := map[string]struct {
+		capacity       api.ResourceList
+		systemReserved api.ResourceList
+		kubeReserved   api.ResourceList
+		expected       api.ResourceList
+		expectError    bool
+	}{
+		"no-reservations": {
+			capacity: getResourceList("4", "8Gi"),
+			expected: getResourceList("4", "8Gi"),
+		},
+		"both-reservations": {
+			capacity:       getResourceList("4", "8Gi"),
+			systemReserved: getResourceList("500m", "1Gi"),
+			kubeReserved:   getResourceList("1", "2Gi"),
+			expected:       getResourceList("2500m", "5Gi"),
+		},
+		"memory-only-reservation": {
+			capacity:       getResourceList("4", "8Gi"),
+			systemReserved: getResourceList("", "1Gi"),
+			expected:       getResourceList("4", "7Gi"),
+		},
+		"fully-reserved": {
+			capacity:     getResourceList("4", "8Gi"),
+			kubeReserved: getResourceList("4", "8Gi"),
+			expected:     getResourceList("0", "0"),
+		},
+		"cpu-over-reserved": {
+			capacity:       getResourceList("4", "8Gi"),
+			systemReserved: getResourceList("3", ""),
+			kubeReserved:   getResourceList("2", ""),
+			expectError:    true,
+		},
+		"memory-over-reserved": {
+			capacity:     getResourceList("4", "8Gi"),
+			kubeReserved: getResourceList("", "10Gi"),
+			expectError:  true,
+		},
+	}
+	for testName, testCase := range testCases {
+		actual, err := nodeAllocatable(testCase.capacity, testCase.systemReserved, testCase.kubeReserved)
+		if testCase.expectError {
+			if err == nil {
+				t.Errorf("unexpected success for test case %q: got %v", testName, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for test case %q: %v", testName, err)
+			continue
+		}
+		for name, expected := range testCase.expected {
+			quantity, exists := actual[name]
+			if !exists {
+				t.Errorf("missing resource %q for test case %q", name, testName)
+				continue
+			}
+			if quantity.Cmp(expected) != 0 {
+				t.Errorf("unexpected allocatable %q for test case %q: expected %s, got %s", name, testName, expected.String(), quantity.String())
+			}
+		}
+	}
+}
+
+func TestCgroupConfigFromResourceList(t *testing.T) {
+	memoryQuantity := getResourceList("", "6Gi")[api.ResourceMemory]
+	expectedMemory := memoryQuantity.Value()
+	expectedShares := MilliCPUToShares(2500)
+
+	actual := cgroupConfigFromResourceList(getResourceList("2500m", "6Gi"))
+	if actual.Memory == nil || *actual.Memory != expectedMemory {
+		t.Errorf("unexpected memory limit: expected %d, got %v", expectedMemory, actual.Memory)
+	}
+	if actual.CpuShares == nil || *actual.CpuShares != expectedShares {
+		t.Errorf("unexpected cpu shares: expected %d, got %v", expectedShares, actual.CpuShares)
+	}
+	if actual.CpuQuota != nil || actual.CpuPeriod != nil {
+		t.Errorf("expected cpu quota and period to be unset, got %+v", actual)
+	}
+
+	actual = cgroupConfigFromResourceList(api.ResourceList{})
+	if actual.Memory != nil || actual.CpuShares != nil {
+		t.Errorf("expected no limits for an empty resource list, got %+v", actual)
+	}
+}
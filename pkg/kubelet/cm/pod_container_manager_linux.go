@@ -52,12 +52,16 @@ type podContainerManagerImpl struct {
 // Make sure that podContainerManagerImpl implements the PodContainerManager interface
 var _ PodContainerManager = &podContainerManagerImpl{}
 
-// applyLimits sets pod cgroup resource limits
-// It also updates the resource limits on top level qos containers.
+// applyLimits sets pod cgroup resource limits derived from the pod spec.
+// It runs on every sync so that changes to the pod's resource requirements
+// are reflected on an already existing pod cgroup.
 func (m *podContainerManagerImpl) applyLimits(pod *api.Pod) error {
-	// This function will house the logic for setting the resource parameters
-	// on the pod container config and updating top level qos container configs
-	return nil
+	podContainerName, _ := m.GetPodContainerName(pod)
+	containerConfig := &CgroupConfig{
+		Name:               podContainerName,
+		ResourceParameters: ResourceConfigForPod(pod),
+	}
+	return m.cgroupManager.Update(containerConfig)
 }
 
 // Exists checks if the pod's cgroup already exists
@@ -40,6 +40,8 @@ type ContainerGCPolicy struct {
 type ContainerGC interface {
 	// Garbage collect containers.
 	GarbageCollect(allSourcesReady bool) error
+	// Deletes all unused containers, including containers belonging to pods that are terminated but not deleted
+	DeleteAllUnusedContainers() error
 }
 
 // TODO(vmarmol): Preferentially remove pod infra containers.
@@ -66,3 +68,8 @@ func NewContainerGC(runtime Runtime, policy ContainerGCPolicy) (ContainerGC, err
 func (cgc *realContainerGC) GarbageCollect(allSourcesReady bool) error {
 	return cgc.runtime.GarbageCollect(cgc.policy, allSourcesReady)
 }
+
+func (cgc *realContainerGC) DeleteAllUnusedContainers() error {
+	// a policy with no limits deletes all dead containers immediately.
+	return cgc.runtime.GarbageCollect(ContainerGCPolicy{MinAge: 0, MaxPerPodContainer: 0, MaxContainers: 0}, true)
+}
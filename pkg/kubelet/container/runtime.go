@@ -17,6 +17,7 @@ limitations under the License.
 package container
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
@@ -144,6 +145,100 @@ type IndirectStreamingRuntime interface {
 	GetPortForward(podName, podNamespace string, podUID types.UID) (*url.URL, error)
 }
 
+// ContainerNotifier is the interface implemented by runtimes that can deliver an out-of-band
+// notification message (e.g. a pre-eviction warning) to a running container.
+type ContainerNotifier interface {
+	// NotifyContainer delivers the named notification to the container by performing the
+	// given action and reports how the handler responded. A nil action lets the runtime
+	// fall back to its default delivery mechanism. A non-empty payload is passed to the
+	// handler: on stdin for exec actions and as the request body for HTTP actions.
+	NotifyContainer(id ContainerID, name string, action *NotificationAction, payload []byte) (*NotificationResult, error)
+}
+
+// ContainerNotificationStreamer is implemented by runtimes that can stream a
+// notification handler's output while it runs instead of buffering it into a
+// NotificationResult. Only exec handlers produce streaming output.
+type ContainerNotificationStreamer interface {
+	// NotifyContainerStream delivers the notification like NotifyContainer but
+	// writes the handler's output to out as it is produced. The handler's
+	// failure, if any, is reported through the returned error once the stream
+	// ends.
+	NotifyContainerStream(id ContainerID, name string, action *NotificationAction, payload []byte, out io.Writer) error
+}
+
+// NotificationAction describes how a notification is delivered to a running container.
+// At most one of its fields should be set.
+type NotificationAction struct {
+	// Signal is the name of a POSIX signal (e.g. "SIGUSR1") to send to the container's
+	// init process.
+	Signal string
+	// Exec is a command to run inside the container, with the notification name appended
+	// as its final argument.
+	Exec []string
+	// HTTPPort is a port declared by the container against which an HTTP GET is issued.
+	HTTPPort int
+	// HTTPPath is the path of the HTTP GET request, defaulting to "/".
+	HTTPPath string
+}
+
+// NotifySupportedAnnotation is the node annotation through which the kubelet
+// advertises that its container runtime can deliver notifications. Its value
+// lists the supported delivery modes, comma separated.
+const NotifySupportedAnnotation = "notify.alpha.kubernetes.io/supported-handlers"
+
+// NotifySupportedHandlers is the annotation value advertised for runtimes
+// implementing ContainerNotifier, which deliver all three handler modes.
+const NotifySupportedHandlers = "exec,http,signal"
+
+// MaxNotificationOutputSize bounds the handler output captured in a NotificationResult.
+const MaxNotificationOutputSize = 10 * 1024
+
+// ErrNotificationRateLimited is returned when a pod's notification rate limit or
+// queue depth has been exhausted. The kubelet surfaces it with 429 semantics.
+var ErrNotificationRateLimited = errors.New("notification rate limit for the pod exceeded")
+
+// ErrNotificationTimedOut is returned when a notification handler does not complete
+// before its deadline. The kubelet surfaces it with 504 semantics.
+var ErrNotificationTimedOut = errors.New("notification handler did not complete before the deadline")
+
+// NotificationResult reports how a container's notification handler responded.
+type NotificationResult struct {
+	// Code is the exit code of an exec handler or the status code of an HTTP handler.
+	// Signal deliveries always report zero.
+	Code int `json:"code"`
+	// Output is the handler's captured output (stdout and stderr for exec handlers,
+	// the response body for HTTP handlers), truncated to MaxNotificationOutputSize.
+	Output []byte `json:"output,omitempty"`
+}
+
+// ContainerNotificationResult is one entry of the response to a notify request
+// addressing several containers of a pod. Either the embedded result or Error
+// is populated, never both.
+type ContainerNotificationResult struct {
+	// Container is the name of the container the notification was delivered to.
+	Container string `json:"container"`
+	NotificationResult
+	// Error describes why delivery to this container failed, if it did.
+	Error string `json:"error,omitempty"`
+}
+
+// BroadcastNotificationResult is one entry of the response to a node-level
+// broadcast, reporting the delivery to one container of one pod.
+type BroadcastNotificationResult struct {
+	// Pod and Namespace identify the pod the container belongs to.
+	Pod       string `json:"pod"`
+	Namespace string `json:"namespace"`
+	ContainerNotificationResult
+}
+
+// LimitNotificationOutput truncates output to MaxNotificationOutputSize.
+func LimitNotificationOutput(output []byte) []byte {
+	if len(output) > MaxNotificationOutputSize {
+		return output[:MaxNotificationOutputSize]
+	}
+	return output
+}
+
 type ImageService interface {
 	// PullImage pulls an image from the network to local storage using the supplied
 	// secrets if necessary.
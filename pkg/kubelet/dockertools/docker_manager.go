@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path"
@@ -59,10 +60,12 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/types"
 	"k8s.io/kubernetes/pkg/kubelet/util/cache"
 	"k8s.io/kubernetes/pkg/kubelet/util/format"
+	"k8s.io/kubernetes/pkg/kubelet/util/ioutils"
 	kruntime "k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/security/apparmor"
 	"k8s.io/kubernetes/pkg/securitycontext"
 	kubetypes "k8s.io/kubernetes/pkg/types"
+	utilexec "k8s.io/kubernetes/pkg/util/exec"
 	"k8s.io/kubernetes/pkg/util/flowcontrol"
 	"k8s.io/kubernetes/pkg/util/oom"
 	"k8s.io/kubernetes/pkg/util/procfs"
@@ -105,12 +108,17 @@ const (
 
 	// The expiration time of version cache.
 	versionCacheTTL = 60 * time.Second
+
+	// Signal sent for notifications that do not configure a delivery action.
+	defaultNotificationSignal = "SIGUSR1"
 )
 
 var (
-	// DockerManager implements the Runtime and DirectStreamingRuntime interfaces.
+	// DockerManager implements the Runtime, DirectStreamingRuntime and
+	// ContainerNotifier interfaces.
 	_ kubecontainer.Runtime                = &DockerManager{}
 	_ kubecontainer.DirectStreamingRuntime = &DockerManager{}
+	_ kubecontainer.ContainerNotifier      = &DockerManager{}
 
 	// TODO: make this a TTL based pull (if image older than X policy, pull)
 	podInfraContainerImagePullPolicy = api.PullIfNotPresent
@@ -230,6 +238,7 @@ func NewDockerManager(
 	cpuCFSQuota bool,
 	imageBackOff *flowcontrol.Backoff,
 	serializeImagePulls bool,
+	maxParallelImagePulls int,
 	enableCustomMetrics bool,
 	hairpinMode bool,
 	seccompProfileRoot string,
@@ -285,7 +294,7 @@ func NewDockerManager(
 	}
 	cmdRunner := kubecontainer.DirectStreamingRunner(dm)
 	dm.runner = lifecycle.NewHandlerRunner(httpClient, cmdRunner, dm)
-	dm.imagePuller = images.NewImageManager(kubecontainer.FilterEventRecorder(recorder), dm, imageBackOff, serializeImagePulls, qps, burst)
+	dm.imagePuller = images.NewImageManager(kubecontainer.FilterEventRecorder(recorder), dm, imageBackOff, serializeImagePulls, maxParallelImagePulls, qps, burst)
 	dm.containerGC = NewContainerGC(client, podGetter, containerLogsDir)
 
 	dm.versionCache = cache.NewObjectCache(
@@ -1287,6 +1296,95 @@ func (dm *DockerManager) ExecInContainer(containerID kubecontainer.ContainerID,
 	return dm.execHandler.ExecInContainer(dm.client, container, cmd, stdin, stdout, stderr, tty, resize, timeout)
 }
 
+// NotifyContainer delivers the named notification to a running container by performing
+// the given action and reports the handler's exit code or HTTP status along with its
+// truncated output. A nil action falls back to sending defaultNotificationSignal to the
+// container's init process. A non-empty payload is written to the handler's stdin for
+// exec actions and sent as a POST body for HTTP actions; signal actions cannot carry one.
+func (dm *DockerManager) NotifyContainer(containerID kubecontainer.ContainerID, name string, action *kubecontainer.NotificationAction, payload []byte) (*kubecontainer.NotificationResult, error) {
+	container, err := dm.client.InspectContainer(containerID.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !container.State.Running {
+		return nil, fmt.Errorf("container not running (%s)", container.ID)
+	}
+	if action == nil {
+		action = &kubecontainer.NotificationAction{Signal: defaultNotificationSignal}
+	}
+	switch {
+	case action.Signal != "":
+		if len(payload) > 0 {
+			return nil, fmt.Errorf("notification %q is delivered as a signal and cannot carry a payload", name)
+		}
+		if err := dm.client.KillContainer(containerID.ID, action.Signal); err != nil {
+			return nil, err
+		}
+		return &kubecontainer.NotificationResult{}, nil
+	case len(action.Exec) > 0:
+		cmd := append(append([]string{}, action.Exec...), name)
+		var stdin io.Reader
+		if len(payload) > 0 {
+			stdin = bytes.NewReader(payload)
+		}
+		var buffer bytes.Buffer
+		output := ioutils.WriteCloserWrapper(&buffer)
+		err := dm.ExecInContainer(containerID, cmd, stdin, output, output, false, nil, 0)
+		result := &kubecontainer.NotificationResult{Output: kubecontainer.LimitNotificationOutput(buffer.Bytes())}
+		if exitErr, ok := err.(utilexec.ExitError); ok {
+			result.Code = exitErr.ExitStatus()
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case action.HTTPPort != 0:
+		ip := getContainerIP(container)
+		if ip == "" {
+			return nil, fmt.Errorf("container %s has no IP address", container.ID)
+		}
+		httpPath := action.HTTPPath
+		if httpPath == "" {
+			httpPath = "/"
+		}
+		url := fmt.Sprintf("http://%s:%d%s", ip, action.HTTPPort, httpPath)
+		var resp *http.Response
+		if len(payload) > 0 {
+			resp, err = http.Post(url, "application/octet-stream", bytes.NewReader(payload))
+		} else {
+			resp, err = http.Get(url)
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(io.LimitReader(resp.Body, kubecontainer.MaxNotificationOutputSize))
+		if err != nil {
+			return nil, err
+		}
+		return &kubecontainer.NotificationResult{Code: resp.StatusCode, Output: body}, nil
+	}
+	return nil, fmt.Errorf("no action configured for notification %q", name)
+}
+
+// NotifyContainerStream delivers the named notification like NotifyContainer but
+// streams the handler's output to out as it is produced instead of buffering it.
+// Only exec actions produce streaming output; a non-zero handler exit is reported
+// through the returned error once the stream ends.
+func (dm *DockerManager) NotifyContainerStream(containerID kubecontainer.ContainerID, name string, action *kubecontainer.NotificationAction, payload []byte, out io.Writer) error {
+	if action == nil || len(action.Exec) == 0 {
+		return fmt.Errorf("notification %q cannot stream: only exec handlers produce streaming output", name)
+	}
+	cmd := append(append([]string{}, action.Exec...), name)
+	var stdin io.Reader
+	if len(payload) > 0 {
+		stdin = bytes.NewReader(payload)
+	}
+	output := ioutils.WriteCloserWrapper(out)
+	return dm.ExecInContainer(containerID, cmd, stdin, output, output, false, nil, 0)
+}
+
 func (dm *DockerManager) AttachContainer(containerID kubecontainer.ContainerID, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool, resize <-chan term.Size) error {
 	return AttachContainer(dm.client, containerID.ID, stdin, stdout, stderr, tty, resize)
 }
@@ -2565,6 +2663,17 @@ func (dm *DockerManager) DeleteContainer(containerID kubecontainer.ContainerID)
 	return dm.containerGC.deleteContainer(containerID.ID)
 }
 
+// UpdateContainerCPUSet restricts a running container to the given cpuset, in
+// the Linux cpuset list format. It is used by the CPU manager to pin
+// containers to exclusive CPUs.
+func (dm *DockerManager) UpdateContainerCPUSet(containerID string, cpus string) error {
+	return dm.client.UpdateContainerResources(containerID, dockercontainer.UpdateConfig{
+		Resources: dockercontainer.Resources{
+			CpusetCpus: cpus,
+		},
+	})
+}
+
 // GetNetNS returns the network namespace path for the given container
 func (dm *DockerManager) GetNetNS(containerID kubecontainer.ContainerID) (string, error) {
 	inspectResult, err := dm.client.InspectContainer(containerID.ID)
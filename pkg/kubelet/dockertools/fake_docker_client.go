@@ -60,6 +60,8 @@ type FakeDockerClient struct {
 	Created         []string
 	Started         []string
 	Stopped         []string
+	Killed          []string
+	Updated         []string
 	Removed         []string
 	VersionInfo     dockertypes.Version
 	Information     dockertypes.Info
@@ -121,6 +123,8 @@ func (f *FakeDockerClient) ClearCalls() {
 	defer f.Unlock()
 	f.called = []calledDetail{}
 	f.Stopped = []string{}
+	f.Killed = []string{}
+	f.Updated = []string{}
 	f.pulled = []string{}
 	f.Created = []string{}
 	f.Removed = []string{}
@@ -439,6 +443,28 @@ func (f *FakeDockerClient) StopContainer(id string, timeout int) error {
 	return nil
 }
 
+func (f *FakeDockerClient) KillContainer(id string, signal string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.called = append(f.called, calledDetail{name: "kill"})
+	if err := f.popError("kill"); err != nil {
+		return err
+	}
+	f.Killed = append(f.Killed, id)
+	return nil
+}
+
+func (f *FakeDockerClient) UpdateContainerResources(id string, updateConfig dockercontainer.UpdateConfig) error {
+	f.Lock()
+	defer f.Unlock()
+	f.called = append(f.called, calledDetail{name: "update"})
+	if err := f.popError("update"); err != nil {
+		return err
+	}
+	f.Updated = append(f.Updated, id)
+	return nil
+}
+
 func (f *FakeDockerClient) RemoveContainer(id string, opts dockertypes.ContainerRemoveOptions) error {
 	f.Lock()
 	defer f.Unlock()
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	dockertypes "github.com/docker/engine-api/types"
+	dockercontainer "github.com/docker/engine-api/types/container"
 	"k8s.io/kubernetes/pkg/kubelet/metrics"
 )
 
@@ -98,6 +99,24 @@ func (in instrumentedDockerInterface) StopContainer(id string, timeout int) erro
 	return err
 }
 
+func (in instrumentedDockerInterface) KillContainer(id string, signal string) error {
+	const operation = "kill_container"
+	defer recordOperation(operation, time.Now())
+
+	err := in.client.KillContainer(id, signal)
+	recordError(operation, err)
+	return err
+}
+
+func (in instrumentedDockerInterface) UpdateContainerResources(id string, updateConfig dockercontainer.UpdateConfig) error {
+	const operation = "update_container"
+	defer recordOperation(operation, time.Now())
+
+	err := in.client.UpdateContainerResources(id, updateConfig)
+	recordError(operation, err)
+	return err
+}
+
 func (in instrumentedDockerInterface) RemoveContainer(id string, opts dockertypes.ContainerRemoveOptions) error {
 	const operation = "remove_container"
 	defer recordOperation(operation, time.Now())
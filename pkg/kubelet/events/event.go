@@ -75,4 +75,8 @@ const (
 	FailedPostStartHook   = "FailedPostStartHook"
 	FailedPreStopHook     = "FailedPreStopHook"
 	UnfinishedPreStopHook = "UnfinishedPreStopHook"
+
+	// Notification event reason list
+	NotificationDelivered = "NotificationDelivered"
+	FailedNotification    = "FailedNotification"
 )
@@ -0,0 +1,69 @@
+// +build linux
+
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eviction
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// cgroupMemoryRoot is where the memory cgroup hierarchy is mounted.
+const cgroupMemoryRoot = "/sys/fs/cgroup/memory"
+
+// cgroupUnlimitedMemory is the value the kernel reports for a memory cgroup with no limit set.
+// values at or above it indicate the cgroup limit is not meaningful for eviction decisions.
+const cgroupUnlimitedMemory = int64(1) << 62
+
+// cgroupMemoryInfo returns the available and capacity bytes for the memory cgroup at path,
+// where available is derived as limit - usage.
+func cgroupMemoryInfo(path string) (available int64, capacity int64, err error) {
+	dir := filepath.Join(cgroupMemoryRoot, path)
+	usage, err := readCgroupValue(filepath.Join(dir, "memory.usage_in_bytes"))
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err := readCgroupValue(filepath.Join(dir, "memory.limit_in_bytes"))
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit >= cgroupUnlimitedMemory {
+		return 0, 0, fmt.Errorf("cgroup %s has no memory limit set", path)
+	}
+	available = limit - usage
+	if available < 0 {
+		available = 0
+	}
+	return available, limit, nil
+}
+
+// readCgroupValue reads a single integer value from a cgroup control file.
+func readCgroupValue(path string) (int64, error) {
+	out, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse %s: %v", path, err)
+	}
+	return value, nil
+}
@@ -24,11 +24,17 @@ import (
 
 	"github.com/golang/glog"
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
+	statsapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	"k8s.io/kubernetes/pkg/kubelet/metrics"
 	"k8s.io/kubernetes/pkg/kubelet/qos"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
 	"k8s.io/kubernetes/pkg/kubelet/util/format"
+	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/clock"
 	"k8s.io/kubernetes/pkg/util/wait"
 )
@@ -39,10 +45,20 @@ type managerImpl struct {
 	clock clock.Clock
 	// config is how the manager is configured
 	config Config
-	// the function to invoke to kill a pod
-	killPodFunc KillPodFunc
+	// the interface to invoke to terminate a pod
+	podTerminator PodTerminator
+	// the function to invoke to mark the node unschedulable and back
+	cordonFunc CordonFunc
+	// the function to observe additional local filesystems configured for pressure signals
+	fsInfoFunc func(path string) (available int64, capacity int64, err error)
+	// the function to observe memory availability within top-level cgroups configured for pressure signals
+	cgroupInfoFunc func(path string) (available int64, capacity int64, err error)
 	// the interface that knows how to do image gc
 	imageGC ImageGC
+	// the interface that knows how to do container gc
+	containerGC ContainerGC
+	// the interface that knows how to reclaim container logs, may be nil
+	logsReclaimer LogsReclaimer
 	// protects access to internal state
 	sync.RWMutex
 	// node conditions are the set of conditions present
@@ -65,6 +81,28 @@ type managerImpl struct {
 	resourceToNodeReclaimFuncs map[api.ResourceName]nodeReclaimFuncs
 	// last observations from synchronize
 	lastObservations signalObservations
+	// records when a pod owned by a controller was last evicted, keyed by owner UID
+	evictedOwnersAt map[types.UID]time.Time
+	// the most recent eviction decisions, oldest first, capped at maxEvictionRecords
+	evictionRecords []EvictionRecord
+	// notifiersInitialized indicates if the threshold notifiers have been initialized (i.e. synchronize() has been called once)
+	notifiersInitialized bool
+	// records when hard thresholds were crossed, for deciding whether to cordon the node
+	hardThresholdCrossingsAt []time.Time
+	// nodeCordoned indicates if the manager has marked the node unschedulable
+	nodeCordoned bool
+	// records when pods were evicted, for enforcing the eviction cap
+	evictionsAt []time.Time
+	// records when a pod was last pre-notified of a pending soft eviction
+	podsNotifiedAt map[types.UID]time.Time
+	// explains each reported node condition in terms of the thresholds that triggered it
+	nodeConditionMessages map[api.NodeConditionType]string
+	// the number of consecutive summary provider failures
+	summaryFailures int
+	// the time before which the summary provider is not asked for stats again after a failure
+	summaryBackoffUntil time.Time
+	// statsStale indicates threshold evaluation is currently skipped because stats are stale
+	statsStale bool
 }
 
 // ensure it implements the required interface
@@ -74,21 +112,31 @@ var _ Manager = &managerImpl{}
 func NewManager(
 	summaryProvider stats.SummaryProvider,
 	config Config,
-	killPodFunc KillPodFunc,
+	podTerminator PodTerminator,
 	imageGC ImageGC,
+	containerGC ContainerGC,
+	logsReclaimer LogsReclaimer,
+	cordonFunc CordonFunc,
 	recorder record.EventRecorder,
 	nodeRef *api.ObjectReference,
 	clock clock.Clock) (Manager, lifecycle.PodAdmitHandler, error) {
 	manager := &managerImpl{
 		clock:           clock,
-		killPodFunc:     killPodFunc,
+		podTerminator:   podTerminator,
 		imageGC:         imageGC,
+		containerGC:     containerGC,
+		logsReclaimer:   logsReclaimer,
+		cordonFunc:      cordonFunc,
+		fsInfoFunc:      fsAvailableInfo,
+		cgroupInfoFunc:  cgroupMemoryInfo,
 		config:          config,
 		recorder:        recorder,
 		summaryProvider: summaryProvider,
 		nodeRef:         nodeRef,
 		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
 		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+		evictedOwnersAt:              map[types.UID]time.Time{},
+		podsNotifiedAt:               map[types.UID]time.Time{},
 	}
 	return manager, manager, nil
 }
@@ -101,15 +149,33 @@ func (m *managerImpl) Admit(attrs *lifecycle.PodAdmitAttributes) lifecycle.PodAd
 		return lifecycle.PodAdmitResult{Admit: true}
 	}
 
-	// the node has memory pressure, admit if not best-effort
+	// critical pods are always admitted, as rejecting them could leave the node without
+	// its system addons (e.g. DNS or networking).
+	if isCriticalPod(attrs.Pod) {
+		return lifecycle.PodAdmitResult{Admit: true}
+	}
+
+	// reject pods whose owner recently had a pod evicted from this node, to prevent a
+	// controller from recreating its pod into the same pressured node in a kill loop.
+	if ownerUID, found := podOwnerUID(attrs.Pod); found {
+		if evictedAt, found := m.evictedOwnersAt[ownerUID]; found && m.clock.Since(evictedAt) < podEvictionBackoffPeriod {
+			glog.Warningf("Failed to admit pod %v - owner had a pod evicted recently", format.Pod(attrs.Pod))
+			return lifecycle.PodAdmitResult{
+				Admit:   false,
+				Reason:  backoffReason,
+				Message: fmt.Sprintf(backoffMessage, podEvictionBackoffPeriod),
+			}
+		}
+	}
+
+	// the node has memory pressure, admit per the configured admission policy.
 	if hasNodeCondition(m.nodeConditions, api.NodeMemoryPressure) {
-		notBestEffort := qos.BestEffort != qos.GetPodQOS(attrs.Pod)
-		if notBestEffort {
+		if m.admitUnderMemoryPressure(attrs.Pod) {
 			return lifecycle.PodAdmitResult{Admit: true}
 		}
 	}
 
-	// reject pods when under memory pressure (if pod is best effort), or if under disk pressure.
+	// reject pods when under memory pressure (per the admission policy), or if under disk pressure.
 	glog.Warningf("Failed to admit pod %v - %s", format.Pod(attrs.Pod), "node has conditions: %v", m.nodeConditions)
 	return lifecycle.PodAdmitResult{
 		Admit:   false,
@@ -118,13 +184,134 @@ func (m *managerImpl) Admit(attrs *lifecycle.PodAdmitAttributes) lifecycle.PodAd
 	}
 }
 
+// admitUnderMemoryPressure returns true if the configured admission policy allows the pod
+// to be admitted while the node reports memory pressure.  callers must hold the lock.
+func (m *managerImpl) admitUnderMemoryPressure(pod *api.Pod) bool {
+	switch m.config.AdmissionPolicy {
+	case AdmissionPolicyAll:
+		return false
+	case AdmissionPolicyBurstable:
+		switch qos.GetPodQOS(pod) {
+		case qos.BestEffort:
+			return false
+		case qos.Burstable:
+			// reject a burstable pod if the node cannot currently satisfy its memory request.
+			if observed, found := m.lastObservations[SignalMemoryAvailable]; found && observed.available != nil {
+				request := podMemoryRequest(pod)
+				return request.Cmp(*observed.available) <= 0
+			}
+			return true
+		default:
+			return true
+		}
+	default:
+		return qos.BestEffort != qos.GetPodQOS(pod)
+	}
+}
+
+// startMemoryThresholdNotifier starts a memory threshold notifier which performs the specified action
+// when the specified threshold is crossed
+func startMemoryThresholdNotifier(thresholds []Threshold, observations signalObservations, hard bool, action func(string)) error {
+	for _, threshold := range thresholds {
+		if threshold.Signal != SignalMemoryAvailable || hard != isHardEvictionThreshold(threshold) {
+			continue
+		}
+		observed, found := observations[SignalMemoryAvailable]
+		if !found {
+			continue
+		}
+		cgroups, err := cm.GetCgroupSubsystems()
+		if err != nil {
+			return err
+		}
+		// TODO add support for eviction from --cgroup-root
+		cgpath, found := cgroups.MountPoints["memory"]
+		if !found || len(cgpath) == 0 {
+			return fmt.Errorf("memory cgroup mount point not found")
+		}
+		attribute := "memory.usage_in_bytes"
+		quantity := getThresholdQuantity(threshold.Value, observed.capacity)
+		usageThreshold := resource.NewQuantity(observed.capacity.Value(), resource.DecimalSI)
+		usageThreshold.Sub(*quantity)
+		description := fmt.Sprintf("<%s available", formatThresholdValue(threshold.Value))
+		memcgThresholdNotifier, err := NewMemCGThresholdNotifier(cgpath, attribute, usageThreshold.String(), description, action)
+		if err != nil {
+			return err
+		}
+		go memcgThresholdNotifier.Start(wait.NeverStop)
+		return nil
+	}
+	return nil
+}
+
+// pressureMonitoringPeriod is the interval used between monitoring passes while a
+// threshold is observed and adaptive monitoring is enabled.
+const pressureMonitoringPeriod = time.Second
+
+// podEvictionBackoffPeriod is the window during which pods from an owner that recently
+// had a pod evicted are not admitted back onto the node.
+const podEvictionBackoffPeriod = 5 * time.Minute
+
+// maxEvictionRecords is the number of recent eviction decisions retained for debugging.
+const maxEvictionRecords = 10
+
+// nodeCordonWindow is the window over which hard threshold crossings are counted when
+// deciding whether to cordon the node.
+const nodeCordonWindow = 10 * time.Minute
+
+// evictionRateLimitWindow is the window over which evictions are counted when enforcing
+// the configured eviction cap.
+const evictionRateLimitWindow = time.Hour
+
+// evictionRateLimitReason is the reason reported in events when the eviction cap prevents
+// further pod evictions.
+const evictionRateLimitReason = "EvictionRateLimited"
+
+const (
+	// the reason reported in events when the node is cordoned due to repeated threshold crossings.
+	nodeCordonReason = "EvictionCordon"
+	// the reason reported in events when the node is uncordoned after pressure clears.
+	nodeUncordonReason = "EvictionUncordon"
+)
+
+// statsStaleReason is the reason reported in events when the stats summary is older than the
+// configured staleness window and threshold evaluation is skipped.
+const statsStaleReason = "NodeStatsStale"
+
+// statsFailureBackoffBase is the initial period to wait before asking the summary provider
+// for stats again after a failure.  the period doubles with each consecutive failure.
+const statsFailureBackoffBase = 10 * time.Second
+
+// statsFailureBackoffMax caps the period to wait between summary provider attempts.
+const statsFailureBackoffMax = 5 * time.Minute
+
 // Start starts the control loop to observe and response to low compute resources.
 func (m *managerImpl) Start(diskInfoProvider DiskInfoProvider, podFunc ActivePodsFunc, monitoringInterval time.Duration) error {
 	// start the eviction manager monitoring
-	go wait.Until(func() { m.synchronize(diskInfoProvider, podFunc) }, monitoringInterval, wait.NeverStop)
+	if !m.config.AdaptiveMonitoring {
+		go wait.Until(func() { m.synchronize(diskInfoProvider, podFunc) }, monitoringInterval, wait.NeverStop)
+		return nil
+	}
+	go func() {
+		for {
+			m.synchronize(diskInfoProvider, podFunc)
+			time.Sleep(m.nextMonitoringInterval(monitoringInterval))
+		}
+	}()
 	return nil
 }
 
+// nextMonitoringInterval returns the duration to wait before the next monitoring pass.
+// while a threshold is observed, monitoring is tightened to reduce eviction reaction latency.
+func (m *managerImpl) nextMonitoringInterval(monitoringInterval time.Duration) time.Duration {
+	m.RLock()
+	defer m.RUnlock()
+	if len(m.thresholdsFirstObservedAt) > 0 || len(m.nodeConditions) > 0 {
+		return pressureMonitoringPeriod
+	}
+	return monitoringInterval
+}
+
 // IsUnderMemoryPressure returns true if the node is under memory pressure.
 func (m *managerImpl) IsUnderMemoryPressure() bool {
 	m.RLock()
@@ -139,14 +326,43 @@ func (m *managerImpl) IsUnderDiskPressure() bool {
 	return hasNodeCondition(m.nodeConditions, api.NodeDiskPressure)
 }
 
+// IsUnderPIDPressure returns true if the node is under PID pressure.
+func (m *managerImpl) IsUnderPIDPressure() bool {
+	m.RLock()
+	defer m.RUnlock()
+	return hasNodeCondition(m.nodeConditions, api.NodePIDPressure)
+}
+
+// NodeConditionMessage returns a human-readable explanation of why the specified node
+// condition is reported, or an empty string if no eviction threshold is driving it.
+func (m *managerImpl) NodeConditionMessage(condition api.NodeConditionType) string {
+	m.RLock()
+	defer m.RUnlock()
+	return m.nodeConditionMessages[condition]
+}
+
 // synchronize is the main control loop that enforces eviction thresholds.
 func (m *managerImpl) synchronize(diskInfoProvider DiskInfoProvider, podFunc ActivePodsFunc) {
 	// if we have nothing to do, just return
 	thresholds := m.config.Thresholds
-	if len(thresholds) == 0 {
+	if len(thresholds) == 0 && len(m.config.FilesystemThresholds) == 0 && len(m.config.CustomResourceThresholds) == 0 && len(m.config.CgroupThresholds) == 0 {
 		return
 	}
 
+	// fold per-filesystem, custom resource, and cgroup-scoped thresholds into the working set
+	if len(m.config.FilesystemThresholds) != 0 || len(m.config.CustomResourceThresholds) != 0 || len(m.config.CgroupThresholds) != 0 {
+		thresholds = append([]Threshold{}, thresholds...)
+		for _, fsThreshold := range m.config.FilesystemThresholds {
+			thresholds = append(thresholds, fsThreshold.Threshold)
+		}
+		for _, customThreshold := range m.config.CustomResourceThresholds {
+			thresholds = append(thresholds, customThreshold.Threshold)
+		}
+		for _, cgroupThreshold := range m.config.CgroupThresholds {
+			thresholds = append(thresholds, cgroupThreshold.Threshold)
+		}
+	}
+
 	// build the ranking functions (if not yet known)
 	// TODO: have a function in cadvisor that lets us know if global housekeeping has completed
 	if len(m.resourceToRankFunc) == 0 || len(m.resourceToNodeReclaimFuncs) == 0 {
@@ -156,13 +372,118 @@ func (m *managerImpl) synchronize(diskInfoProvider DiskInfoProvider, podFunc Act
 			return
 		}
 		m.resourceToRankFunc = buildResourceToRankFunc(hasDedicatedImageFs)
-		m.resourceToNodeReclaimFuncs = buildResourceToNodeReclaimFuncs(m.imageGC, hasDedicatedImageFs)
+		for _, customThreshold := range m.config.CustomResourceThresholds {
+			m.resourceToRankFunc[customThreshold.Resource] = rankCustomResourcePressure(customThreshold.Resource)
+		}
+		m.resourceToNodeReclaimFuncs = buildResourceToNodeReclaimFuncs(m.imageGC, m.containerGC, m.logsReclaimer, hasDedicatedImageFs)
+	}
+
+	// back off repeated summary provider failures rather than hammering a broken provider.
+	if !m.summaryBackoffUntil.IsZero() && m.clock.Now().Before(m.summaryBackoffUntil) {
+		glog.V(3).Infof("eviction manager: backing off summary provider until %v", m.summaryBackoffUntil)
+		return
 	}
 
 	// make observations and get a function to derive pod usage stats relative to those observations.
-	observations, statsFunc, err := makeSignalObservations(m.summaryProvider)
+	observations, statsFunc, err := makeSignalObservations(m.summaryProvider, m.config.InactiveFileReclaimablePercent)
 	if err != nil {
-		glog.Errorf("eviction manager: unexpected err: %v", err)
+		m.summaryFailures++
+		backoff := statsFailureBackoffBase
+		for i := 1; i < m.summaryFailures && backoff < statsFailureBackoffMax; i++ {
+			backoff *= 2
+		}
+		if backoff > statsFailureBackoffMax {
+			backoff = statsFailureBackoffMax
+		}
+		m.summaryBackoffUntil = m.clock.Now().Add(backoff)
+		glog.Errorf("eviction manager: unexpected err: %v (backing off for %v)", err, backoff)
+		return
+	}
+	m.summaryFailures = 0
+	m.summaryBackoffUntil = time.Time{}
+
+	// skip threshold evaluation when the summary is older than the configured staleness
+	// window, so pods are not evicted based on numbers that no longer reflect the node.
+	if staleness := m.config.StatsStalenessPeriod; staleness > 0 {
+		if age := observationsAge(observations, m.clock.Now()); age > staleness {
+			if !m.statsStale {
+				m.statsStale = true
+				m.recorder.Eventf(m.nodeRef, api.EventTypeWarning, statsStaleReason, "Node stats are %v old, skipping eviction threshold evaluation", age)
+			}
+			glog.Warningf("eviction manager: node stats are %v old, skipping threshold evaluation", age)
+			return
+		}
+		m.statsStale = false
+	}
+
+	// observe any additional local filesystems configured for per-device pressure signals
+	for _, fsThreshold := range m.config.FilesystemThresholds {
+		available, capacity, err := m.fsInfoFunc(fsThreshold.Path)
+		if err != nil {
+			glog.Errorf("eviction manager: unable to observe filesystem %s: %v", fsThreshold.Path, err)
+			continue
+		}
+		observations[fsThreshold.Threshold.Signal] = signalObservation{
+			available: resource.NewQuantity(available, resource.BinarySI),
+			capacity:  resource.NewQuantity(capacity, resource.BinarySI),
+			time:      unversioned.NewTime(m.clock.Now()),
+		}
+	}
+
+	// observe memory availability within any top-level cgroups configured for pressure signals
+	for _, cgroupThreshold := range m.config.CgroupThresholds {
+		available, capacity, err := m.cgroupInfoFunc(cgroupThreshold.Path)
+		if err != nil {
+			glog.Errorf("eviction manager: unable to observe cgroup %s: %v", cgroupThreshold.Path, err)
+			continue
+		}
+		observations[cgroupThreshold.Threshold.Signal] = signalObservation{
+			available: resource.NewQuantity(available, resource.BinarySI),
+			capacity:  resource.NewQuantity(capacity, resource.BinarySI),
+			time:      unversioned.NewTime(m.clock.Now()),
+		}
+	}
+
+	// observe custom resources configured with a pluggable stats source
+	for _, customThreshold := range m.config.CustomResourceThresholds {
+		available, capacity, err := customThreshold.StatsFunc()
+		if err != nil {
+			glog.Errorf("eviction manager: unable to observe custom resource %s: %v", customThreshold.Resource, err)
+			continue
+		}
+		observations[customThreshold.Threshold.Signal] = signalObservation{
+			available: resource.NewQuantity(available, resource.DecimalSI),
+			capacity:  resource.NewQuantity(capacity, resource.DecimalSI),
+			time:      unversioned.NewTime(m.clock.Now()),
+		}
+	}
+
+	// attempt to create a threshold notifier to improve eviction response time
+	if m.config.KernelMemcgNotification && !m.notifiersInitialized {
+		glog.Infof("eviction manager attempting to integrate with kernel memcg notification api")
+		m.notifiersInitialized = true
+		// start soft memory notification
+		err = startMemoryThresholdNotifier(m.config.Thresholds, observations, false, func(desc string) {
+			glog.Infof("soft memory eviction threshold crossed at %s", desc)
+			// TODO wait grace period for soft memory limit
+			m.synchronize(diskInfoProvider, podFunc)
+		})
+		if err != nil {
+			glog.Warningf("eviction manager: failed to create soft memory threshold notifier: %v", err)
+		}
+		// start hard memory notification
+		err = startMemoryThresholdNotifier(m.config.Thresholds, observations, true, func(desc string) {
+			glog.Infof("hard memory eviction threshold crossed at %s", desc)
+			m.synchronize(diskInfoProvider, podFunc)
+		})
+		if err != nil {
+			glog.Warningf("eviction manager: failed to create hard memory threshold notifier: %v", err)
+		}
+	}
+
+	// evict pods that exceed their declared local storage limits independent of node-level pressure.
+	if evictedPods := m.localStorageEviction(podFunc(), statsFunc); len(evictedPods) > 0 {
+		glog.Infof("eviction manager: pods %s evicted for exceeding local storage limits", format.Pods(evictedPods))
 		return
 	}
 
@@ -184,27 +505,62 @@ func (m *managerImpl) synchronize(diskInfoProvider DiskInfoProvider, podFunc Act
 	// track when a threshold was first observed
 	thresholdsFirstObservedAt := thresholdsFirstObservedAt(thresholds, m.thresholdsFirstObservedAt, now)
 
+	// count each threshold that is newly crossed this interval
+	for _, threshold := range thresholds {
+		if _, found := m.thresholdsFirstObservedAt[threshold]; !found {
+			metrics.EvictionThresholdCrossings.WithLabelValues(string(threshold.Signal)).Inc()
+			if threshold.GracePeriod == time.Duration(0) {
+				m.hardThresholdCrossingsAt = append(m.hardThresholdCrossingsAt, now)
+			}
+		}
+	}
+
 	// the set of node conditions that are triggered by currently observed thresholds
 	nodeConditions := nodeConditions(thresholds)
 
+	// build an explanation for each triggered condition from the met thresholds and observed values
+	nodeConditionMessages := nodeConditionMessages(thresholds, observations)
+
 	// track when a node condition was last observed
 	nodeConditionsLastObservedAt := nodeConditionsLastObservedAt(nodeConditions, m.nodeConditionsLastObservedAt, now)
 
 	// node conditions report true if it has been observed within the transition period window
 	nodeConditions = nodeConditionsObservedSince(nodeConditionsLastObservedAt, m.config.PressureTransitionPeriod, now)
 
+	// pre-notify the likely victim of any soft threshold whose notify period has been reached
+	m.notifyPendingEvictions(thresholdsFirstObservedAt, now, podFunc, statsFunc)
+
 	// determine the set of thresholds we need to drive eviction behavior (i.e. all grace periods are met)
 	thresholds = thresholdsMetGracePeriod(thresholdsFirstObservedAt, now)
 
 	// update internal state
 	m.Lock()
 	m.nodeConditions = nodeConditions
+	// retain the previous explanation for conditions still reported within the transition period
+	for _, nodeCondition := range nodeConditions {
+		if _, found := nodeConditionMessages[nodeCondition]; !found {
+			if previous, found := m.nodeConditionMessages[nodeCondition]; found {
+				nodeConditionMessages[nodeCondition] = previous
+			}
+		}
+	}
+	m.nodeConditionMessages = nodeConditionMessages
 	m.thresholdsFirstObservedAt = thresholdsFirstObservedAt
 	m.nodeConditionsLastObservedAt = nodeConditionsLastObservedAt
 	m.thresholdsMet = thresholds
 	m.lastObservations = observations
+	// expire eviction backoff entries that have aged out of the backoff window
+	for ownerUID, evictedAt := range m.evictedOwnersAt {
+		if now.Sub(evictedAt) >= podEvictionBackoffPeriod {
+			delete(m.evictedOwnersAt, ownerUID)
+		}
+	}
 	m.Unlock()
 
+	// cordon the node if hard thresholds are being crossed repeatedly, and uncordon it
+	// once pressure has cleared past the transition period.
+	m.manageNodeCordon(nodeConditions, now)
+
 	// determine the set of resources under starvation
 	starvedResources := getStarvedResources(thresholds)
 	if len(starvedResources) == 0 {
@@ -229,6 +585,14 @@ func (m *managerImpl) synchronize(diskInfoProvider DiskInfoProvider, podFunc Act
 		return
 	}
 
+	// enforce the eviction cap so a misconfigured threshold cannot wipe out every pod on
+	// the node.  pressure conditions remain reported while the cap is in effect.
+	if m.evictionRateLimited(now) {
+		m.recorder.Eventf(m.nodeRef, api.EventTypeWarning, evictionRateLimitReason, "Eviction cap of %d per hour reached, not evicting pods to reclaim %s", m.config.MaxEvictionsPerHour, resourceToReclaim)
+		glog.Warningf("eviction manager: eviction cap of %d per hour reached, not evicting pods", m.config.MaxEvictionsPerHour)
+		return
+	}
+
 	glog.Infof("eviction manager: must evict pod(s) to reclaim %v", resourceToReclaim)
 
 	// rank the pods for eviction
@@ -240,6 +604,31 @@ func (m *managerImpl) synchronize(diskInfoProvider DiskInfoProvider, podFunc Act
 
 	// the only candidates viable for eviction are those pods that had anything running.
 	activePods := podFunc()
+
+	// pods that are already terminating will release their usage on their own, so evicting
+	// them wastes an eviction cycle.  instead, credit their expected reclaim against the
+	// observations when deciding whether further kills are needed.
+	activePods, terminatingPods := filterTerminatingPods(activePods)
+
+	// static pods are restarted by the kubelet as soon as they are evicted, so evicting them
+	// is useless and noisy; optionally exclude them from ranking.
+	if m.config.SkipStaticPods {
+		activePods = filterOutStaticPods(activePods)
+	}
+	for _, pod := range terminatingPods {
+		if podStats, found := statsFunc(pod); found {
+			if estimate := podReclaimEstimate(pod, podStats, resourceToReclaim); estimate != nil {
+				if value, found := observations[resourceSignal(resourceToReclaim)]; found {
+					value.available.Add(*estimate)
+				}
+			}
+		}
+	}
+	if len(terminatingPods) != 0 && len(thresholdsMet(thresholds, observations, true)) == 0 {
+		glog.Infof("eviction manager: pods already terminating are expected to relieve %v pressure; not evicting", resourceToReclaim)
+		return
+	}
+
 	if len(activePods) == 0 {
 		glog.Errorf("eviction manager: eviction thresholds have been met, but no pods are active to evict")
 		return
@@ -248,33 +637,408 @@ func (m *managerImpl) synchronize(diskInfoProvider DiskInfoProvider, podFunc Act
 	// rank the running pods for eviction for the specified resource
 	rank(activePods, statsFunc)
 
+	// honor pod opt-out annotations by ranking protected pods last, unless availability
+	// has dropped below the emergency floor.
+	if len(thresholdsMet(m.config.EmergencyThresholds, observations, false)) == 0 {
+		activePods = demoteProtectedPods(activePods)
+	}
+
 	glog.Infof("eviction manager: pods ranked for eviction: %s", format.Pods(activePods))
 
-	// we kill at most a single pod during each eviction interval
+	// we kill at most a single pod during each eviction interval, unless the manager is
+	// configured to keep evicting under a hard threshold until the estimated reclaim
+	// would relieve pressure.
+	evicted := false
 	for i := range activePods {
 		pod := activePods[i]
-		status := api.PodStatus{
-			Phase:   api.PodFailed,
-			Message: fmt.Sprintf(message, resourceToReclaim),
-			Reason:  reason,
-		}
-		// record that we are evicting the pod
-		m.recorder.Eventf(pod, api.EventTypeWarning, reason, fmt.Sprintf(message, resourceToReclaim))
 		gracePeriodOverride := int64(0)
 		if softEviction {
-			gracePeriodOverride = m.config.MaxPodGracePeriodSeconds
+			gracePeriodOverride = softEvictionGracePeriod(pod, m.config.MaxPodGracePeriodSeconds, m.config.HonorPodGracePeriod)
 		}
-		// this is a blocking call and should only return when the pod and its containers are killed.
-		err := m.killPodFunc(pod, status, &gracePeriodOverride)
-		if err != nil {
-			glog.Infof("eviction manager: pod %s failed to evict %v", format.Pod(pod), err)
+		evictionStartTime := m.clock.Now()
+		if m.evictPod(pod, gracePeriodOverride, evictionMessage(resourceToReclaim, pod, statsFunc, thresholds, observations)) {
+			evicted = true
+			signal := string(resourceSignal(resourceToReclaim))
+			metrics.Evictions.WithLabelValues(signal).Inc()
+			metrics.EvictionLatency.WithLabelValues(signal).Observe(float64(m.clock.Since(evictionStartTime).Nanoseconds() / time.Microsecond.Nanoseconds()))
+			if softEviction || !m.config.EvictMultiplePods {
+				// success, so we return until the next housekeeping interval
+				return
+			}
+			// credit the pod's estimated usage back to the observation, and stop evicting
+			// once the thresholds (including min-reclaim) would no longer be met.
+			if podStats, found := statsFunc(pod); found {
+				if estimate := podReclaimEstimate(pod, podStats, resourceToReclaim); estimate != nil {
+					if value, found := observations[resourceSignal(resourceToReclaim)]; found {
+						value.available.Add(*estimate)
+					}
+				}
+			}
+			if len(thresholdsMet(thresholds, observations, true)) == 0 {
+				return
+			}
+		}
+	}
+	if !evicted {
+		glog.Infof("eviction manager: unable to evict any pods from the node")
+	}
+}
+
+// notifyPendingEvictions delivers a pre-eviction warning to the likely victim of any soft
+// threshold whose notify period has been reached but whose grace period has not yet expired,
+// giving the application a chance to shed load or checkpoint before it is killed.
+func (m *managerImpl) notifyPendingEvictions(thresholdsFirstObservedAt thresholdsObservedAt, now time.Time, podFunc ActivePodsFunc, statsFunc statsFunc) {
+	if m.config.PodNotifier == nil {
+		return
+	}
+
+	// collect soft thresholds inside their notify window
+	pending := []Threshold{}
+	for threshold, observedAt := range thresholdsFirstObservedAt {
+		if threshold.NotifyPeriod == time.Duration(0) || threshold.GracePeriod == time.Duration(0) {
 			continue
 		}
-		// success, so we return until the next housekeeping interval
-		glog.Infof("eviction manager: pod %s evicted successfully", format.Pod(pod))
+		remaining := threshold.GracePeriod - now.Sub(observedAt)
+		if remaining <= 0 || remaining > threshold.NotifyPeriod {
+			continue
+		}
+		pending = append(pending, threshold)
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	// notify for the resource the manager would attempt to reclaim first
+	starvedResources := getStarvedResources(pending)
+	if len(starvedResources) == 0 {
+		return
+	}
+	sort.Sort(byEvictionPriority(starvedResources))
+	resourceToReclaim := starvedResources[0]
+	crossedAt := now
+	remaining := time.Duration(0)
+	for _, threshold := range pending {
+		if reclaimResource, _ := signalResource(threshold.Signal); reclaimResource != resourceToReclaim {
+			continue
+		}
+		observedAt := thresholdsFirstObservedAt[threshold]
+		if observedAt.Before(crossedAt) {
+			crossedAt = observedAt
+		}
+		if thresholdRemaining := threshold.GracePeriod - now.Sub(observedAt); remaining == 0 || thresholdRemaining < remaining {
+			remaining = thresholdRemaining
+		}
+	}
+
+	// rank the candidates the same way eviction would
+	rank, ok := m.resourceToRankFunc[resourceToReclaim]
+	if !ok {
+		return
+	}
+	activePods, _ := filterTerminatingPods(podFunc())
+	if m.config.SkipStaticPods {
+		activePods = filterOutStaticPods(activePods)
+	}
+
+	// forget notifications for pods that are no longer active
+	active := map[types.UID]bool{}
+	for _, pod := range activePods {
+		active[pod.UID] = true
+	}
+	for uid := range m.podsNotifiedAt {
+		if !active[uid] {
+			delete(m.podsNotifiedAt, uid)
+		}
+	}
+
+	if len(activePods) == 0 {
+		return
+	}
+	rank(activePods, statsFunc)
+	pod := activePods[0]
+
+	// notify each pod at most once per threshold crossing
+	if notifiedAt, found := m.podsNotifiedAt[pod.UID]; found && !notifiedAt.Before(crossedAt) {
+		return
+	}
+	message := fmt.Sprintf("The node is low on resource: %v. Pod may be evicted in %v if pressure persists.", resourceToReclaim, remaining)
+	if err := m.config.PodNotifier(pod, message); err != nil {
+		glog.Warningf("eviction manager: failed to notify pod %s of pending eviction: %v", format.Pod(pod), err)
+		return
+	}
+	glog.Infof("eviction manager: notified pod %s of pending eviction for %v", format.Pod(pod), resourceToReclaim)
+	m.podsNotifiedAt[pod.UID] = now
+}
+
+// Simulate evaluates what the manager would evict for the provided pods if the current
+// observations were replaced by the provided overrides.  it is served by the kubelet debug
+// endpoint for capacity planning and never kills anything.
+func (m *managerImpl) Simulate(activePods []*api.Pod, overrides map[Signal]resource.Quantity) SimulationResult {
+	result := SimulationResult{Victims: []SimulatedVictim{}}
+	observations, statsFunc, err := makeSignalObservations(m.summaryProvider, m.config.InactiveFileReclaimablePercent)
+	if err != nil {
+		result.Error = fmt.Sprintf("unable to make signal observations: %v", err)
+		return result
+	}
+	// apply the hypothetical availability for each overridden signal
+	for signal, available := range overrides {
+		observed, found := observations[signal]
+		if !found {
+			result.Error = fmt.Sprintf("no observation found for eviction signal %v", signal)
+			return result
+		}
+		quantity := available
+		observed.available = &quantity
+		observations[signal] = observed
+	}
+	// grace periods are ignored: the simulation reports what would happen if the
+	// hypothetical observations persisted long enough to trigger eviction.
+	thresholds := thresholdsMet(m.config.Thresholds, observations, false)
+	starvedResources := getStarvedResources(thresholds)
+	if len(starvedResources) == 0 {
+		return result
+	}
+	sort.Sort(byEvictionPriority(starvedResources))
+	resourceToReclaim := starvedResources[0]
+	result.ResourceToReclaim = resourceToReclaim
+
+	m.RLock()
+	rank, ok := m.resourceToRankFunc[resourceToReclaim]
+	m.RUnlock()
+	if !ok {
+		result.Error = fmt.Sprintf("no ranking function for resource %s", resourceToReclaim)
+		return result
+	}
+
+	// rank a copy of the input so the caller's slice is left untouched
+	pods := append([]*api.Pod{}, activePods...)
+	pods, _ = filterTerminatingPods(pods)
+	rank(pods, statsFunc)
+	if len(thresholdsMet(m.config.EmergencyThresholds, observations, false)) == 0 {
+		pods = demoteProtectedPods(pods)
+	}
+	for _, pod := range pods {
+		victim := SimulatedVictim{Pod: format.Pod(pod)}
+		if podStats, found := statsFunc(pod); found {
+			if estimate := podReclaimEstimate(pod, podStats, resourceToReclaim); estimate != nil {
+				victim.Reclaim = estimate.String()
+				if value, found := observations[resourceSignal(resourceToReclaim)]; found {
+					value.available.Add(*estimate)
+				}
+			}
+		}
+		result.Victims = append(result.Victims, victim)
+		if len(thresholdsMet(thresholds, observations, true)) == 0 {
+			break
+		}
+	}
+	return result
+}
+
+// manageNodeCordon marks the node unschedulable when hard thresholds have been crossed
+// repeatedly within the cordon window, and schedulable again once all pressure conditions
+// have cleared.
+func (m *managerImpl) manageNodeCordon(nodeConditions []api.NodeConditionType, now time.Time) {
+	if m.config.CordonThresholdCrossings <= 0 || m.cordonFunc == nil {
+		return
+	}
+	// drop crossings that have aged out of the window
+	recentCrossings := m.hardThresholdCrossingsAt[:0]
+	for _, crossedAt := range m.hardThresholdCrossingsAt {
+		if now.Sub(crossedAt) < nodeCordonWindow {
+			recentCrossings = append(recentCrossings, crossedAt)
+		}
+	}
+	m.hardThresholdCrossingsAt = recentCrossings
+	if !m.nodeCordoned && len(recentCrossings) >= m.config.CordonThresholdCrossings {
+		if err := m.cordonFunc(true); err != nil {
+			glog.Errorf("eviction manager: unable to cordon node: %v", err)
+			return
+		}
+		m.recorder.Eventf(m.nodeRef, api.EventTypeWarning, nodeCordonReason, "Node cordoned: hard eviction thresholds crossed %d times within %v", len(recentCrossings), nodeCordonWindow)
+		m.nodeCordoned = true
 		return
 	}
-	glog.Infof("eviction manager: unable to evict any pods from the node")
+	if m.nodeCordoned && len(nodeConditions) == 0 {
+		if err := m.cordonFunc(false); err != nil {
+			glog.Errorf("eviction manager: unable to uncordon node: %v", err)
+			return
+		}
+		m.recorder.Eventf(m.nodeRef, api.EventTypeNormal, nodeUncordonReason, "Node uncordoned: eviction pressure has cleared")
+		m.nodeCordoned = false
+	}
+}
+
+// evictPod evicts the provided pod for the provided message, and returns true if the eviction succeeded.
+func (m *managerImpl) evictPod(pod *api.Pod, gracePeriodOverride int64, evictMsg string) bool {
+	evictMsg = fmt.Sprintf("%s Grace period applied: %ds.", evictMsg, gracePeriodOverride)
+	if m.config.DryRun {
+		// in dry-run mode, only record what would have happened.
+		m.recorder.Eventf(pod, api.EventTypeWarning, wouldEvictReason, evictMsg)
+		m.Lock()
+		m.recordEviction(pod, evictMsg)
+		m.Unlock()
+		glog.Infof("eviction manager: pod %s would have been evicted (dry-run)", format.Pod(pod))
+		return true
+	}
+	// record that we are evicting the pod
+	m.recorder.Eventf(pod, api.EventTypeWarning, reason, evictMsg)
+	// this is a blocking call and should only return when the pod and its containers are killed.
+	err := m.podTerminator.TerminatePod(pod, PodTerminationOptions{
+		GracePeriodOverride: &gracePeriodOverride,
+		Reason:              reason,
+		Message:             evictMsg,
+	})
+	if err != nil {
+		glog.Infof("eviction manager: pod %s failed to evict %v", format.Pod(pod), err)
+		return false
+	}
+	m.Lock()
+	// track the owner of the evicted pod so its replacements are not admitted right back
+	if ownerUID, found := podOwnerUID(pod); found {
+		m.evictedOwnersAt[ownerUID] = m.clock.Now()
+	}
+	m.recordEviction(pod, evictMsg)
+	m.evictionsAt = append(m.evictionsAt, m.clock.Now())
+	m.Unlock()
+	glog.Infof("eviction manager: pod %s evicted successfully", format.Pod(pod))
+	return true
+}
+
+// evictionRateLimited returns true if the configured eviction cap has been reached within
+// the rate limit window, pruning eviction records that have aged out of the window.
+func (m *managerImpl) evictionRateLimited(now time.Time) bool {
+	if m.config.MaxEvictionsPerHour <= 0 {
+		return false
+	}
+	m.Lock()
+	defer m.Unlock()
+	recentEvictions := m.evictionsAt[:0]
+	for _, evictedAt := range m.evictionsAt {
+		if now.Sub(evictedAt) < evictionRateLimitWindow {
+			recentEvictions = append(recentEvictions, evictedAt)
+		}
+	}
+	m.evictionsAt = recentEvictions
+	return len(m.evictionsAt) >= m.config.MaxEvictionsPerHour
+}
+
+// recordEviction retains the eviction decision for the debug endpoint.  callers must hold the lock.
+func (m *managerImpl) recordEviction(pod *api.Pod, evictMsg string) {
+	m.evictionRecords = append(m.evictionRecords, EvictionRecord{
+		Pod:     format.Pod(pod),
+		Message: evictMsg,
+		Time:    unversioned.NewTime(m.clock.Now()),
+	})
+	if len(m.evictionRecords) > maxEvictionRecords {
+		m.evictionRecords = m.evictionRecords[len(m.evictionRecords)-maxEvictionRecords:]
+	}
+}
+
+// DebugInfo returns a snapshot of the manager's internal state for debug endpoints.
+func (m *managerImpl) DebugInfo() DebugInfo {
+	m.RLock()
+	defer m.RUnlock()
+	result := DebugInfo{
+		Observations:              map[Signal]string{},
+		ThresholdsMet:             []string{},
+		ThresholdsFirstObservedAt: map[string]unversioned.Time{},
+		NodeConditions:            m.nodeConditions,
+		Evictions:                 m.evictionRecords,
+	}
+	for signal, observed := range m.lastObservations {
+		available := "unknown"
+		if observed.available != nil {
+			available = observed.available.String()
+		}
+		capacity := "unknown"
+		if observed.capacity != nil {
+			capacity = observed.capacity.String()
+		}
+		result.Observations[signal] = fmt.Sprintf("available: %s, capacity: %s", available, capacity)
+	}
+	for _, threshold := range m.thresholdsMet {
+		result.ThresholdsMet = append(result.ThresholdsMet, formatThreshold(threshold))
+	}
+	for threshold, at := range m.thresholdsFirstObservedAt {
+		result.ThresholdsFirstObservedAt[formatThreshold(threshold)] = unversioned.NewTime(at)
+	}
+	return result
+}
+
+// localStorageEviction checks the local storage usage of pods against any limit declared
+// on the pod, and evicts pods that exceed their limit.  it returns the pods that were evicted.
+func (m *managerImpl) localStorageEviction(pods []*api.Pod, statsFunc statsFunc) []*api.Pod {
+	evicted := []*api.Pod{}
+	for _, pod := range pods {
+		podStats, found := statsFunc(pod)
+		if !found {
+			continue
+		}
+		if m.emptyDirLimitEviction(podStats, pod) {
+			evicted = append(evicted, pod)
+			continue
+		}
+		if m.podLocalStorageLimitEviction(podStats, pod) {
+			evicted = append(evicted, pod)
+		}
+	}
+	return evicted
+}
+
+// emptyDirLimitEviction returns true if the pod was evicted because an emptyDir volume exceeded its declared size limit.
+func (m *managerImpl) emptyDirLimitEviction(podStats statsapi.PodStats, pod *api.Pod) bool {
+	limits, err := podEmptyDirSizeLimits(pod)
+	if err != nil {
+		glog.Errorf("eviction manager: error parsing emptyDir size limits for pod %s: %v", format.Pod(pod), err)
+		return false
+	}
+	if len(limits) == 0 {
+		return false
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir == nil {
+			continue
+		}
+		limit, found := limits[volume.Name]
+		if !found {
+			continue
+		}
+		for _, volumeStats := range podStats.VolumeStats {
+			if volumeStats.Name != volume.Name {
+				continue
+			}
+			used := diskUsage(&volumeStats.FsStats)
+			if used.Cmp(limit) > 0 {
+				return m.evictPod(pod, int64(0), fmt.Sprintf(emptyDirMessage, volume.Name, used.String(), limit.String()))
+			}
+			break
+		}
+	}
+	return false
+}
+
+// podLocalStorageLimitEviction returns true if the pod was evicted because its total local storage usage
+// exceeded the limit declared on the pod.
+func (m *managerImpl) podLocalStorageLimitEviction(podStats statsapi.PodStats, pod *api.Pod) bool {
+	limit, err := podLocalStorageLimit(pod)
+	if err != nil {
+		glog.Errorf("eviction manager: error parsing local storage limit for pod %s: %v", format.Pod(pod), err)
+		return false
+	}
+	if limit == nil {
+		return false
+	}
+	usage, err := podDiskUsage(podStats, pod, []fsStatsType{fsStatsRoot, fsStatsLogs, fsStatsLocalVolumeSource})
+	if err != nil {
+		glog.Errorf("eviction manager: error measuring local storage usage for pod %s: %v", format.Pod(pod), err)
+		return false
+	}
+	used := usage[resourceDisk]
+	if used.Cmp(*limit) > 0 {
+		return m.evictPod(pod, int64(0), fmt.Sprintf(localStorageMessage, limit.String()))
+	}
+	return false
 }
 
 // reclaimNodeLevelResources attempts to reclaim node level resources.  returns true if thresholds were satisfied and no pod eviction is required.
@@ -286,13 +1050,14 @@ func (m *managerImpl) reclaimNodeLevelResources(resourceToReclaim api.ResourceNa
 		if err == nil {
 			// update our local observations based on the amount reported to have been reclaimed.
 			// note: this is optimistic, other things could have been still consuming the pressured resource in the interim.
-			signal := resourceToSignal[resourceToReclaim]
+			signal := resourceSignal(resourceToReclaim)
 			value, ok := observations[signal]
 			if !ok {
 				glog.Errorf("eviction manager: unable to find value associated with signal %v", signal)
 				continue
 			}
 			value.available.Add(*reclaimed)
+			metrics.EvictionNodeReclaimBytes.WithLabelValues(string(resourceToReclaim)).Add(float64(reclaimed.Value()))
 
 			// evaluate all current thresholds to see if with adjusted observations, we think we have met min reclaim goals
 			if len(thresholdsMet(m.thresholdsMet, observations, true)) == 0 {
@@ -17,14 +17,20 @@ limitations under the License.
 package eviction
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 	statsapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/kubelet/util/format"
 	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/clock"
 )
@@ -32,6 +38,7 @@ import (
 // mockPodKiller is used to testing which pod is killed
 type mockPodKiller struct {
 	pod                 *api.Pod
+	pods                []*api.Pod
 	status              api.PodStatus
 	gracePeriodOverride *int64
 }
@@ -39,6 +46,7 @@ type mockPodKiller struct {
 // killPodNow records the pod that was killed
 func (m *mockPodKiller) killPodNow(pod *api.Pod, status api.PodStatus, gracePeriodOverride *int64) error {
 	m.pod = pod
+	m.pods = append(m.pods, pod)
 	m.status = status
 	m.gracePeriodOverride = gracePeriodOverride
 	return nil
@@ -67,6 +75,37 @@ func (m *mockImageGC) DeleteUnusedImages() (int64, error) {
 	return m.freed, m.err
 }
 
+// DeleteImagesByCostBenefit returns the mocked values.
+func (m *mockImageGC) DeleteImagesByCostBenefit() (int64, error) {
+	m.invoked = true
+	return m.freed, m.err
+}
+
+// mockContainerGC is used to simulate invoking container garbage collection.
+type mockContainerGC struct {
+	err     error
+	invoked bool
+}
+
+// DeleteAllUnusedContainers returns the mocked value.
+func (m *mockContainerGC) DeleteAllUnusedContainers() error {
+	m.invoked = true
+	return m.err
+}
+
+// mockLogsReclaimer is used to simulate reclaiming container logs.
+type mockLogsReclaimer struct {
+	err     error
+	freed   int64
+	invoked bool
+}
+
+// ReclaimLogs returns the mocked values.
+func (m *mockLogsReclaimer) ReclaimLogs() (int64, error) {
+	m.invoked = true
+	return m.freed, m.err
+}
+
 func makePodWithMemoryStats(name string, requests api.ResourceList, limits api.ResourceList, memoryWorkingSet string) (*api.Pod, statsapi.PodStats) {
 	pod := newPod(name, []api.Container{
 		newContainer(name, requests, limits),
@@ -171,6 +210,7 @@ func TestMemoryPressure(t *testing.T) {
 	podKiller := &mockPodKiller{}
 	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
 	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
 	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
 
 	config := Config{
@@ -197,8 +237,9 @@ func TestMemoryPressure(t *testing.T) {
 	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("2Gi", podStats)}
 	manager := &managerImpl{
 		clock:           fakeClock,
-		killPodFunc:     podKiller.killPodNow,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
 		imageGC:         imageGC,
+		containerGC:     containerGC,
 		config:          config,
 		recorder:        &record.FakeRecorder{},
 		summaryProvider: summaryProvider,
@@ -287,6 +328,11 @@ func TestMemoryPressure(t *testing.T) {
 		t.Errorf("Manager should report memory pressure")
 	}
 
+	// the condition message should explain which threshold was crossed
+	if message := manager.NodeConditionMessage(api.NodeMemoryPressure); !strings.Contains(message, string(SignalMemoryAvailable)) {
+		t.Errorf("Manager should explain memory pressure with the met threshold, got: %q", message)
+	}
+
 	// check the right pod was killed
 	if podKiller.pod != podToEvict {
 		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod.Name, podToEvict.Name)
@@ -353,6 +399,192 @@ func TestMemoryPressure(t *testing.T) {
 	}
 }
 
+// TestAdmissionPolicy verifies the configured admission policy controls which pods are
+// rejected while the node reports memory pressure.
+func TestAdmissionPolicy(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "guaranteed-high", requests: newResourceList("100m", "1Gi"), limits: newResourceList("100m", "1Gi"), memoryWorkingSet: "800Mi"},
+		{name: "burstable-high", requests: newResourceList("100m", "100Mi"), limits: newResourceList("200m", "1Gi"), memoryWorkingSet: "800Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("2Gi"),
+				},
+				GracePeriod: time.Minute * 2,
+			},
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	bestEffortPodToAdmit, _ := podMaker("best-admit", newResourceList("", ""), newResourceList("", ""), "0Gi")
+	smallBurstablePodToAdmit, _ := podMaker("burst-small-admit", newResourceList("100m", "100Mi"), newResourceList("200m", "1Gi"), "0Gi")
+	bigBurstablePodToAdmit, _ := podMaker("burst-big-admit", newResourceList("100m", "600Mi"), newResourceList("200m", "1Gi"), "0Gi")
+	guaranteedPodToAdmit, _ := podMaker("guaranteed-admit", newResourceList("100m", "100Mi"), newResourceList("100m", "100Mi"), "0Gi")
+	podsToAdmit := []*api.Pod{bestEffortPodToAdmit, smallBurstablePodToAdmit, bigBurstablePodToAdmit, guaranteedPodToAdmit}
+
+	// induce memory pressure with 500Mi observed available
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should report memory pressure")
+	}
+
+	testCases := map[AdmissionPolicy][]bool{
+		AdmissionPolicy(""):       {false, true, true, true},
+		AdmissionPolicyBestEffort: {false, true, true, true},
+		AdmissionPolicyBurstable:  {false, true, false, true},
+		AdmissionPolicyAll:        {false, false, false, false},
+	}
+	for policy, expected := range testCases {
+		manager.config.AdmissionPolicy = policy
+		for i, pod := range podsToAdmit {
+			if result := manager.Admit(&lifecycle.PodAdmitAttributes{Pod: pod}); expected[i] != result.Admit {
+				t.Errorf("policy: %v, admit pod: %v, expected: %v, actual: %v", policy, pod.Name, expected[i], result.Admit)
+			}
+		}
+	}
+}
+
+// TestSoftEvictionNotification verifies the likely victim of a soft threshold is pre-notified
+// once the notify period is reached, before the grace period expires and the pod is killed.
+func TestSoftEvictionNotification(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "guaranteed-high", requests: newResourceList("100m", "1Gi"), limits: newResourceList("100m", "1Gi"), memoryWorkingSet: "800Mi"},
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "500Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	podToNotify := pods[1]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	notifiedPods := []*api.Pod{}
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+				GracePeriod:  time.Minute * 2,
+				NotifyPeriod: time.Minute * 1,
+			},
+		},
+		PodNotifier: func(pod *api.Pod, message string) error {
+			notifiedPods = append(notifiedPods, pod)
+			return nil
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+		podsNotifiedAt:               map[types.UID]time.Time{},
+	}
+
+	// cross the soft threshold; the notify period has not yet been reached
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should report memory pressure since soft threshold was met")
+	}
+	if len(notifiedPods) != 0 {
+		t.Errorf("Manager should not have notified a pod before the notify period, notified: %v", format.Pods(notifiedPods))
+	}
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod yet, but killed: %v", podKiller.pod.Name)
+	}
+
+	// step inside the notify window; the likely victim should be notified but not killed
+	fakeClock.Step(90 * time.Second)
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if len(notifiedPods) != 1 || notifiedPods[0] != podToNotify {
+		t.Errorf("Manager should have notified pod %v once, notified: %v", podToNotify.Name, format.Pods(notifiedPods))
+	}
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod yet, but killed: %v", podKiller.pod.Name)
+	}
+
+	// a pod is notified at most once per threshold crossing
+	fakeClock.Step(10 * time.Second)
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if len(notifiedPods) != 1 {
+		t.Errorf("Manager should not have notified the pod again, notified: %v", format.Pods(notifiedPods))
+	}
+
+	// step past the grace period; the notified pod should be the one killed
+	fakeClock.Step(1 * time.Minute)
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != podToNotify {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, podToNotify.Name)
+	}
+}
+
 // parseQuantity parses the specified value (if provided) otherwise returns 0 value
 func parseQuantity(value string) resource.Quantity {
 	if len(value) == 0 {
@@ -388,6 +620,7 @@ func TestDiskPressureNodeFs(t *testing.T) {
 	podKiller := &mockPodKiller{}
 	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
 	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
 	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
 
 	config := Config{
@@ -414,8 +647,9 @@ func TestDiskPressureNodeFs(t *testing.T) {
 	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("16Gi", "200Gi", podStats)}
 	manager := &managerImpl{
 		clock:           fakeClock,
-		killPodFunc:     podKiller.killPodNow,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
 		imageGC:         imageGC,
+		containerGC:     containerGC,
 		config:          config,
 		recorder:        &record.FakeRecorder{},
 		summaryProvider: summaryProvider,
@@ -585,6 +819,7 @@ func TestMinReclaim(t *testing.T) {
 	podKiller := &mockPodKiller{}
 	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
 	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
 	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
 
 	config := Config{
@@ -606,8 +841,9 @@ func TestMinReclaim(t *testing.T) {
 	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("2Gi", podStats)}
 	manager := &managerImpl{
 		clock:           fakeClock,
-		killPodFunc:     podKiller.killPodNow,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
 		imageGC:         imageGC,
+		containerGC:     containerGC,
 		config:          config,
 		recorder:        &record.FakeRecorder{},
 		summaryProvider: summaryProvider,
@@ -724,6 +960,7 @@ func TestNodeReclaimFuncs(t *testing.T) {
 	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
 	imageGcFree := resource.MustParse("700Mi")
 	imageGC := &mockImageGC{freed: imageGcFree.Value(), err: nil}
+	containerGC := &mockContainerGC{err: nil}
 	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
 
 	config := Config{
@@ -745,8 +982,9 @@ func TestNodeReclaimFuncs(t *testing.T) {
 	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("16Gi", "200Gi", podStats)}
 	manager := &managerImpl{
 		clock:           fakeClock,
-		killPodFunc:     podKiller.killPodNow,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
 		imageGC:         imageGC,
+		containerGC:     containerGC,
 		config:          config,
 		recorder:        &record.FakeRecorder{},
 		summaryProvider: summaryProvider,
@@ -865,6 +1103,1202 @@ func TestNodeReclaimFuncs(t *testing.T) {
 	}
 }
 
+// TestLogsReclaim verifies container logs are reclaimed under nodefs pressure before pods are
+// evicted, and that pods are only evicted when log reclaim is insufficient.
+func TestLogsReclaim(t *testing.T) {
+	podMaker := makePodWithDiskStats
+	summaryStatsMaker := makeDiskStats
+	podsToMake := []podToMake{
+		{name: "guaranteed-high", requests: newResourceList("100m", "1Gi"), limits: newResourceList("100m", "1Gi"), rootFsUsed: "800Mi"},
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), rootFsUsed: "500Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.rootFsUsed, podToMake.logsFsUsed, podToMake.perLocalVolumeUsed)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	podToEvict := pods[1]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	// with a dedicated imagefs, log reclaim is the only nodefs reclaim function
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: true}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	logsFree := resource.MustParse("700Mi")
+	logsReclaimer := &mockLogsReclaimer{freed: logsFree.Value(), err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalNodeFsAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+				MinReclaim: &ThresholdValue{
+					Quantity: quantityMustParse("500Mi"),
+				},
+			},
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("16Gi", "200Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		logsReclaimer:   logsReclaimer,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// synchronize
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// we should not have disk pressure
+	if manager.IsUnderDiskPressure() {
+		t.Errorf("Manager should not report disk pressure")
+	}
+	if logsReclaimer.invoked {
+		t.Errorf("Manager should not have reclaimed logs absent disk pressure")
+	}
+
+	// induce disk pressure that log reclaim can relieve
+	fakeClock.Step(1 * time.Minute)
+	summaryProvider.result = summaryStatsMaker(".9Gi", "200Gi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// we should have disk pressure
+	if !manager.IsUnderDiskPressure() {
+		t.Errorf("Manager should report disk pressure")
+	}
+
+	// verify logs were reclaimed and no pod was killed
+	if !logsReclaimer.invoked {
+		t.Errorf("Manager should have reclaimed container logs")
+	}
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod, but killed: %v", podKiller.pod.Name)
+	}
+
+	// remove disk pressure
+	fakeClock.Step(20 * time.Minute)
+	summaryProvider.result = summaryStatsMaker("16Gi", "200Gi", podStats)
+	logsReclaimer.invoked = false // reset state
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// induce disk pressure that log reclaim cannot relieve
+	fakeClock.Step(1 * time.Minute)
+	summaryProvider.result = summaryStatsMaker("400Mi", "200Gi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// we should have disk pressure
+	if !manager.IsUnderDiskPressure() {
+		t.Errorf("Manager should report disk pressure")
+	}
+
+	// verify logs were reclaimed first, but the insufficient reclaim forced an eviction
+	if !logsReclaimer.invoked {
+		t.Errorf("Manager should have reclaimed container logs")
+	}
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, podToEvict.Name)
+	}
+}
+
+// TestStaticPodsNotEvicted verifies static pods are excluded from eviction ranking when the
+// manager is configured to skip them, since the kubelet immediately restarts them.
+func TestStaticPodsNotEvicted(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "static-best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "800Mi"},
+		{name: "best-effort-low", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "300Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	staticPod := pods[0]
+	staticPod.Annotations = map[string]string{
+		kubetypes.ConfigSourceAnnotationKey: kubetypes.FileSource,
+	}
+	podToEvict := pods[1]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		SkipStaticPods:           true,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// induce memory pressure; the static pod ranks first but must be skipped
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should report memory pressure")
+	}
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, podToEvict.Name)
+	}
+
+	// without the knob, the static pod is ranked like any other pod
+	manager.config.SkipStaticPods = false
+	fakeClock.Step(1 * time.Minute)
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	podKiller.pod = nil // reset state
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != staticPod {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, staticPod.Name)
+	}
+}
+
+// TestStatsStaleness verifies threshold evaluation is skipped, and an event is recorded, when
+// the stats summary is older than the configured staleness window.
+func TestStatsStaleness(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "800Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	podToEvict := pods[0]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+	recorder := record.NewFakeRecorder(10)
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		StatsStalenessPeriod:     time.Minute * 30,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	staleSummary := summaryStatsMaker("500Mi", podStats)
+	staleSummary.Node.Memory.Time = unversioned.NewTime(fakeClock.Now())
+	summaryProvider := &fakeSummaryProvider{result: staleSummary}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        recorder,
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// age the stats past the staleness window, then synchronize under pressure
+	fakeClock.Step(1 * time.Hour)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// no pod should be killed and no pressure reported because the stats are stale
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod on stale stats, but killed: %v", podKiller.pod.Name)
+	}
+	if manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should not report memory pressure on stale stats")
+	}
+
+	// a stale stats event should have been recorded
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, statsStaleReason) {
+			t.Errorf("Expected a %s event, but got: %v", statsStaleReason, event)
+		}
+	default:
+		t.Errorf("Expected a %s event, but none was recorded", statsStaleReason)
+	}
+
+	// synchronize again while still stale, the event should not repeat
+	fakeClock.Step(1 * time.Minute)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	select {
+	case event := <-recorder.Events:
+		t.Errorf("Expected no additional event while stats remain stale, but got: %v", event)
+	default:
+	}
+
+	// refresh the stats, eviction should resume
+	freshSummary := summaryStatsMaker("500Mi", podStats)
+	freshSummary.Node.Memory.Time = unversioned.NewTime(fakeClock.Now())
+	summaryProvider.result = freshSummary
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, podToEvict.Name)
+	}
+}
+
+// TestSummaryFailureBackoff verifies the manager backs off a failing summary provider and
+// resumes evaluation once the provider recovers and the backoff expires.
+func TestSummaryFailureBackoff(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "800Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	podToEvict := pods[0]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{err: fmt.Errorf("summary unavailable")}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// the first failure should start the backoff
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if manager.summaryFailures != 1 {
+		t.Errorf("Expected 1 summary failure, got: %d", manager.summaryFailures)
+	}
+
+	// the provider recovers, but the manager should still be backing off
+	summaryProvider.err = nil
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	fakeClock.Step(5 * time.Second)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod while backing off, but killed: %v", podKiller.pod.Name)
+	}
+
+	// once the backoff expires, evaluation resumes
+	fakeClock.Step(10 * time.Second)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if manager.summaryFailures != 0 {
+		t.Errorf("Expected failure count to reset on success, got: %d", manager.summaryFailures)
+	}
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, podToEvict.Name)
+	}
+}
+
+// TestLocalStorageEviction verifies pods that exceed a declared local storage limit are evicted
+// independent of node-level disk pressure.
+func TestLocalStorageEviction(t *testing.T) {
+	podMaker := makePodWithDiskStats
+	summaryStatsMaker := makeDiskStats
+	podsToMake := []podToMake{
+		{name: "below-limit", requests: newResourceList("", ""), limits: newResourceList("", ""), rootFsUsed: "300Mi"},
+		{name: "above-limit", requests: newResourceList("", ""), limits: newResourceList("", ""), rootFsUsed: "900Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.rootFsUsed, podToMake.logsFsUsed, podToMake.perLocalVolumeUsed)
+		pod.Annotations = map[string]string{podLocalStorageLimitAnnotationKey: "500Mi"}
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	podToEvict := pods[1]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalNodeFsAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// plenty of disk available, so the node is not under disk pressure
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("200Gi", "200Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// synchronize
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// we should not have disk pressure
+	if manager.IsUnderDiskPressure() {
+		t.Errorf("Manager should not report disk pressure")
+	}
+
+	// the pod that exceeded its limit should have been evicted with a zero grace period
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager expected to evict pod: %v, but instead evicted: %v", podToEvict.Name, podKiller.pod)
+	}
+	observedGracePeriod := *podKiller.gracePeriodOverride
+	if observedGracePeriod != int64(0) {
+		t.Errorf("Manager chose to kill pod with incorrect grace period.  Expected: %d, actual: %d", 0, observedGracePeriod)
+	}
+}
+
+// TestDryRun verifies no pod is killed while in dry-run mode even if thresholds are crossed.
+func TestDryRun(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "500Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		DryRun: true,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// induce memory pressure immediately
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// synchronize
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// we should have memory pressure, but no pod should have been killed
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should report memory pressure")
+	}
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod in dry-run mode, but killed: %v", podKiller.pod.Name)
+	}
+}
+
+// TestEvictMultiplePods verifies the manager keeps evicting under a hard threshold until
+// the estimated reclaim would relieve pressure.
+func TestEvictMultiplePods(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "guaranteed", requests: newResourceList("100m", "1Gi"), limits: newResourceList("100m", "1Gi"), memoryWorkingSet: "300Mi"},
+		{name: "best-effort-1", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "300Mi"},
+		{name: "best-effort-2", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "300Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	guaranteedPod := pods[0]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		EvictMultiplePods:        true,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// induce memory pressure immediately, 500Mi short of the threshold
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// synchronize
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// evicting the first best-effort pod credits 300Mi, still below the threshold, so a
+	// second eviction is required.  the guaranteed pod should be left alone.
+	if len(podKiller.pods) != 2 {
+		t.Fatalf("Manager should have killed 2 pods, but killed %d", len(podKiller.pods))
+	}
+	for _, pod := range podKiller.pods {
+		if pod == guaranteedPod {
+			t.Errorf("Manager should not have killed the guaranteed pod: %v", pod.Name)
+		}
+	}
+}
+
+// TestTerminatingPodsNotEvicted verifies pods that are already terminating are not selected
+// as eviction victims, and that their expected reclaim is credited when deciding whether
+// further kills are needed.
+func TestTerminatingPodsNotEvicted(t *testing.T) {
+	summaryStatsMaker := makeMemoryStats
+	terminatingPod, terminatingPodStats := makePodWithMemoryStats("terminating", newResourceList("", ""), newResourceList("", ""), "200Mi")
+	now := unversioned.Now()
+	terminatingPod.DeletionTimestamp = &now
+	activePod, activePodStats := makePodWithMemoryStats("active", newResourceList("", ""), newResourceList("", ""), "300Mi")
+	pods := []*api.Pod{terminatingPod, activePod}
+	podStats := map[*api.Pod]statsapi.PodStats{
+		terminatingPod: terminatingPodStats,
+		activePod:      activePodStats,
+	}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// induce memory pressure; the terminating pod's 200Mi is not enough to relieve it
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// synchronize
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// the active pod should be evicted; the terminating pod should be left alone.
+	if podKiller.pod != activePod {
+		t.Errorf("Manager should have killed the active pod, but killed: %v", podKiller.pod)
+	}
+	for _, pod := range podKiller.pods {
+		if pod == terminatingPod {
+			t.Errorf("Manager should not have killed the terminating pod: %v", pod.Name)
+		}
+	}
+
+	// if the terminating pod's expected reclaim would relieve pressure, nothing is evicted.
+	bigTerminatingPod, bigTerminatingPodStats := makePodWithMemoryStats("big-terminating", newResourceList("", ""), newResourceList("", ""), "600Mi")
+	bigTerminatingPod.DeletionTimestamp = &now
+	pods = []*api.Pod{bigTerminatingPod, activePod}
+	podStats = map[*api.Pod]statsapi.PodStats{
+		bigTerminatingPod: bigTerminatingPodStats,
+		activePod:         activePodStats,
+	}
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	podKiller.pod = nil
+	podKiller.pods = nil
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod while terminating pods relieve pressure, but killed: %v", podKiller.pod.Name)
+	}
+}
+
+// TestNodeCordon verifies the manager cordons the node when hard thresholds are crossed
+// repeatedly, and uncordons it once pressure clears past the transition period.
+func TestNodeCordon(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	pod, podStat := podMaker("best-effort", newResourceList("", ""), newResourceList("", ""), "500Mi")
+	pods := []*api.Pod{pod}
+	podStats := map[*api.Pod]statsapi.PodStats{pod: podStat}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	cordoned := false
+	cordonFunc := func(unschedulable bool) error {
+		cordoned = unschedulable
+		return nil
+	}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		CordonThresholdCrossings: 1,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("2Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		cordonFunc:      cordonFunc,
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// no pressure, the node should not be cordoned
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if cordoned {
+		t.Errorf("Manager should not have cordoned the node without pressure")
+	}
+
+	// induce memory pressure, the hard threshold crossing should cordon the node
+	fakeClock.Step(1 * time.Minute)
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if !cordoned {
+		t.Errorf("Manager should have cordoned the node after crossing a hard threshold")
+	}
+
+	// relieve pressure and wait out the transition period, the node should be uncordoned
+	fakeClock.Step(6 * time.Minute)
+	summaryProvider.result = summaryStatsMaker("2Gi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if cordoned {
+		t.Errorf("Manager should have uncordoned the node after pressure cleared")
+	}
+}
+
+// TestFilesystemThresholds verifies per-filesystem thresholds observed outside the stats
+// summary drive their own pressure conditions.
+func TestFilesystemThresholds(t *testing.T) {
+	pod, podStat := makePodWithMemoryStats("pod", newResourceList("", ""), newResourceList("", ""), "100Mi")
+	pods := []*api.Pod{pod}
+	podStats := map[*api.Pod]statsapi.PodStats{pod: podStat}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	filesystemThresholds, err := ParseFilesystemThresholdConfig("/var/log<1Gi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		FilesystemThresholds:     filesystemThresholds,
+	}
+	available := int64(1024 * 1024 * 1024 * 10)
+	summaryProvider := &fakeSummaryProvider{result: makeMemoryStats("2Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+		fsInfoFunc: func(path string) (int64, int64, error) {
+			return available, int64(1024 * 1024 * 1024 * 100), nil
+		},
+	}
+
+	// plenty of space available, no condition should be reported
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if len(manager.nodeConditions) != 0 {
+		t.Errorf("Manager should not report conditions without filesystem pressure, but reported: %v", manager.nodeConditions)
+	}
+
+	// drop below the threshold, a condition named for the mount path should be reported
+	fakeClock.Step(1 * time.Minute)
+	available = int64(1024 * 1024 * 512)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	expectedCondition := api.NodeConditionType("DiskPressure:/var/log")
+	if len(manager.nodeConditions) != 1 || manager.nodeConditions[0] != expectedCondition {
+		t.Errorf("Manager should report condition %v, but reported: %v", expectedCondition, manager.nodeConditions)
+	}
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod for a filesystem signal, but killed: %v", podKiller.pod.Name)
+	}
+}
+
+// TestCgroupThresholds verifies thresholds scoped to a top-level cgroup observed outside the
+// stats summary drive memory pressure and evictions.
+func TestCgroupThresholds(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	podsToMake := []podToMake{
+		{name: "best-effort-low", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "100Mi"},
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "500Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	podToEvict := pods[1]
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	cgroupThresholds, err := ParseCgroupThresholdConfig("kubepods<500Mi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		CgroupThresholds:         cgroupThresholds,
+	}
+	available := int64(1024 * 1024 * 1024)
+	summaryProvider := &fakeSummaryProvider{result: makeMemoryStats("2Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+		cgroupInfoFunc: func(path string) (int64, int64, error) {
+			if path != "kubepods" {
+				t.Errorf("Manager observed unexpected cgroup path: %v", path)
+			}
+			return available, int64(2 * 1024 * 1024 * 1024), nil
+		},
+	}
+
+	// plenty of memory available in the cgroup, no pressure should be reported
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should not report memory pressure without cgroup pressure")
+	}
+
+	// drop below the threshold, memory pressure should be reported and a pod evicted
+	fakeClock.Step(1 * time.Minute)
+	available = int64(1024 * 1024 * 100)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if !manager.IsUnderMemoryPressure() {
+		t.Errorf("Manager should report memory pressure since the cgroup threshold was met")
+	}
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager chose to kill pod: %v, but should have chosen %v", podKiller.pod, podToEvict.Name)
+	}
+}
+
+// TestEvictionRateLimit verifies the eviction cap stops pod kills once reached, even when
+// the triggering threshold never clears, and allows evictions again once the window passes.
+func TestEvictionRateLimit(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "best-effort-first", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "500Mi"},
+		{name: "best-effort-second", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "400Mi"},
+		{name: "best-effort-third", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "300Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		MaxEvictionsPerHour:      1,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// the threshold never clears, memory available remains below 1Gi for the entire test
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// the first pass should evict a pod
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod == nil {
+		t.Fatalf("Manager should have evicted a pod, but did not")
+	}
+
+	// subsequent passes should keep reporting pressure but not evict past the cap
+	for i := 0; i < 5; i++ {
+		podKiller.pod = nil
+		fakeClock.Step(1 * time.Minute)
+		summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+		manager.synchronize(diskInfoProvider, activePodsFunc)
+		if !manager.IsUnderMemoryPressure() {
+			t.Errorf("Manager should still report memory pressure while rate limited")
+		}
+		if podKiller.pod != nil {
+			t.Errorf("Manager should not have evicted pod %v past the eviction cap", podKiller.pod.Name)
+		}
+	}
+
+	// once the window has passed, evictions resume
+	fakeClock.Step(1 * time.Hour)
+	summaryProvider.result = summaryStatsMaker("500Mi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod == nil {
+		t.Errorf("Manager should have evicted a pod after the rate limit window passed, but did not")
+	}
+}
+
+// TestCustomResourceThresholds verifies a threshold on a custom resource observed via a
+// pluggable stats source drives eviction, ranking pods by their declared usage.
+func TestCustomResourceThresholds(t *testing.T) {
+	scratchResource := api.ResourceName("example.com/scratch")
+	podWithScratch := func(name string, scratch string) (*api.Pod, statsapi.PodStats) {
+		pod, podStat := makePodWithMemoryStats(name, newResourceList("", ""), newResourceList("", ""), "100Mi")
+		pod.Spec.Containers[0].Resources.Requests[scratchResource] = resource.MustParse(scratch)
+		return pod, podStat
+	}
+	lowPod, lowPodStats := podWithScratch("scratch-low", "100")
+	highPod, highPodStats := podWithScratch("scratch-high", "600")
+	pods := []*api.Pod{lowPod, highPod}
+	podStats := map[*api.Pod]statsapi.PodStats{lowPod: lowPodStats, highPod: highPodStats}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	available := int64(1000)
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		CustomResourceThresholds: []CustomResourceThreshold{
+			{
+				Resource: scratchResource,
+				StatsFunc: func() (int64, int64, error) {
+					return available, int64(1000), nil
+				},
+				Threshold: Threshold{
+					Signal:   customSignal(scratchResource),
+					Operator: OpLessThan,
+					Value: ThresholdValue{
+						Quantity: quantityMustParse("200"),
+					},
+				},
+			},
+		},
+	}
+	summaryProvider := &fakeSummaryProvider{result: makeMemoryStats("2Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// plenty of the resource available, no pod should be killed
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != nil {
+		t.Errorf("Manager should not have killed a pod without pressure, but killed: %v", podKiller.pod.Name)
+	}
+
+	// drop below the threshold, the pod with the largest declared usage should be killed
+	fakeClock.Step(1 * time.Minute)
+	available = int64(100)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod == nil {
+		t.Fatalf("Manager should have killed a pod, but did not")
+	}
+	if podKiller.pod != highPod {
+		t.Errorf("Manager killed the wrong pod: %v, expected: %v", podKiller.pod.Name, highPod.Name)
+	}
+}
+
+// TestEvictionBackoff verifies pods from an owner that recently had a pod evicted are
+// rejected at admission while the node is under pressure, until the backoff window expires.
+func TestEvictionBackoff(t *testing.T) {
+	ownerRef, err := json.Marshal(api.SerializedReference{
+		Reference: api.ObjectReference{Kind: "ReplicationController", Name: "rc", UID: types.UID("owner-uid")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	evictedPod, evictedPodStats := makePodWithMemoryStats("evicted", newResourceList("", ""), newResourceList("", ""), "500Mi")
+	evictedPod.Annotations = map[string]string{api.CreatedByAnnotation: string(ownerRef)}
+	replacementPod, _ := makePodWithMemoryStats("replacement", newResourceList("", ""), newResourceList("", ""), "0Mi")
+	replacementPod.Annotations = map[string]string{api.CreatedByAnnotation: string(ownerRef)}
+	pods := []*api.Pod{evictedPod}
+	podStats := map[*api.Pod]statsapi.PodStats{evictedPod: evictedPodStats}
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// induce memory pressure immediately
+	summaryProvider := &fakeSummaryProvider{result: makeMemoryStats("500Mi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+		evictedOwnersAt:              map[types.UID]time.Time{},
+	}
+
+	// synchronize should evict the only pod and record its owner
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	if podKiller.pod != evictedPod {
+		t.Fatalf("Manager should have killed pod: %v", evictedPod.Name)
+	}
+
+	// a replacement pod from the same owner is rejected during the backoff window
+	result := manager.Admit(&lifecycle.PodAdmitAttributes{Pod: replacementPod})
+	if result.Admit {
+		t.Errorf("Manager should have rejected the replacement pod during backoff")
+	}
+
+	// once the backoff window expires, the replacement pod is admitted again
+	fakeClock.Step(podEvictionBackoffPeriod + time.Minute)
+	summaryProvider.result = makeMemoryStats("2Gi", podStats)
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+	result = manager.Admit(&lifecycle.PodAdmitAttributes{Pod: replacementPod})
+	if !result.Admit {
+		t.Errorf("Manager should have admitted the replacement pod after backoff, but got: %v", result.Message)
+	}
+}
+
+// TestEmptyDirLimitEviction verifies pods whose emptyDir volumes exceed a declared size limit are evicted.
+func TestEmptyDirLimitEviction(t *testing.T) {
+	summaryStatsMaker := makeDiskStats
+	podBelow := newPod("emptydir-below", []api.Container{
+		newContainer("emptydir-below", newResourceList("", ""), newResourceList("", "")),
+	}, []api.Volume{
+		{Name: "scratch", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+	})
+	podBelow.Annotations = map[string]string{podEmptyDirSizeLimitAnnotationKey: "scratch=1Gi"}
+	podAbove := newPod("emptydir-above", []api.Container{
+		newContainer("emptydir-above", newResourceList("", ""), newResourceList("", "")),
+	}, []api.Volume{
+		{Name: "scratch", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+	})
+	podAbove.Annotations = map[string]string{podEmptyDirSizeLimitAnnotationKey: "scratch=500Mi"}
+
+	pods := []*api.Pod{podBelow, podAbove}
+	podStats := map[*api.Pod]statsapi.PodStats{
+		podBelow: newPodDiskStats(podBelow, parseQuantity(""), parseQuantity(""), parseQuantity("700Mi")),
+		podAbove: newPodDiskStats(podAbove, parseQuantity(""), parseQuantity(""), parseQuantity("700Mi")),
+	}
+	podToEvict := podAbove
+	activePodsFunc := func() []*api.Pod {
+		return pods
+	}
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
+	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
+	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalNodeFsAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+			},
+		},
+	}
+	// plenty of disk available, so the node is not under disk pressure
+	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("200Gi", "200Gi", podStats)}
+	manager := &managerImpl{
+		clock:           fakeClock,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
+		imageGC:         imageGC,
+		containerGC:     containerGC,
+		config:          config,
+		recorder:        &record.FakeRecorder{},
+		summaryProvider: summaryProvider,
+		nodeRef:         nodeRef,
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+	}
+
+	// synchronize
+	manager.synchronize(diskInfoProvider, activePodsFunc)
+
+	// the pod with the exceeded emptyDir limit should have been evicted
+	if podKiller.pod != podToEvict {
+		t.Errorf("Manager expected to evict pod: %v, but instead evicted: %v", podToEvict.Name, podKiller.pod)
+	}
+}
+
 func TestInodePressureNodeFsInodes(t *testing.T) {
 	podMaker := func(name string, requests api.ResourceList, limits api.ResourceList, rootInodes, logInodes, volumeInodes string) (*api.Pod, statsapi.PodStats) {
 		pod := newPod(name, []api.Container{
@@ -916,6 +2350,7 @@ func TestInodePressureNodeFsInodes(t *testing.T) {
 	podKiller := &mockPodKiller{}
 	diskInfoProvider := &mockDiskInfoProvider{dedicatedImageFs: false}
 	imageGC := &mockImageGC{freed: int64(0), err: nil}
+	containerGC := &mockContainerGC{err: nil}
 	nodeRef := &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""}
 
 	config := Config{
@@ -942,8 +2377,9 @@ func TestInodePressureNodeFsInodes(t *testing.T) {
 	summaryProvider := &fakeSummaryProvider{result: summaryStatsMaker("3Mi", "4Mi", podStats)}
 	manager := &managerImpl{
 		clock:           fakeClock,
-		killPodFunc:     podKiller.killPodNow,
+		podTerminator:   KillPodFunc(podKiller.killPodNow),
 		imageGC:         imageGC,
+		containerGC:     containerGC,
 		config:          config,
 		recorder:        &record.FakeRecorder{},
 		summaryProvider: summaryProvider,
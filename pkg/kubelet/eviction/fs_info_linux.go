@@ -0,0 +1,32 @@
+// +build linux
+
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eviction
+
+import "syscall"
+
+// fsAvailableInfo returns the available and capacity bytes for the filesystem that path resides upon.
+func fsAvailableInfo(path string) (available int64, capacity int64, err error) {
+	statfs := &syscall.Statfs_t{}
+	if err := syscall.Statfs(path, statfs); err != nil {
+		return 0, 0, err
+	}
+	available = int64(statfs.Bavail) * int64(statfs.Bsize)
+	capacity = int64(statfs.Blocks) * int64(statfs.Bsize)
+	return available, capacity, nil
+}
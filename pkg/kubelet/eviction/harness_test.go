@@ -0,0 +1,196 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eviction
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
+	statsapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util/clock"
+)
+
+// evictionHarness drives the eviction manager through a scripted timeline of summary
+// snapshots and clock steps, asserting on kills, node conditions and admission decisions
+// after each step.  it exists so new scenarios (and new signals) do not require hand-rolling
+// the same manager setup and synchronize loop per test.
+type evictionHarness struct {
+	t                *testing.T
+	manager          *managerImpl
+	podKiller        *mockPodKiller
+	fakeClock        *clock.FakeClock
+	summaryProvider  *fakeSummaryProvider
+	diskInfoProvider *mockDiskInfoProvider
+	activePods       []*api.Pod
+}
+
+// harnessStep is a single step in a scripted eviction timeline.
+type harnessStep struct {
+	// name identifies the step in failure messages.
+	name string
+	// clockStep advances the manager clock before synchronizing.
+	clockStep time.Duration
+	// summary produces the stats snapshot observed during this step.  it is invoked at
+	// step time so observation timestamps advance between steps.
+	summary func() *statsapi.Summary
+	// expectMemoryPressure asserts whether the node reports memory pressure after the step.
+	expectMemoryPressure bool
+	// expectDiskPressure asserts whether the node reports disk pressure after the step.
+	expectDiskPressure bool
+	// expectKill asserts the named pod was killed during this step; empty asserts no kill.
+	expectKill string
+	// expectAdmit asserts admission decisions for the provided pods after the step.
+	expectAdmit map[*api.Pod]bool
+}
+
+// newEvictionHarness returns a harness running the provided config against the provided active pods.
+func newEvictionHarness(t *testing.T, config Config, activePods []*api.Pod, initialSummary *statsapi.Summary, dedicatedImageFs bool) *evictionHarness {
+	fakeClock := clock.NewFakeClock(time.Now())
+	podKiller := &mockPodKiller{}
+	summaryProvider := &fakeSummaryProvider{result: initialSummary}
+	manager := &managerImpl{
+		clock:                        fakeClock,
+		podTerminator:                KillPodFunc(podKiller.killPodNow),
+		imageGC:                      &mockImageGC{freed: int64(0), err: nil},
+		containerGC:                  &mockContainerGC{err: nil},
+		config:                       config,
+		recorder:                     &record.FakeRecorder{},
+		summaryProvider:              summaryProvider,
+		nodeRef:                      &api.ObjectReference{Kind: "Node", Name: "test", UID: types.UID("test"), Namespace: ""},
+		nodeConditionsLastObservedAt: nodeConditionsObservedAt{},
+		thresholdsFirstObservedAt:    thresholdsObservedAt{},
+		evictedOwnersAt:              map[types.UID]time.Time{},
+		podsNotifiedAt:               map[types.UID]time.Time{},
+	}
+	return &evictionHarness{
+		t:                t,
+		manager:          manager,
+		podKiller:        podKiller,
+		fakeClock:        fakeClock,
+		summaryProvider:  summaryProvider,
+		diskInfoProvider: &mockDiskInfoProvider{dedicatedImageFs: dedicatedImageFs},
+		activePods:       activePods,
+	}
+}
+
+// run executes the scripted steps in order and reports any unexpected observations.
+func (h *evictionHarness) run(steps []harnessStep) {
+	for _, step := range steps {
+		if step.clockStep > 0 {
+			h.fakeClock.Step(step.clockStep)
+		}
+		if step.summary != nil {
+			h.summaryProvider.result = step.summary()
+		}
+		h.podKiller.pod = nil
+		h.podKiller.gracePeriodOverride = nil
+		h.manager.synchronize(h.diskInfoProvider, func() []*api.Pod { return h.activePods })
+
+		if actual := h.manager.IsUnderMemoryPressure(); actual != step.expectMemoryPressure {
+			h.t.Errorf("step %q: memory pressure expected: %v, actual: %v", step.name, step.expectMemoryPressure, actual)
+		}
+		if actual := h.manager.IsUnderDiskPressure(); actual != step.expectDiskPressure {
+			h.t.Errorf("step %q: disk pressure expected: %v, actual: %v", step.name, step.expectDiskPressure, actual)
+		}
+		switch {
+		case step.expectKill == "" && h.podKiller.pod != nil:
+			h.t.Errorf("step %q: expected no pod to be killed, but killed: %v", step.name, h.podKiller.pod.Name)
+		case step.expectKill != "" && h.podKiller.pod == nil:
+			h.t.Errorf("step %q: expected to kill pod %v, but killed nothing", step.name, step.expectKill)
+		case step.expectKill != "" && h.podKiller.pod.Name != step.expectKill:
+			h.t.Errorf("step %q: expected to kill pod %v, but killed: %v", step.name, step.expectKill, h.podKiller.pod.Name)
+		}
+		for pod, expected := range step.expectAdmit {
+			if result := h.manager.Admit(&lifecycle.PodAdmitAttributes{Pod: pod}); result.Admit != expected {
+				h.t.Errorf("step %q: admit pod %v expected: %v, actual: %v", step.name, pod.Name, expected, result.Admit)
+			}
+		}
+	}
+}
+
+// TestScriptedMemoryPressureTimeline exercises the soft memory eviction lifecycle using the
+// scripted harness: crossing the threshold, waiting out the grace period, killing the
+// best-effort victim, and transitioning out of pressure once availability recovers.
+func TestScriptedMemoryPressureTimeline(t *testing.T) {
+	podMaker := makePodWithMemoryStats
+	summaryStatsMaker := makeMemoryStats
+	podsToMake := []podToMake{
+		{name: "guaranteed-high", requests: newResourceList("100m", "1Gi"), limits: newResourceList("100m", "1Gi"), memoryWorkingSet: "800Mi"},
+		{name: "best-effort-high", requests: newResourceList("", ""), limits: newResourceList("", ""), memoryWorkingSet: "500Mi"},
+	}
+	pods := []*api.Pod{}
+	podStats := map[*api.Pod]statsapi.PodStats{}
+	for _, podToMake := range podsToMake {
+		pod, podStat := podMaker(podToMake.name, podToMake.requests, podToMake.limits, podToMake.memoryWorkingSet)
+		pods = append(pods, pod)
+		podStats[pod] = podStat
+	}
+	bestEffortPodToAdmit, _ := podMaker("best-admit", newResourceList("", ""), newResourceList("", ""), "0Gi")
+
+	config := Config{
+		MaxPodGracePeriodSeconds: 5,
+		PressureTransitionPeriod: time.Minute * 5,
+		Thresholds: []Threshold{
+			{
+				Signal:   SignalMemoryAvailable,
+				Operator: OpLessThan,
+				Value: ThresholdValue{
+					Quantity: quantityMustParse("1Gi"),
+				},
+				GracePeriod: time.Minute * 2,
+			},
+		},
+	}
+	harness := newEvictionHarness(t, config, pods, summaryStatsMaker("2Gi", podStats), false)
+	harness.run([]harnessStep{
+		{
+			name:        "healthy node",
+			summary:     func() *statsapi.Summary { return summaryStatsMaker("2Gi", podStats) },
+			expectAdmit: map[*api.Pod]bool{bestEffortPodToAdmit: true},
+		},
+		{
+			name:                 "soft threshold crossed",
+			clockStep:            1 * time.Minute,
+			summary:              func() *statsapi.Summary { return summaryStatsMaker("500Mi", podStats) },
+			expectMemoryPressure: true,
+			expectAdmit:          map[*api.Pod]bool{bestEffortPodToAdmit: false},
+		},
+		{
+			name:                 "grace period expires",
+			clockStep:            3 * time.Minute,
+			summary:              func() *statsapi.Summary { return summaryStatsMaker("500Mi", podStats) },
+			expectMemoryPressure: true,
+			expectKill:           "best-effort-high",
+		},
+		{
+			name:                 "pressure clears within transition period",
+			clockStep:            1 * time.Minute,
+			summary:              func() *statsapi.Summary { return summaryStatsMaker("3Gi", podStats) },
+			expectMemoryPressure: true,
+		},
+		{
+			name:        "transition period expires",
+			clockStep:   6 * time.Minute,
+			summary:     func() *statsapi.Summary { return summaryStatsMaker("3Gi", podStats) },
+			expectAdmit: map[*api.Pod]bool{bestEffortPodToAdmit: true},
+		},
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package eviction
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -29,7 +30,10 @@ import (
 	statsapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
 	"k8s.io/kubernetes/pkg/kubelet/qos"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
+	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/kubelet/util/format"
 	"k8s.io/kubernetes/pkg/quota/evaluator/core"
+	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/sets"
 )
 
@@ -37,8 +41,18 @@ const (
 	unsupportedEvictionSignal = "unsupported eviction signal %v"
 	// the reason reported back in status.
 	reason = "Evicted"
+	// the reason reported in events when running in dry-run mode.
+	wouldEvictReason = "WouldEvict"
+	// the reason reported back in status when a pod is rejected due to eviction backoff.
+	backoffReason = "EvictionBackoff"
+	// the message associated with an admission rejection due to eviction backoff.
+	backoffMessage = "A pod owned by the same controller was recently evicted from the node; backing off for %v."
 	// the message associated with the reason.
 	message = "The node was low on resource: %v."
+	// the message associated with an eviction caused by exceeding a pod-level local storage limit.
+	localStorageMessage = "Pod ephemeral local storage usage exceeds the declared limit of %v."
+	// the message associated with an eviction caused by an emptyDir volume exceeding its declared size limit.
+	emptyDirMessage = "Usage of EmptyDir volume %q (%v) exceeds the declared size limit of %v."
 	// disk, in bytes.  internal to this module, used to account for local disk usage.
 	resourceDisk api.ResourceName = "disk"
 	// inodes, number. internal to this module, used to account for local disk inode consumption.
@@ -51,6 +65,30 @@ const (
 	resourceNodeFs api.ResourceName = "nodefs"
 	// nodefs inodes, number.  internal to this module, used to account for local node root filesystem inodes.
 	resourceNodeFsInodes api.ResourceName = "nodefsInodes"
+	// pids, number.  internal to this module, used to account for local pid consumption.
+	resourcePids api.ResourceName = "pids"
+	// swap, in bytes.  internal to this module, used to account for node swap usage.
+	resourceSwap api.ResourceName = "swap"
+	// podLocalStorageLimitAnnotationKey is the annotation that declares a limit on the
+	// ephemeral local storage (rootfs + logs + local volumes) a pod may consume before it is evicted.
+	podLocalStorageLimitAnnotationKey = "alpha.kubernetes.io/ephemeral-storage-limit"
+	// podEmptyDirSizeLimitAnnotationKey is the annotation that declares per-volume size limits for
+	// the emptyDir volumes of a pod, e.g. scratch=1Gi,cache=500Mi.
+	podEmptyDirSizeLimitAnnotationKey = "alpha.kubernetes.io/emptydir-size-limit"
+	// podPriorityAnnotationKey is the annotation that declares the priority of a pod.
+	// pods with lower priority are evicted before pods with higher priority.
+	podPriorityAnnotationKey = "alpha.kubernetes.io/pod-priority"
+	// podEvictionCostAnnotationKey is the annotation that declares the cost of evicting a pod.
+	// pods with lower eviction cost are evicted before pods with higher cost within the same
+	// QoS class and priority, e.g. to hint that non-leader replicas are cheaper to kill.
+	podEvictionCostAnnotationKey = "alpha.kubernetes.io/eviction-cost"
+	// criticalPodAnnotationKey is the annotation that marks a pod as critical to the node.
+	criticalPodAnnotationKey = "scheduler.alpha.kubernetes.io/critical-pod"
+	// criticalPodPriority is the minimum declared priority at which a pod is treated as critical.
+	criticalPodPriority = int64(1000000000)
+	// podSafeToEvictAnnotationKey is the annotation a pod may carry with value "false" to ask
+	// the kubelet to select it for eviction only as a last resort.
+	podSafeToEvictAnnotationKey = "kubelet.kubernetes.io/safe-to-evict"
 )
 
 var (
@@ -60,6 +98,8 @@ var (
 	signalToResource map[Signal]api.ResourceName
 	// resourceToSignal maps a Resource to its associated Signal
 	resourceToSignal map[api.ResourceName]Signal
+	// signalAliases maps accepted shorthand names to their canonical eviction signals.
+	signalAliases map[Signal]Signal
 )
 
 func init() {
@@ -70,6 +110,8 @@ func init() {
 	signalToNodeCondition[SignalNodeFsAvailable] = api.NodeDiskPressure
 	signalToNodeCondition[SignalImageFsInodesFree] = api.NodeDiskPressure
 	signalToNodeCondition[SignalNodeFsInodesFree] = api.NodeDiskPressure
+	signalToNodeCondition[SignalPidAvailable] = api.NodePIDPressure
+	signalToNodeCondition[SignalSwapAvailable] = api.NodeMemoryPressure
 
 	// map signals to resources (and vice-versa)
 	signalToResource = map[Signal]api.ResourceName{}
@@ -78,10 +120,20 @@ func init() {
 	signalToResource[SignalImageFsInodesFree] = resourceImageFsInodes
 	signalToResource[SignalNodeFsAvailable] = resourceNodeFs
 	signalToResource[SignalNodeFsInodesFree] = resourceNodeFsInodes
+	signalToResource[SignalPidAvailable] = resourcePids
+	signalToResource[SignalSwapAvailable] = resourceSwap
 	resourceToSignal = map[api.ResourceName]Signal{}
 	for key, value := range signalToResource {
 		resourceToSignal[value] = key
 	}
+
+	// map shorthand aliases to canonical signals for flag parsing
+	signalAliases = map[Signal]Signal{}
+	signalAliases[Signal("memory")] = SignalMemoryAvailable
+	signalAliases[Signal("imagefs")] = SignalImageFsAvailable
+	signalAliases[Signal("nodefs")] = SignalNodeFsAvailable
+	signalAliases[Signal("pid")] = SignalPidAvailable
+	signalAliases[Signal("swap")] = SignalSwapAvailable
 }
 
 // validSignal returns true if the signal is supported.
@@ -90,8 +142,18 @@ func validSignal(signal Signal) bool {
 	return found
 }
 
-// ParseThresholdConfig parses the flags for thresholds.
-func ParseThresholdConfig(evictionHard, evictionSoft, evictionSoftGracePeriod, evictionMinimumReclaim string) ([]Threshold, error) {
+// canonicalSignal resolves accepted shorthand aliases (e.g. memory for memory.available)
+// to their canonical signal name.
+func canonicalSignal(signal Signal) Signal {
+	if canonical, found := signalAliases[signal]; found {
+		return canonical
+	}
+	return signal
+}
+
+// ParseThresholdConfig parses the flags for thresholds.  signals may be referenced by their
+// canonical name or an accepted shorthand alias (e.g. memory for memory.available).
+func ParseThresholdConfig(evictionHard, evictionSoft, evictionSoftGracePeriod, evictionMinimumReclaim, evictionSoftNotifyPeriod string) ([]Threshold, error) {
 	results := []Threshold{}
 
 	hardThresholds, err := parseThresholdStatements(evictionHard)
@@ -108,6 +170,10 @@ func ParseThresholdConfig(evictionHard, evictionSoft, evictionSoftGracePeriod, e
 	if err != nil {
 		return nil, err
 	}
+	notifyPeriods, err := parseGracePeriods(evictionSoftNotifyPeriod)
+	if err != nil {
+		return nil, err
+	}
 	minReclaims, err := parseMinimumReclaims(evictionMinimumReclaim)
 	if err != nil {
 		return nil, err
@@ -119,6 +185,12 @@ func ParseThresholdConfig(evictionHard, evictionSoft, evictionSoftGracePeriod, e
 			return nil, fmt.Errorf("grace period must be specified for the soft eviction threshold %v", signal)
 		}
 		softThresholds[i].GracePeriod = period
+		if notifyPeriod, found := notifyPeriods[signal]; found {
+			if notifyPeriod > period {
+				return nil, fmt.Errorf("notify period %v for the soft eviction threshold %v must not exceed its grace period %v", notifyPeriod, signal, period)
+			}
+			softThresholds[i].NotifyPeriod = notifyPeriod
+		}
 	}
 	results = append(results, softThresholds...)
 	for i := range results {
@@ -158,6 +230,7 @@ func parseThresholdStatements(expr string) ([]Threshold, error) {
 func parseThresholdStatement(statement string) (Threshold, error) {
 	tokens2Operator := map[string]ThresholdOperator{
 		"<": OpLessThan,
+		">": OpGreaterThan,
 	}
 	var (
 		operator ThresholdOperator
@@ -174,7 +247,7 @@ func parseThresholdStatement(statement string) (Threshold, error) {
 	if len(operator) == 0 || len(parts) != 2 {
 		return Threshold{}, fmt.Errorf("invalid eviction threshold syntax %v, expected <signal><operator><value>", statement)
 	}
-	signal := Signal(parts[0])
+	signal := canonicalSignal(Signal(parts[0]))
 	if !validSignal(signal) {
 		return Threshold{}, fmt.Errorf(unsupportedEvictionSignal, signal)
 	}
@@ -188,6 +261,9 @@ func parseThresholdStatement(statement string) (Threshold, error) {
 		if percentage <= 0 {
 			return Threshold{}, fmt.Errorf("eviction percentage threshold %v must be positive: %s", signal, quantityValue)
 		}
+		if percentage > 1 {
+			return Threshold{}, fmt.Errorf("eviction percentage threshold %v must be less than 100%%: %s", signal, quantityValue)
+		}
 		return Threshold{
 			Signal:   signal,
 			Operator: operator,
@@ -198,7 +274,7 @@ func parseThresholdStatement(statement string) (Threshold, error) {
 	}
 	quantity, err := resource.ParseQuantity(quantityValue)
 	if err != nil {
-		return Threshold{}, err
+		return Threshold{}, fmt.Errorf("invalid eviction threshold value %v for signal %v: %v", quantityValue, signal, err)
 	}
 	if quantity.Sign() < 0 || quantity.IsZero() {
 		return Threshold{}, fmt.Errorf("eviction threshold %v must be positive: %s", signal, &quantity)
@@ -212,6 +288,227 @@ func parseThresholdStatement(statement string) (Threshold, error) {
 	}, nil
 }
 
+// ParseFilesystemThresholdConfig parses the per-filesystem eviction threshold configuration.
+// statements have the form <mountPath><<value>, e.g. /var/log<1Gi, separated by commas.
+func ParseFilesystemThresholdConfig(expr string) ([]FilesystemThreshold, error) {
+	results := []FilesystemThreshold{}
+	if len(expr) == 0 {
+		return results, nil
+	}
+	statements := strings.Split(expr, ",")
+	pathsFound := sets.NewString()
+	for _, statement := range statements {
+		parts := strings.Split(statement, "<")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid filesystem eviction threshold syntax %v, expected <path><<value>", statement)
+		}
+		path := parts[0]
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("filesystem eviction threshold path %v must be absolute", path)
+		}
+		if pathsFound.Has(path) {
+			return nil, fmt.Errorf("found duplicate filesystem eviction threshold for path %v", path)
+		}
+		pathsFound.Insert(path)
+		threshold := Threshold{
+			Signal:   fsSignal(path),
+			Operator: OpLessThan,
+		}
+		quantityValue := parts[1]
+		if strings.HasSuffix(quantityValue, "%") {
+			percentage, err := parsePercentage(quantityValue)
+			if err != nil {
+				return nil, err
+			}
+			if percentage <= 0 {
+				return nil, fmt.Errorf("eviction percentage threshold %v must be positive: %s", path, quantityValue)
+			}
+			threshold.Value = ThresholdValue{
+				Percentage: percentage,
+			}
+		} else {
+			quantity, err := resource.ParseQuantity(quantityValue)
+			if err != nil {
+				return nil, err
+			}
+			if quantity.Sign() < 0 || quantity.IsZero() {
+				return nil, fmt.Errorf("eviction threshold %v must be positive: %s", path, &quantity)
+			}
+			threshold.Value = ThresholdValue{
+				Quantity: &quantity,
+			}
+		}
+		results = append(results, FilesystemThreshold{
+			Path:      path,
+			Threshold: threshold,
+		})
+	}
+	return results, nil
+}
+
+// ParseCgroupThresholdConfig parses the cgroup-scoped eviction threshold configuration.
+// statements have the form <cgroupPath><<value>, e.g. kubepods<500Mi, separated by commas.
+// percentage values are evaluated relative to the memory limit of the cgroup.
+func ParseCgroupThresholdConfig(expr string) ([]CgroupThreshold, error) {
+	results := []CgroupThreshold{}
+	if len(expr) == 0 {
+		return results, nil
+	}
+	statements := strings.Split(expr, ",")
+	pathsFound := sets.NewString()
+	for _, statement := range statements {
+		parts := strings.Split(statement, "<")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid cgroup eviction threshold syntax %v, expected <cgroupPath><<value>", statement)
+		}
+		path := strings.TrimPrefix(parts[0], "/")
+		if len(path) == 0 {
+			return nil, fmt.Errorf("cgroup eviction threshold path must not be empty: %v", statement)
+		}
+		if pathsFound.Has(path) {
+			return nil, fmt.Errorf("found duplicate cgroup eviction threshold for path %v", path)
+		}
+		pathsFound.Insert(path)
+		threshold := Threshold{
+			Signal:   cgroupSignal(path),
+			Operator: OpLessThan,
+		}
+		quantityValue := parts[1]
+		if strings.HasSuffix(quantityValue, "%") {
+			percentage, err := parsePercentage(quantityValue)
+			if err != nil {
+				return nil, err
+			}
+			if percentage <= 0 {
+				return nil, fmt.Errorf("eviction percentage threshold %v must be positive: %s", path, quantityValue)
+			}
+			threshold.Value = ThresholdValue{
+				Percentage: percentage,
+			}
+		} else {
+			quantity, err := resource.ParseQuantity(quantityValue)
+			if err != nil {
+				return nil, err
+			}
+			if quantity.Sign() < 0 || quantity.IsZero() {
+				return nil, fmt.Errorf("eviction threshold %v must be positive: %s", path, &quantity)
+			}
+			threshold.Value = ThresholdValue{
+				Quantity: &quantity,
+			}
+		}
+		results = append(results, CgroupThreshold{
+			Path:      path,
+			Threshold: threshold,
+		})
+	}
+	return results, nil
+}
+
+// ParseAdmissionPolicy parses the admission policy applied while the node reports memory pressure.
+// an empty value selects the default policy of rejecting only BestEffort pods.
+func ParseAdmissionPolicy(expr string) (AdmissionPolicy, error) {
+	switch policy := AdmissionPolicy(expr); policy {
+	case "":
+		return AdmissionPolicyBestEffort, nil
+	case AdmissionPolicyBestEffort, AdmissionPolicyBurstable, AdmissionPolicyAll:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid eviction admission policy %v, expected one of %v, %v, %v", expr, AdmissionPolicyBestEffort, AdmissionPolicyBurstable, AdmissionPolicyAll)
+	}
+}
+
+// podDeclaredUsage returns the effective amount of the resource requested by the pod:
+// the larger of the sum across app containers and the largest single init container
+// request, since init containers run to completion before app containers start.
+func podDeclaredUsage(pod *api.Pod, resourceName api.ResourceName) resource.Quantity {
+	request := resource.Quantity{Format: resource.BinarySI}
+	for i := range pod.Spec.Containers {
+		request.Add(pod.Spec.Containers[i].Resources.Requests[resourceName])
+	}
+	// take max_resource(sum_pod, any_init_container)
+	for i := range pod.Spec.InitContainers {
+		if initRequest := pod.Spec.InitContainers[i].Resources.Requests[resourceName]; initRequest.Cmp(request) > 0 {
+			request = *initRequest.Copy()
+		}
+	}
+	return request
+}
+
+// podMemoryRequest returns the total memory requested across the containers in the pod.
+func podMemoryRequest(pod *api.Pod) resource.Quantity {
+	return podDeclaredUsage(pod, api.ResourceMemory)
+}
+
+// fsSignal returns the signal for available bytes on the filesystem mounted at path.
+func fsSignal(path string) Signal {
+	return Signal(fsSignalPrefix + path)
+}
+
+// isFsSignal returns true if the signal observes an additional local filesystem by mount path.
+func isFsSignal(signal Signal) bool {
+	return strings.HasPrefix(string(signal), fsSignalPrefix)
+}
+
+// fsSignalMountPath returns the mount path observed by a filesystem signal.
+func fsSignalMountPath(signal Signal) string {
+	return strings.TrimPrefix(string(signal), fsSignalPrefix)
+}
+
+// customSignal returns the signal for availability of the named custom resource.
+func customSignal(resourceName api.ResourceName) Signal {
+	return Signal(customSignalPrefix + string(resourceName))
+}
+
+// isCustomSignal returns true if the signal observes a custom resource by name.
+func isCustomSignal(signal Signal) bool {
+	return strings.HasPrefix(string(signal), customSignalPrefix)
+}
+
+// customSignalResource returns the custom resource observed by the signal.
+func customSignalResource(signal Signal) api.ResourceName {
+	return api.ResourceName(strings.TrimPrefix(string(signal), customSignalPrefix))
+}
+
+// cgroupSignal returns the signal for memory available within the cgroup at path.
+func cgroupSignal(path string) Signal {
+	return Signal(cgroupSignalPrefix + path)
+}
+
+// isCgroupSignal returns true if the signal observes memory within a top-level cgroup by path.
+func isCgroupSignal(signal Signal) bool {
+	return strings.HasPrefix(string(signal), cgroupSignalPrefix)
+}
+
+// cgroupSignalPath returns the cgroup path observed by a cgroup signal.
+func cgroupSignalPath(signal Signal) string {
+	return strings.TrimPrefix(string(signal), cgroupSignalPrefix)
+}
+
+// signalResource returns the resource associated with the signal, handling signals
+// derived from custom resource names, and whether one exists.
+func signalResource(signal Signal) (api.ResourceName, bool) {
+	if resourceName, found := signalToResource[signal]; found {
+		return resourceName, true
+	}
+	if isCustomSignal(signal) {
+		return customSignalResource(signal), true
+	}
+	if isCgroupSignal(signal) {
+		// a pressured cgroup is relieved by evicting pods ranked on their memory usage.
+		return api.ResourceMemory, true
+	}
+	return "", false
+}
+
+// resourceSignal returns the signal whose observation measures availability of the resource.
+func resourceSignal(resourceName api.ResourceName) Signal {
+	if signal, found := resourceToSignal[resourceName]; found {
+		return signal
+	}
+	return customSignal(resourceName)
+}
+
 // parsePercentage parses a string representing a percentage value
 func parsePercentage(input string) (float32, error) {
 	value, err := strconv.ParseFloat(strings.TrimRight(input, "%"), 32)
@@ -233,14 +530,14 @@ func parseGracePeriods(expr string) (map[Signal]time.Duration, error) {
 		if len(parts) != 2 {
 			return nil, fmt.Errorf("invalid eviction grace period syntax %v, expected <signal>=<duration>", statement)
 		}
-		signal := Signal(parts[0])
+		signal := canonicalSignal(Signal(parts[0]))
 		if !validSignal(signal) {
 			return nil, fmt.Errorf(unsupportedEvictionSignal, signal)
 		}
 
 		gracePeriod, err := time.ParseDuration(parts[1])
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid eviction grace period %v for signal %v: %v", parts[1], signal, err)
 		}
 		if gracePeriod < 0 {
 			return nil, fmt.Errorf("invalid eviction grace period specified: %v, must be a positive value", parts[1])
@@ -267,7 +564,7 @@ func parseMinimumReclaims(expr string) (map[Signal]ThresholdValue, error) {
 		if len(parts) != 2 {
 			return nil, fmt.Errorf("invalid eviction minimum reclaim syntax: %v, expected <signal>=<value>", statement)
 		}
-		signal := Signal(parts[0])
+		signal := canonicalSignal(Signal(parts[0]))
 		if !validSignal(signal) {
 			return nil, fmt.Errorf(unsupportedEvictionSignal, signal)
 		}
@@ -295,12 +592,12 @@ func parseMinimumReclaims(expr string) (map[Signal]ThresholdValue, error) {
 			return nil, fmt.Errorf("duplicate eviction minimum reclaim specified for %v", signal)
 		}
 		quantity, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid eviction minimum reclaim value %v for signal %v: %v", parts[1], signal, err)
+		}
 		if quantity.Sign() < 0 {
 			return nil, fmt.Errorf("negative eviction minimum reclaim specified for %v", signal)
 		}
-		if err != nil {
-			return nil, err
-		}
 		results[signal] = ThresholdValue{
 			Quantity: &quantity,
 		}
@@ -335,15 +632,23 @@ func memoryUsage(memStats *statsapi.MemoryStats) *resource.Quantity {
 	return resource.NewQuantity(usage, resource.BinarySI)
 }
 
-// localVolumeNames returns the set of volumes for the pod that are local
+// isLocalVolumeSource returns true if the volume is backed by local node storage.
+// remote-backed volumes (e.g. nfs or network attached disks) do not consume the node
+// filesystem, so their usage must not count toward disk pressure ranking.
 // TODO: sumamry API should report what volumes consume local storage rather than hard-code here.
+func isLocalVolumeSource(volume api.Volume) bool {
+	return volume.HostPath != nil ||
+		(volume.EmptyDir != nil && volume.EmptyDir.Medium != api.StorageMediumMemory) ||
+		volume.ConfigMap != nil ||
+		volume.DownwardAPI != nil ||
+		volume.GitRepo != nil
+}
+
+// localVolumeNames returns the set of volumes for the pod that are local
 func localVolumeNames(pod *api.Pod) []string {
 	result := []string{}
 	for _, volume := range pod.Spec.Volumes {
-		if volume.HostPath != nil ||
-			(volume.EmptyDir != nil && volume.EmptyDir.Medium != api.StorageMediumMemory) ||
-			volume.ConfigMap != nil ||
-			volume.GitRepo != nil {
+		if isLocalVolumeSource(volume) {
 			result = append(result, volume.Name)
 		}
 	}
@@ -382,6 +687,48 @@ func podDiskUsage(podStats statsapi.PodStats, pod *api.Pod, statsToMeasure []fsS
 	}, nil
 }
 
+// podLocalStorageLimit returns the local storage limit declared on the pod via annotation, if any.
+func podLocalStorageLimit(pod *api.Pod) (*resource.Quantity, error) {
+	annotation, found := pod.Annotations[podLocalStorageLimitAnnotationKey]
+	if !found {
+		return nil, nil
+	}
+	quantity, err := resource.ParseQuantity(annotation)
+	if err != nil {
+		return nil, err
+	}
+	if quantity.Sign() <= 0 {
+		return nil, fmt.Errorf("local storage limit for pod %s must be positive: %s", format.Pod(pod), &quantity)
+	}
+	return &quantity, nil
+}
+
+// podEmptyDirSizeLimits parses the emptyDir size limits declared on the pod via annotation.
+// the annotation value has the form <volumeName>=<quantity>[,<volumeName>=<quantity>...]
+func podEmptyDirSizeLimits(pod *api.Pod) (map[string]resource.Quantity, error) {
+	annotation, found := pod.Annotations[podEmptyDirSizeLimitAnnotationKey]
+	if !found {
+		return nil, nil
+	}
+	results := map[string]resource.Quantity{}
+	statements := strings.Split(annotation, ",")
+	for _, statement := range statements {
+		parts := strings.Split(statement, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid emptyDir size limit syntax: %v, expected <volumeName>=<quantity>", statement)
+		}
+		quantity, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if quantity.Sign() <= 0 {
+			return nil, fmt.Errorf("emptyDir size limit for volume %s must be positive: %s", parts[0], &quantity)
+		}
+		results[parts[0]] = quantity
+	}
+	return results, nil
+}
+
 // podMemoryUsage aggregates pod memory usage.
 func podMemoryUsage(podStats statsapi.PodStats) (api.ResourceList, error) {
 	disk := resource.Quantity{Format: resource.BinarySI}
@@ -400,6 +747,74 @@ func podMemoryUsage(podStats statsapi.PodStats) (api.ResourceList, error) {
 	}, nil
 }
 
+// podReclaimEstimate estimates how much of the starved resource evicting the pod would
+// reclaim, based on its current usage stats.  it returns nil if no estimate can be made.
+func podReclaimEstimate(pod *api.Pod, podStats statsapi.PodStats, resourceToReclaim api.ResourceName) *resource.Quantity {
+	switch resourceToReclaim {
+	case api.ResourceMemory:
+		if usage, err := podMemoryUsage(podStats); err == nil {
+			quantity := usage[api.ResourceMemory]
+			return &quantity
+		}
+	case resourceSwap:
+		if usage, err := podSwapUsage(podStats); err == nil {
+			quantity := usage[resourceSwap]
+			return &quantity
+		}
+	case resourceNodeFs, resourceImageFs:
+		if usage, err := podDiskUsage(podStats, pod, []fsStatsType{fsStatsRoot, fsStatsLogs, fsStatsLocalVolumeSource}); err == nil {
+			quantity := usage[resourceDisk]
+			return &quantity
+		}
+	default:
+		// custom resources are not measured by the summary API, so the best estimate
+		// available is the pod's declared usage.
+		if request := podDeclaredUsage(pod, resourceToReclaim); request.Sign() > 0 {
+			return &request
+		}
+	}
+	return nil
+}
+
+// podOwnerUID extracts the UID of the controller that created the pod from the
+// created-by annotation, and whether one was found.
+func podOwnerUID(pod *api.Pod) (types.UID, bool) {
+	creatorRefJson, found := pod.Annotations[api.CreatedByAnnotation]
+	if !found {
+		return types.UID(""), false
+	}
+	var sr api.SerializedReference
+	if err := json.Unmarshal([]byte(creatorRefJson), &sr); err != nil {
+		return types.UID(""), false
+	}
+	if len(sr.Reference.UID) == 0 {
+		return types.UID(""), false
+	}
+	return sr.Reference.UID, true
+}
+
+// evictionMessage produces a detailed message about why the pod was evicted, including the
+// triggering signal, the observed value relative to its threshold, and the pod's measured
+// usage of the starved resource, so post-mortems do not require kubelet logs.
+func evictionMessage(resourceToReclaim api.ResourceName, pod *api.Pod, stats statsFunc, thresholds []Threshold, observations signalObservations) string {
+	result := fmt.Sprintf(message, resourceToReclaim)
+	signal := resourceSignal(resourceToReclaim)
+	if observed, found := observations[signal]; found {
+		for _, threshold := range thresholds {
+			if threshold.Signal != signal {
+				continue
+			}
+			result += fmt.Sprintf(" Triggered by %s, observed %s available.", formatThreshold(threshold), observed.available.String())
+		}
+	}
+	if podStats, found := stats(pod); found {
+		if usage := podReclaimEstimate(pod, podStats, resourceToReclaim); usage != nil {
+			result += fmt.Sprintf(" Pod usage of %s: %s.", resourceToReclaim, usage.String())
+		}
+	}
+	return result
+}
+
 // formatThreshold formats a threshold for logging.
 func formatThreshold(threshold Threshold) string {
 	return fmt.Sprintf("threshold(signal=%v, operator=%v, value=%v, gracePeriod=%v)", threshold.Signal, formatThresholdValue(threshold.Value), threshold.Operator, threshold.GracePeriod)
@@ -506,6 +921,166 @@ func qosComparator(p1, p2 *api.Pod) int {
 	return 1
 }
 
+// podPriority returns the priority declared on the pod via annotation.  pods with no declared
+// priority share the default priority of zero.  higher values indicate a more important pod.
+func podPriority(pod *api.Pod) int64 {
+	annotation, found := pod.Annotations[podPriorityAnnotationKey]
+	if !found {
+		return 0
+	}
+	priority, err := strconv.ParseInt(annotation, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+// priority compares pods by their declared priority; lower-priority pods are evicted first.
+func priority(p1, p2 *api.Pod) int {
+	priority1 := podPriority(p1)
+	priority2 := podPriority(p2)
+	if priority1 == priority2 {
+		return 0
+	}
+	if priority1 < priority2 {
+		return -1
+	}
+	return 1
+}
+
+// podEvictionCost returns the eviction cost declared on the pod via annotation.  pods with
+// no declared cost, or a cost that fails to parse, share the default cost of zero.
+func podEvictionCost(pod *api.Pod) int64 {
+	annotation, found := pod.Annotations[podEvictionCostAnnotationKey]
+	if !found {
+		return 0
+	}
+	cost, err := strconv.ParseInt(annotation, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return cost
+}
+
+// evictionCost compares pods by their declared eviction cost; cheaper pods are evicted first.
+func evictionCost(p1, p2 *api.Pod) int {
+	cost1 := podEvictionCost(p1)
+	cost2 := podEvictionCost(p2)
+	if cost1 == cost2 {
+		return 0
+	}
+	if cost1 < cost2 {
+		return -1
+	}
+	return 1
+}
+
+// isCriticalPod returns true if the pod is critical to the node (e.g. DNS or networking
+// addons).  only pods in the kube-system namespace may be marked critical, either via
+// the critical-pod annotation or by declaring a priority at or above criticalPodPriority,
+// so that arbitrary workloads cannot exempt themselves from eviction.
+func isCriticalPod(pod *api.Pod) bool {
+	if pod.Namespace != api.NamespaceSystem {
+		return false
+	}
+	if _, found := pod.Annotations[criticalPodAnnotationKey]; found {
+		return true
+	}
+	return podPriority(pod) >= criticalPodPriority
+}
+
+// critical compares pods by whether they are critical to the node.  critical pods are
+// ranked after all other pods, so they are only selected for eviction as a last resort.
+func critical(p1, p2 *api.Pod) int {
+	critical1 := isCriticalPod(p1)
+	critical2 := isCriticalPod(p2)
+	if critical1 == critical2 {
+		return 0
+	}
+	if critical1 {
+		return 1
+	}
+	return -1
+}
+
+// podProtectedFromEviction returns true if the pod has opted out of early eviction via annotation.
+func podProtectedFromEviction(pod *api.Pod) bool {
+	return pod.Annotations[podSafeToEvictAnnotationKey] == "false"
+}
+
+// demoteProtectedPods moves pods protected from eviction to the end of the ranked list,
+// preserving the relative order produced by the ranking functions.
+func demoteProtectedPods(pods []*api.Pod) []*api.Pod {
+	unprotected := []*api.Pod{}
+	protected := []*api.Pod{}
+	for _, pod := range pods {
+		if podProtectedFromEviction(pod) {
+			protected = append(protected, pod)
+			continue
+		}
+		unprotected = append(unprotected, pod)
+	}
+	return append(unprotected, protected...)
+}
+
+// podSwapUsage aggregates pod swap usage.
+func podSwapUsage(podStats statsapi.PodStats) (api.ResourceList, error) {
+	swap := resource.Quantity{Format: resource.BinarySI}
+	for _, container := range podStats.Containers {
+		if container.Memory != nil && container.Memory.SwapUsageBytes != nil {
+			swap.Add(*resource.NewQuantity(int64(*container.Memory.SwapUsageBytes), resource.BinarySI))
+		}
+	}
+	return api.ResourceList{
+		resourceSwap: swap,
+	}, nil
+}
+
+// softEvictionGracePeriod negotiates the grace period to use when softly evicting the pod.
+// the pod's declared terminationGracePeriodSeconds is honored up to the configured maximum,
+// or fully if honorPodGracePeriod is set.
+func softEvictionGracePeriod(pod *api.Pod, maxPodGracePeriodSeconds int64, honorPodGracePeriod bool) int64 {
+	if pod.Spec.TerminationGracePeriodSeconds == nil {
+		return maxPodGracePeriodSeconds
+	}
+	podGracePeriod := *pod.Spec.TerminationGracePeriodSeconds
+	if honorPodGracePeriod || podGracePeriod < maxPodGracePeriodSeconds {
+		return podGracePeriod
+	}
+	return maxPodGracePeriodSeconds
+}
+
+// isStaticPod returns true if the pod did not originate from the apiserver, i.e. it is a
+// static pod the kubelet restarts from its local manifest after an eviction.
+func isStaticPod(pod *api.Pod) bool {
+	source, err := kubetypes.GetPodSource(pod)
+	return err == nil && source != kubetypes.ApiserverSource
+}
+
+// filterOutStaticPods returns the pods that are not static pods.
+func filterOutStaticPods(pods []*api.Pod) []*api.Pod {
+	results := []*api.Pod{}
+	for _, pod := range pods {
+		if !isStaticPod(pod) {
+			results = append(results, pod)
+		}
+	}
+	return results
+}
+
+// filterTerminatingPods splits the input into pods not yet terminating and pods that
+// already have a deletion timestamp.
+func filterTerminatingPods(pods []*api.Pod) (active []*api.Pod, terminating []*api.Pod) {
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			terminating = append(terminating, pod)
+			continue
+		}
+		active = append(active, pod)
+	}
+	return active, terminating
+}
+
 // memory compares pods by largest consumer of memory relative to request.
 func memory(stats statsFunc) cmpFunc {
 	return func(p1, p2 *api.Pod) int {
@@ -546,6 +1121,48 @@ func memory(stats statsFunc) cmpFunc {
 	}
 }
 
+// swap compares pods by largest consumer of swap.
+func swap(stats statsFunc) cmpFunc {
+	return func(p1, p2 *api.Pod) int {
+		p1Stats, found := stats(p1)
+		// if we have no usage stats for p1, we want p2 first
+		if !found {
+			return -1
+		}
+		// if we have no usage stats for p2, but p1 has usage, we want p1 first.
+		p2Stats, found := stats(p2)
+		if !found {
+			return 1
+		}
+		// if we cant get usage for p1 measured, we want p2 first
+		p1Usage, err := podSwapUsage(p1Stats)
+		if err != nil {
+			return -1
+		}
+		// if we cant get usage for p2 measured, we want p1 first
+		p2Usage, err := podSwapUsage(p2Stats)
+		if err != nil {
+			return 1
+		}
+
+		// swap is best effort, so we don't measure relative to a request.
+		p1Swap := p1Usage[resourceSwap]
+		p2Swap := p2Usage[resourceSwap]
+		// if p2 is using more than p1, we want p2 first
+		return p2Swap.Cmp(p1Swap)
+	}
+}
+
+// declaredUsage compares pods by their declared usage of the specified resource.
+func declaredUsage(resourceName api.ResourceName) cmpFunc {
+	return func(p1, p2 *api.Pod) int {
+		p1Request := podDeclaredUsage(p1, resourceName)
+		p2Request := podDeclaredUsage(p2, resourceName)
+		// if p2 requests more than p1, we want p2 first
+		return p2Request.Cmp(p1Request)
+	}
+}
+
 // disk compares pods by largest consumer of disk relative to request for the specified disk resource.
 func disk(stats statsFunc, fsStatsToMeasure []fsStatsType, diskResource api.ResourceName) cmpFunc {
 	return func(p1, p2 *api.Pod) int {
@@ -581,13 +1198,34 @@ func disk(stats statsFunc, fsStatsToMeasure []fsStatsType, diskResource api.Reso
 
 // rankMemoryPressure orders the input pods for eviction in response to memory pressure.
 func rankMemoryPressure(pods []*api.Pod, stats statsFunc) {
-	orderedBy(qosComparator, memory(stats)).Sort(pods)
+	orderedBy(critical, qosComparator, priority, evictionCost, memory(stats)).Sort(pods)
+}
+
+// rankPIDPressure orders the input pods for eviction in response to PID pressure.
+// TODO: the summary API does not report per-pod process counts, so until it does
+// the best we can do is evict in QoS order.
+func rankPIDPressure(pods []*api.Pod, stats statsFunc) {
+	orderedBy(critical, qosComparator, priority, evictionCost).Sort(pods)
+}
+
+// rankSwapPressure orders the input pods for eviction in response to swap pressure.
+func rankSwapPressure(pods []*api.Pod, stats statsFunc) {
+	orderedBy(critical, qosComparator, priority, evictionCost, swap(stats)).Sort(pods)
+}
+
+// rankCustomResourcePressure returns a rankFunc that orders pods by their declared usage of the
+// specified custom resource.  the summary API carries no usage stats for custom resources, so
+// declared usage is the best signal available.
+func rankCustomResourcePressure(resourceName api.ResourceName) rankFunc {
+	return func(pods []*api.Pod, stats statsFunc) {
+		orderedBy(critical, qosComparator, priority, evictionCost, declaredUsage(resourceName)).Sort(pods)
+	}
 }
 
 // rankDiskPressureFunc returns a rankFunc that measures the specified fs stats.
 func rankDiskPressureFunc(fsStatsToMeasure []fsStatsType, diskResource api.ResourceName) rankFunc {
 	return func(pods []*api.Pod, stats statsFunc) {
-		orderedBy(qosComparator, disk(stats, fsStatsToMeasure, diskResource)).Sort(pods)
+		orderedBy(critical, qosComparator, priority, evictionCost, disk(stats, fsStatsToMeasure, diskResource)).Sort(pods)
 	}
 }
 
@@ -603,7 +1241,7 @@ func (a byEvictionPriority) Less(i, j int) bool {
 }
 
 // makeSignalObservations derives observations using the specified summary provider.
-func makeSignalObservations(summaryProvider stats.SummaryProvider) (signalObservations, statsFunc, error) {
+func makeSignalObservations(summaryProvider stats.SummaryProvider, inactiveFileReclaimablePercent int64) (signalObservations, statsFunc, error) {
 	summary, err := summaryProvider.Get()
 	if err != nil {
 		return nil, nil, err
@@ -615,8 +1253,19 @@ func makeSignalObservations(summaryProvider stats.SummaryProvider) (signalObserv
 	result := signalObservations{}
 
 	if memory := summary.Node.Memory; memory != nil && memory.AvailableBytes != nil && memory.WorkingSetBytes != nil {
+		// memory.available is derived as capacity - workingSet, where workingSet is usage less
+		// inactive_file (i.e. the kernel treats inactive page cache as fully reclaimable).  when
+		// inactiveFileReclaimablePercent is below 100, only that percentage of inactive_file is
+		// counted as reclaimable:
+		//   memory.available = capacity - usage + (inactive_file * inactiveFileReclaimablePercent / 100)
+		available := int64(*memory.AvailableBytes)
+		if memory.UsageBytes != nil && inactiveFileReclaimablePercent < 100 {
+			if inactiveFile := int64(*memory.UsageBytes) - int64(*memory.WorkingSetBytes); inactiveFile > 0 {
+				available -= inactiveFile - inactiveFile*inactiveFileReclaimablePercent/100
+			}
+		}
 		result[SignalMemoryAvailable] = signalObservation{
-			available: resource.NewQuantity(int64(*memory.AvailableBytes), resource.BinarySI),
+			available: resource.NewQuantity(available, resource.BinarySI),
 			capacity:  resource.NewQuantity(int64(*memory.AvailableBytes+*memory.WorkingSetBytes), resource.BinarySI),
 			time:      memory.Time,
 		}
@@ -637,6 +1286,21 @@ func makeSignalObservations(summaryProvider stats.SummaryProvider) (signalObserv
 			}
 		}
 	}
+	if memory := summary.Node.Memory; memory != nil && memory.SwapAvailableBytes != nil && memory.SwapUsageBytes != nil {
+		result[SignalSwapAvailable] = signalObservation{
+			available: resource.NewQuantity(int64(*memory.SwapAvailableBytes), resource.BinarySI),
+			capacity:  resource.NewQuantity(int64(*memory.SwapAvailableBytes+*memory.SwapUsageBytes), resource.BinarySI),
+			time:      memory.Time,
+		}
+	}
+	if rlimit := summary.Node.Rlimit; rlimit != nil && rlimit.NumOfRunningProcesses != nil && rlimit.MaxPID != nil {
+		available := int64(*rlimit.MaxPID) - int64(*rlimit.NumOfRunningProcesses)
+		result[SignalPidAvailable] = signalObservation{
+			available: resource.NewQuantity(available, resource.DecimalSI),
+			capacity:  resource.NewQuantity(int64(*rlimit.MaxPID), resource.DecimalSI),
+			time:      rlimit.Time,
+		}
+	}
 	if summary.Node.Runtime != nil {
 		if imageFs := summary.Node.Runtime.ImageFs; imageFs != nil {
 			if imageFs.AvailableBytes != nil && imageFs.CapacityBytes != nil {
@@ -658,6 +1322,22 @@ func makeSignalObservations(summaryProvider stats.SummaryProvider) (signalObserv
 	return result, statsFunc, nil
 }
 
+// observationsAge returns the age of the most recent observation in the specified set
+// relative to now.  observations with no timestamp are ignored; if no observation carries
+// a timestamp, a zero age is returned.
+func observationsAge(observations signalObservations, now time.Time) time.Duration {
+	newest := time.Time{}
+	for _, observed := range observations {
+		if !observed.time.IsZero() && observed.time.Time.After(newest) {
+			newest = observed.time.Time
+		}
+	}
+	if newest.IsZero() {
+		return time.Duration(0)
+	}
+	return now.Sub(newest)
+}
+
 // thresholdsMet returns the set of thresholds that were met independent of grace period
 func thresholdsMet(thresholds []Threshold, observations signalObservations, enforceMinReclaim bool) []Threshold {
 	results := []Threshold{}
@@ -673,12 +1353,20 @@ func thresholdsMet(thresholds []Threshold, observations signalObservations, enfo
 		quantity := getThresholdQuantity(threshold.Value, observed.capacity)
 		// if enforceMinReclaim is specified, we compare relative to value - minreclaim
 		if enforceMinReclaim && threshold.MinReclaim != nil {
-			quantity.Add(*getThresholdQuantity(*threshold.MinReclaim, observed.capacity))
+			minReclaim := getThresholdQuantity(*threshold.MinReclaim, observed.capacity)
+			switch threshold.Operator {
+			case OpLessThan:
+				quantity.Add(*minReclaim)
+			case OpGreaterThan:
+				quantity.Sub(*minReclaim)
+			}
 		}
 		thresholdResult := quantity.Cmp(*observed.available)
 		switch threshold.Operator {
 		case OpLessThan:
 			thresholdMet = thresholdResult > 0
+		case OpGreaterThan:
+			thresholdMet = thresholdResult < 0
 		}
 		if thresholdMet {
 			results = append(results, threshold)
@@ -739,11 +1427,27 @@ func thresholdsMetGracePeriod(observedAt thresholdsObservedAt, now time.Time) []
 	return results
 }
 
+// thresholdNodeCondition returns the node condition associated with the threshold, and whether one exists.
+func thresholdNodeCondition(threshold Threshold) (api.NodeConditionType, bool) {
+	if nodeCondition, found := signalToNodeCondition[threshold.Signal]; found {
+		return nodeCondition, true
+	}
+	if isFsSignal(threshold.Signal) {
+		// each additional filesystem drives its own pressure condition, named for its mount path.
+		return api.NodeConditionType(fmt.Sprintf("%s:%s", api.NodeDiskPressure, fsSignalMountPath(threshold.Signal))), true
+	}
+	if isCgroupSignal(threshold.Signal) {
+		// memory exhaustion within the scoped cgroup is pod-attributable memory pressure.
+		return api.NodeMemoryPressure, true
+	}
+	return "", false
+}
+
 // nodeConditions returns the set of node conditions associated with a threshold
 func nodeConditions(thresholds []Threshold) []api.NodeConditionType {
 	results := []api.NodeConditionType{}
 	for _, threshold := range thresholds {
-		if nodeCondition, found := signalToNodeCondition[threshold.Signal]; found {
+		if nodeCondition, found := thresholdNodeCondition(threshold); found {
 			if !hasNodeCondition(results, nodeCondition) {
 				results = append(results, nodeCondition)
 			}
@@ -752,6 +1456,30 @@ func nodeConditions(thresholds []Threshold) []api.NodeConditionType {
 	return results
 }
 
+// nodeConditionMessages builds a human-readable explanation for each triggered node condition
+// from the specific thresholds that were met and the observed values, so the node status can
+// report why the node is pressured.
+func nodeConditionMessages(thresholds []Threshold, observations signalObservations) map[api.NodeConditionType]string {
+	results := map[api.NodeConditionType]string{}
+	for _, threshold := range thresholds {
+		nodeCondition, found := thresholdNodeCondition(threshold)
+		if !found {
+			continue
+		}
+		observed, found := observations[threshold.Signal]
+		if !found {
+			continue
+		}
+		detail := fmt.Sprintf("%s has %s available, crossed %s", threshold.Signal, observed.available.String(), formatThreshold(threshold))
+		if len(results[nodeCondition]) > 0 {
+			results[nodeCondition] += "; " + detail
+		} else {
+			results[nodeCondition] = detail
+		}
+	}
+	return results
+}
+
 // nodeConditionsLastObservedAt merges the input with the previous observation to determine when a condition was most recently met.
 func nodeConditionsLastObservedAt(nodeConditions []api.NodeConditionType, lastObservedAt nodeConditionsObservedAt, now time.Time) nodeConditionsObservedAt {
 	results := nodeConditionsObservedAt{}
@@ -840,17 +1568,22 @@ func compareThresholdValue(a ThresholdValue, b ThresholdValue) bool {
 func getStarvedResources(thresholds []Threshold) []api.ResourceName {
 	results := []api.ResourceName{}
 	for _, threshold := range thresholds {
-		if starvedResource, found := signalToResource[threshold.Signal]; found {
+		if starvedResource, found := signalResource(threshold.Signal); found {
 			results = append(results, starvedResource)
 		}
 	}
 	return results
 }
 
+// isHardEvictionThreshold returns true if eviction should immediately occur
+func isHardEvictionThreshold(threshold Threshold) bool {
+	return threshold.GracePeriod == time.Duration(0)
+}
+
 // isSoftEviction returns true if the thresholds met for the starved resource are only soft thresholds
 func isSoftEviction(thresholds []Threshold, starvedResource api.ResourceName) bool {
 	for _, threshold := range thresholds {
-		if resourceToCheck := signalToResource[threshold.Signal]; resourceToCheck != starvedResource {
+		if resourceToCheck, _ := signalResource(threshold.Signal); resourceToCheck != starvedResource {
 			continue
 		}
 		if threshold.GracePeriod == time.Duration(0) {
@@ -864,6 +1597,8 @@ func isSoftEviction(thresholds []Threshold, starvedResource api.ResourceName) bo
 func buildResourceToRankFunc(withImageFs bool) map[api.ResourceName]rankFunc {
 	resourceToRankFunc := map[api.ResourceName]rankFunc{
 		api.ResourceMemory: rankMemoryPressure,
+		resourcePids:       rankPIDPressure,
+		resourceSwap:       rankSwapPressure,
 	}
 	// usage of an imagefs is optional
 	if withImageFs {
@@ -890,32 +1625,53 @@ func PodIsEvicted(podStatus api.PodStatus) bool {
 }
 
 // buildResourceToNodeReclaimFuncs returns reclaim functions associated with resources.
-func buildResourceToNodeReclaimFuncs(imageGC ImageGC, withImageFs bool) map[api.ResourceName]nodeReclaimFuncs {
+func buildResourceToNodeReclaimFuncs(imageGC ImageGC, containerGC ContainerGC, logsReclaimer LogsReclaimer, withImageFs bool) map[api.ResourceName]nodeReclaimFuncs {
 	resourceToReclaimFunc := map[api.ResourceName]nodeReclaimFuncs{}
 	// usage of an imagefs is optional
 	if withImageFs {
 		// with an imagefs, nodefs pressure should just delete logs
-		resourceToReclaimFunc[resourceNodeFs] = nodeReclaimFuncs{deleteLogs()}
-		resourceToReclaimFunc[resourceNodeFsInodes] = nodeReclaimFuncs{deleteLogs()}
-		// with an imagefs, imagefs pressure should delete unused images
-		resourceToReclaimFunc[resourceImageFs] = nodeReclaimFuncs{deleteImages(imageGC, true)}
-		resourceToReclaimFunc[resourceImageFsInodes] = nodeReclaimFuncs{deleteImages(imageGC, false)}
+		resourceToReclaimFunc[resourceNodeFs] = nodeReclaimFuncs{deleteLogs(logsReclaimer)}
+		resourceToReclaimFunc[resourceNodeFsInodes] = nodeReclaimFuncs{deleteLogs(logsReclaimer)}
+		// with an imagefs, imagefs pressure should delete unused containers and images.
+		// images are deleted by reclaim cost/benefit so that the largest and least
+		// recently used images are removed before pod eviction is considered.
+		resourceToReclaimFunc[resourceImageFs] = nodeReclaimFuncs{deleteTerminatedContainers(containerGC), deleteImagesByCostBenefit(imageGC, true)}
+		resourceToReclaimFunc[resourceImageFsInodes] = nodeReclaimFuncs{deleteTerminatedContainers(containerGC), deleteImagesByCostBenefit(imageGC, false)}
 	} else {
-		// without an imagefs, nodefs pressure should delete logs, and unused images
+		// without an imagefs, nodefs pressure should delete logs, unused containers, and unused images
 		// since imagefs and nodefs share a common device, they share common reclaim functions
-		resourceToReclaimFunc[resourceNodeFs] = nodeReclaimFuncs{deleteLogs(), deleteImages(imageGC, true)}
-		resourceToReclaimFunc[resourceNodeFsInodes] = nodeReclaimFuncs{deleteLogs(), deleteImages(imageGC, false)}
-		resourceToReclaimFunc[resourceImageFs] = nodeReclaimFuncs{deleteLogs(), deleteImages(imageGC, true)}
-		resourceToReclaimFunc[resourceImageFsInodes] = nodeReclaimFuncs{deleteLogs(), deleteImages(imageGC, false)}
+		resourceToReclaimFunc[resourceNodeFs] = nodeReclaimFuncs{deleteLogs(logsReclaimer), deleteTerminatedContainers(containerGC), deleteImages(imageGC, true)}
+		resourceToReclaimFunc[resourceNodeFsInodes] = nodeReclaimFuncs{deleteLogs(logsReclaimer), deleteTerminatedContainers(containerGC), deleteImages(imageGC, false)}
+		resourceToReclaimFunc[resourceImageFs] = nodeReclaimFuncs{deleteLogs(logsReclaimer), deleteTerminatedContainers(containerGC), deleteImages(imageGC, true)}
+		resourceToReclaimFunc[resourceImageFsInodes] = nodeReclaimFuncs{deleteLogs(logsReclaimer), deleteTerminatedContainers(containerGC), deleteImages(imageGC, false)}
 	}
 	return resourceToReclaimFunc
 }
 
-// deleteLogs will delete logs to free up disk pressure.
-func deleteLogs() nodeReclaimFunc {
+// deleteLogs will delete container logs to free up disk pressure, so pod eviction is only
+// considered when log reclaim is insufficient.
+func deleteLogs(logsReclaimer LogsReclaimer) nodeReclaimFunc {
 	return func() (*resource.Quantity, error) {
-		// TODO: not yet supported.
-		return resource.NewQuantity(int64(0), resource.BinarySI), nil
+		if logsReclaimer == nil {
+			// no log reclaimer is configured, so no logs can be reclaimed.
+			return resource.NewQuantity(int64(0), resource.BinarySI), nil
+		}
+		glog.Infof("eviction manager: attempting to reclaim container logs")
+		bytesFreed, err := logsReclaimer.ReclaimLogs()
+		if err != nil {
+			return nil, err
+		}
+		return resource.NewQuantity(bytesFreed, resource.BinarySI), nil
+	}
+}
+
+// deleteTerminatedContainers will delete terminated containers to free up disk pressure.
+func deleteTerminatedContainers(containerGC ContainerGC) nodeReclaimFunc {
+	return func() (*resource.Quantity, error) {
+		glog.Infof("eviction manager: attempting to delete unused containers")
+		err := containerGC.DeleteAllUnusedContainers()
+		// Calculating bytes freed is not yet supported.
+		return resource.NewQuantity(int64(0), resource.BinarySI), err
 	}
 }
 
@@ -934,3 +1690,19 @@ func deleteImages(imageGC ImageGC, reportBytesFreed bool) nodeReclaimFunc {
 		return resource.NewQuantity(reclaimed, resource.BinarySI), nil
 	}
 }
+
+// deleteImagesByCostBenefit will delete unused images ordered by reclaim cost/benefit to free up disk pressure.
+func deleteImagesByCostBenefit(imageGC ImageGC, reportBytesFreed bool) nodeReclaimFunc {
+	return func() (*resource.Quantity, error) {
+		glog.Infof("eviction manager: attempting to delete unused images by cost/benefit")
+		bytesFreed, err := imageGC.DeleteImagesByCostBenefit()
+		if err != nil {
+			return nil, err
+		}
+		reclaimed := int64(0)
+		if reportBytesFreed {
+			reclaimed = bytesFreed
+		}
+		return resource.NewQuantity(reclaimed, resource.BinarySI), nil
+	}
+}
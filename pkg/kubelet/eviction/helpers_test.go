@@ -19,6 +19,7 @@ package eviction
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,13 +38,15 @@ func quantityMustParse(value string) *resource.Quantity {
 
 func TestParseThresholdConfig(t *testing.T) {
 	gracePeriod, _ := time.ParseDuration("30s")
+	notifyPeriod, _ := time.ParseDuration("10s")
 	testCases := map[string]struct {
-		evictionHard            string
-		evictionSoft            string
-		evictionSoftGracePeriod string
-		evictionMinReclaim      string
-		expectErr               bool
-		expectThresholds        []Threshold
+		evictionHard             string
+		evictionSoft             string
+		evictionSoftGracePeriod  string
+		evictionMinReclaim       string
+		evictionSoftNotifyPeriod string
+		expectErr                bool
+		expectThresholds         []Threshold
 	}{
 		"no values": {
 			evictionHard:            "",
@@ -53,12 +56,32 @@ func TestParseThresholdConfig(t *testing.T) {
 			expectErr:               false,
 			expectThresholds:        []Threshold{},
 		},
-		"all flag values": {
-			evictionHard:            "memory.available<150Mi",
-			evictionSoft:            "memory.available<300Mi",
-			evictionSoftGracePeriod: "memory.available=30s",
+		"greater-than operator": {
+			evictionHard:            "memory.available>90%",
+			evictionSoft:            "",
+			evictionSoftGracePeriod: "",
 			evictionMinReclaim:      "memory.available=0",
 			expectErr:               false,
+			expectThresholds: []Threshold{
+				{
+					Signal:   SignalMemoryAvailable,
+					Operator: OpGreaterThan,
+					Value: ThresholdValue{
+						Percentage: 0.9,
+					},
+					MinReclaim: &ThresholdValue{
+						Quantity: quantityMustParse("0"),
+					},
+				},
+			},
+		},
+		"all flag values": {
+			evictionHard:             "memory.available<150Mi",
+			evictionSoft:             "memory.available<300Mi",
+			evictionSoftGracePeriod:  "memory.available=30s",
+			evictionMinReclaim:       "memory.available=0",
+			evictionSoftNotifyPeriod: "memory.available=10s",
+			expectErr:                false,
 			expectThresholds: []Threshold{
 				{
 					Signal:   SignalMemoryAvailable,
@@ -76,7 +99,8 @@ func TestParseThresholdConfig(t *testing.T) {
 					Value: ThresholdValue{
 						Quantity: quantityMustParse("300Mi"),
 					},
-					GracePeriod: gracePeriod,
+					GracePeriod:  gracePeriod,
+					NotifyPeriod: notifyPeriod,
 					MinReclaim: &ThresholdValue{
 						Quantity: quantityMustParse("0"),
 					},
@@ -290,6 +314,14 @@ func TestParseThresholdConfig(t *testing.T) {
 			expectErr:               true,
 			expectThresholds:        []Threshold{},
 		},
+		"hard-signal-percentage-greater-than-100%": {
+			evictionHard:            "memory.available<150%",
+			evictionSoft:            "",
+			evictionSoftGracePeriod: "",
+			evictionMinReclaim:      "",
+			expectErr:               true,
+			expectThresholds:        []Threshold{},
+		},
 		"soft-signal-negative": {
 			evictionHard:            "",
 			evictionSoft:            "memory.available<-150Mi",
@@ -346,9 +378,45 @@ func TestParseThresholdConfig(t *testing.T) {
 			expectErr:               true,
 			expectThresholds:        []Threshold{},
 		},
+		"signal aliases": {
+			evictionHard:            "memory<150Mi",
+			evictionSoft:            "nodefs<1Gi",
+			evictionSoftGracePeriod: "nodefs=30s",
+			evictionMinReclaim:      "memory=0",
+			expectErr:               false,
+			expectThresholds: []Threshold{
+				{
+					Signal:   SignalMemoryAvailable,
+					Operator: OpLessThan,
+					Value: ThresholdValue{
+						Quantity: quantityMustParse("150Mi"),
+					},
+					MinReclaim: &ThresholdValue{
+						Quantity: quantityMustParse("0"),
+					},
+				},
+				{
+					Signal:   SignalNodeFsAvailable,
+					Operator: OpLessThan,
+					Value: ThresholdValue{
+						Quantity: quantityMustParse("1Gi"),
+					},
+					GracePeriod: gracePeriod,
+				},
+			},
+		},
+		"notify-period-exceeds-grace-period": {
+			evictionHard:             "",
+			evictionSoft:             "memory.available<150Mi",
+			evictionSoftGracePeriod:  "memory.available=30s",
+			evictionMinReclaim:       "",
+			evictionSoftNotifyPeriod: "memory.available=1m",
+			expectErr:                true,
+			expectThresholds:         []Threshold{},
+		},
 	}
 	for testName, testCase := range testCases {
-		thresholds, err := ParseThresholdConfig(testCase.evictionHard, testCase.evictionSoft, testCase.evictionSoftGracePeriod, testCase.evictionMinReclaim)
+		thresholds, err := ParseThresholdConfig(testCase.evictionHard, testCase.evictionSoft, testCase.evictionSoftGracePeriod, testCase.evictionMinReclaim, testCase.evictionSoftNotifyPeriod)
 		if testCase.expectErr != (err != nil) {
 			t.Errorf("Err not as expected, test: %v, error expected: %v, actual: %v", testName, testCase.expectErr, err)
 		}
@@ -388,10 +456,15 @@ func thresholdsEqual(expected []Threshold, actual []Threshold) bool {
 }
 
 func thresholdEqual(a Threshold, b Threshold) bool {
+	minReclaimEqual := a.MinReclaim == b.MinReclaim
+	if a.MinReclaim != nil && b.MinReclaim != nil {
+		minReclaimEqual = compareThresholdValue(*a.MinReclaim, *b.MinReclaim)
+	}
 	return a.GracePeriod == b.GracePeriod &&
+		a.NotifyPeriod == b.NotifyPeriod &&
 		a.Operator == b.Operator &&
 		a.Signal == b.Signal &&
-		compareThresholdValue(*a.MinReclaim, *b.MinReclaim) &&
+		minReclaimEqual &&
 		compareThresholdValue(a.Value, b.Value)
 }
 
@@ -418,6 +491,228 @@ func TestOrderedByQoS(t *testing.T) {
 	}
 }
 
+// TestOrderedByPriority ensures lower-priority pods order before higher-priority pods.
+func TestOrderedByPriority(t *testing.T) {
+	defaultPriority := newPod("default-priority", []api.Container{
+		newContainer("default-priority", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	lowPriority := newPod("low-priority", []api.Container{
+		newContainer("low-priority", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	lowPriority.Annotations = map[string]string{podPriorityAnnotationKey: "-10"}
+	highPriority := newPod("high-priority", []api.Container{
+		newContainer("high-priority", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	highPriority.Annotations = map[string]string{podPriorityAnnotationKey: "10"}
+
+	pods := []*api.Pod{highPriority, defaultPriority, lowPriority}
+	orderedBy(priority).Sort(pods)
+
+	expected := []*api.Pod{lowPriority, defaultPriority, highPriority}
+	for i := range expected {
+		if pods[i] != expected[i] {
+			t.Errorf("Expected pod: %s, but got: %s", expected[i].Name, pods[i].Name)
+		}
+	}
+}
+
+// TestOrderedByEvictionCost ensures cheaper pods order before more expensive pods, and that
+// pods with a missing or malformed annotation share the default cost of zero.
+func TestOrderedByEvictionCost(t *testing.T) {
+	defaultCost := newPod("default-cost", []api.Container{
+		newContainer("default-cost", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	malformedCost := newPod("malformed-cost", []api.Container{
+		newContainer("malformed-cost", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	malformedCost.Annotations = map[string]string{podEvictionCostAnnotationKey: "cheap"}
+	malformedCost.Annotations[podPriorityAnnotationKey] = "10"
+	lowCost := newPod("low-cost", []api.Container{
+		newContainer("low-cost", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	lowCost.Annotations = map[string]string{podEvictionCostAnnotationKey: "-100"}
+	highCost := newPod("high-cost", []api.Container{
+		newContainer("high-cost", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	highCost.Annotations = map[string]string{podEvictionCostAnnotationKey: "100"}
+
+	pods := []*api.Pod{highCost, defaultCost, malformedCost, lowCost}
+	orderedBy(evictionCost, priority).Sort(pods)
+
+	expected := []*api.Pod{lowCost, defaultCost, malformedCost, highCost}
+	for i := range expected {
+		if pods[i] != expected[i] {
+			t.Errorf("Expected pod: %s, but got: %s", expected[i].Name, pods[i].Name)
+		}
+	}
+}
+
+// TestIsCriticalPod ensures only kube-system pods marked via annotation or priority are critical.
+func TestIsCriticalPod(t *testing.T) {
+	systemCritical := newPod("system-critical", []api.Container{
+		newContainer("system-critical", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	systemCritical.Namespace = api.NamespaceSystem
+	systemCritical.Annotations = map[string]string{criticalPodAnnotationKey: ""}
+	systemHighPriority := newPod("system-high-priority", []api.Container{
+		newContainer("system-high-priority", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	systemHighPriority.Namespace = api.NamespaceSystem
+	systemHighPriority.Annotations = map[string]string{podPriorityAnnotationKey: "2000000000"}
+	systemRegular := newPod("system-regular", []api.Container{
+		newContainer("system-regular", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	systemRegular.Namespace = api.NamespaceSystem
+	userCritical := newPod("user-critical", []api.Container{
+		newContainer("user-critical", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	userCritical.Annotations = map[string]string{criticalPodAnnotationKey: ""}
+
+	testCases := map[string]struct {
+		pod      *api.Pod
+		critical bool
+	}{
+		"system pod with critical annotation": {pod: systemCritical, critical: true},
+		"system pod with critical priority":   {pod: systemHighPriority, critical: true},
+		"system pod without markings":         {pod: systemRegular, critical: false},
+		"user pod with critical annotation":   {pod: userCritical, critical: false},
+	}
+	for testName, testCase := range testCases {
+		if actual := isCriticalPod(testCase.pod); actual != testCase.critical {
+			t.Errorf("%s: expected critical: %v, but got: %v", testName, testCase.critical, actual)
+		}
+	}
+}
+
+// TestOrderedByCritical ensures critical pods are ranked after all other pods.
+func TestOrderedByCritical(t *testing.T) {
+	criticalPod := newPod("critical", []api.Container{
+		newContainer("critical", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	criticalPod.Namespace = api.NamespaceSystem
+	criticalPod.Annotations = map[string]string{criticalPodAnnotationKey: ""}
+	regularPod := newPod("regular", []api.Container{
+		newContainer("regular", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+
+	pods := []*api.Pod{criticalPod, regularPod}
+	orderedBy(critical).Sort(pods)
+
+	expected := []*api.Pod{regularPod, criticalPod}
+	for i := range expected {
+		if pods[i] != expected[i] {
+			t.Errorf("Expected pod: %s, but got: %s", expected[i].Name, pods[i].Name)
+		}
+	}
+}
+
+// TestEvictionMessage verifies the eviction message carries the triggering signal,
+// the observed value, and the pod's measured usage of the starved resource.
+func TestEvictionMessage(t *testing.T) {
+	pod := newPod("consumer", []api.Container{
+		newContainer("consumer", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	podStats := newPodMemoryStats(pod, resource.MustParse("700Mi"))
+	stats := func(p *api.Pod) (statsapi.PodStats, bool) {
+		return podStats, true
+	}
+	thresholds := []Threshold{
+		{
+			Signal:   SignalMemoryAvailable,
+			Operator: OpLessThan,
+			Value: ThresholdValue{
+				Quantity: quantityMustParse("1Gi"),
+			},
+		},
+	}
+	observations := signalObservations{
+		SignalMemoryAvailable: signalObservation{
+			available: quantityMustParse("500Mi"),
+			capacity:  quantityMustParse("2Gi"),
+		},
+	}
+	msg := evictionMessage(api.ResourceMemory, pod, stats, thresholds, observations)
+	for _, expected := range []string{string(SignalMemoryAvailable), "500Mi", "700Mi"} {
+		if !strings.Contains(msg, expected) {
+			t.Errorf("Expected message to contain %q, but got: %s", expected, msg)
+		}
+	}
+}
+
+// TestLocalVolumeNames ensures only local-backed volumes count toward disk pressure ranking.
+func TestLocalVolumeNames(t *testing.T) {
+	pod := newPod("pod-with-volumes", []api.Container{
+		newContainer("pod-with-volumes", newResourceList("", ""), newResourceList("", "")),
+	}, []api.Volume{
+		{Name: "scratch", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+		{Name: "tmpfs", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}}},
+		{Name: "host", VolumeSource: api.VolumeSource{HostPath: &api.HostPathVolumeSource{Path: "/data"}}},
+		{Name: "podinfo", VolumeSource: api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{}}},
+		{Name: "remote-nfs", VolumeSource: api.VolumeSource{NFS: &api.NFSVolumeSource{Server: "server", Path: "/export"}}},
+		{Name: "remote-ebs", VolumeSource: api.VolumeSource{AWSElasticBlockStore: &api.AWSElasticBlockStoreVolumeSource{VolumeID: "vol"}}},
+	})
+	expected := []string{"scratch", "host", "podinfo"}
+	actual := localVolumeNames(pod)
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected local volumes: %v, but got: %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("Expected local volume: %s, but got: %s", expected[i], actual[i])
+		}
+	}
+}
+
+// TestDemoteProtectedPods ensures pods that opted out of eviction are moved to the end
+// of the ranked list while the relative order of the remaining pods is preserved.
+func TestDemoteProtectedPods(t *testing.T) {
+	first := newPod("first", []api.Container{
+		newContainer("first", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	protected := newPod("protected", []api.Container{
+		newContainer("protected", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	protected.Annotations = map[string]string{podSafeToEvictAnnotationKey: "false"}
+	last := newPod("last", []api.Container{
+		newContainer("last", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+
+	pods := demoteProtectedPods([]*api.Pod{first, protected, last})
+
+	expected := []*api.Pod{first, last, protected}
+	for i := range expected {
+		if pods[i] != expected[i] {
+			t.Errorf("Expected pod: %s, but got: %s", expected[i].Name, pods[i].Name)
+		}
+	}
+}
+
+// TestOrderedByQoSPriority ensures QoS dominates ranking, and priority breaks ties within a QoS class.
+func TestOrderedByQoSPriority(t *testing.T) {
+	bestEffortHigh := newPod("best-effort-high", []api.Container{
+		newContainer("best-effort-high", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	bestEffortHigh.Annotations = map[string]string{podPriorityAnnotationKey: "100"}
+	bestEffortLow := newPod("best-effort-low", []api.Container{
+		newContainer("best-effort-low", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	bestEffortLow.Annotations = map[string]string{podPriorityAnnotationKey: "-100"}
+	guaranteedLow := newPod("guaranteed-low", []api.Container{
+		newContainer("guaranteed-low", newResourceList("200m", "200Mi"), newResourceList("200m", "200Mi")),
+	}, nil)
+	guaranteedLow.Annotations = map[string]string{podPriorityAnnotationKey: "-100"}
+
+	pods := []*api.Pod{guaranteedLow, bestEffortHigh, bestEffortLow}
+	orderedBy(qosComparator, priority).Sort(pods)
+
+	expected := []*api.Pod{bestEffortLow, bestEffortHigh, guaranteedLow}
+	for i := range expected {
+		if pods[i] != expected[i] {
+			t.Errorf("Expected pod: %s, but got: %s", expected[i].Name, pods[i].Name)
+		}
+	}
+}
+
 func TestOrderedbyDisk(t *testing.T) {
 	testOrderedByResource(t, resourceDisk, newPodDiskStats)
 }
@@ -569,6 +864,41 @@ func testOrderedByQoSResource(t *testing.T, orderedByResource api.ResourceName,
 }
 
 // TestOrderedByMemory ensures we order pods by greediest memory consumer relative to request.
+// TestPodDeclaredUsageInitContainers ensures effective pod requests take the max of the
+// app container sum and any single init container, so pods still running large init
+// containers are not mis-ranked.
+func TestPodDeclaredUsageInitContainers(t *testing.T) {
+	appOnly := newPod("app-only", []api.Container{
+		newContainer("app-a", newResourceList("100m", "100Mi"), newResourceList("", "")),
+		newContainer("app-b", newResourceList("100m", "200Mi"), newResourceList("", "")),
+	}, nil)
+	bigInit := newPod("big-init", []api.Container{
+		newContainer("app", newResourceList("100m", "100Mi"), newResourceList("", "")),
+	}, nil)
+	bigInit.Spec.InitContainers = []api.Container{
+		newContainer("init", newResourceList("100m", "1Gi"), newResourceList("", "")),
+	}
+	smallInit := newPod("small-init", []api.Container{
+		newContainer("app", newResourceList("100m", "500Mi"), newResourceList("", "")),
+	}, nil)
+	smallInit.Spec.InitContainers = []api.Container{
+		newContainer("init", newResourceList("100m", "100Mi"), newResourceList("", "")),
+	}
+	testCases := map[string]struct {
+		pod      *api.Pod
+		expected resource.Quantity
+	}{
+		"sum of app containers":           {pod: appOnly, expected: resource.MustParse("300Mi")},
+		"init container exceeds app sum":  {pod: bigInit, expected: resource.MustParse("1Gi")},
+		"app sum exceeds init containers": {pod: smallInit, expected: resource.MustParse("500Mi")},
+	}
+	for testName, testCase := range testCases {
+		if actual := podDeclaredUsage(testCase.pod, api.ResourceMemory); actual.Cmp(testCase.expected) != 0 {
+			t.Errorf("%s: expected %v, actual: %v", testName, testCase.expected.String(), actual.String())
+		}
+	}
+}
+
 func TestOrderedByMemory(t *testing.T) {
 	pod1 := newPod("best-effort-high", []api.Container{
 		newContainer("best-effort-high", newResourceList("", ""), newResourceList("", "")),
@@ -610,6 +940,258 @@ func TestOrderedByMemory(t *testing.T) {
 	}
 }
 
+func TestParseFilesystemThresholdConfig(t *testing.T) {
+	testCases := map[string]struct {
+		expr       string
+		expectErr  bool
+		expectedFs []FilesystemThreshold
+	}{
+		"empty": {
+			expr:       "",
+			expectedFs: []FilesystemThreshold{},
+		},
+		"quantity": {
+			expr: "/var/log<1Gi",
+			expectedFs: []FilesystemThreshold{
+				{
+					Path: "/var/log",
+					Threshold: Threshold{
+						Signal:   fsSignal("/var/log"),
+						Operator: OpLessThan,
+						Value: ThresholdValue{
+							Quantity: quantityMustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+		"percentage": {
+			expr: "/mnt/disk1<10%",
+			expectedFs: []FilesystemThreshold{
+				{
+					Path: "/mnt/disk1",
+					Threshold: Threshold{
+						Signal:   fsSignal("/mnt/disk1"),
+						Operator: OpLessThan,
+						Value: ThresholdValue{
+							Percentage: 0.1,
+						},
+					},
+				},
+			},
+		},
+		"relative-path": {
+			expr:      "var/log<1Gi",
+			expectErr: true,
+		},
+		"duplicate-path": {
+			expr:      "/var/log<1Gi,/var/log<2Gi",
+			expectErr: true,
+		},
+		"invalid-quantity": {
+			expr:      "/var/log<foo",
+			expectErr: true,
+		},
+		"missing-operator": {
+			expr:      "/var/log",
+			expectErr: true,
+		},
+	}
+	for testName, testCase := range testCases {
+		actual, err := ParseFilesystemThresholdConfig(testCase.expr)
+		if testCase.expectErr {
+			if err == nil {
+				t.Errorf("Test case: %s, expected error but got none", testName)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test case: %s, unexpected error: %v", testName, err)
+			continue
+		}
+		if len(actual) != len(testCase.expectedFs) {
+			t.Errorf("Test case: %s, expected %d thresholds, got %d", testName, len(testCase.expectedFs), len(actual))
+			continue
+		}
+		for i := range actual {
+			if actual[i].Path != testCase.expectedFs[i].Path {
+				t.Errorf("Test case: %s, expected path %v, got %v", testName, testCase.expectedFs[i].Path, actual[i].Path)
+			}
+			if actual[i].Threshold.Signal != testCase.expectedFs[i].Threshold.Signal {
+				t.Errorf("Test case: %s, expected signal %v, got %v", testName, testCase.expectedFs[i].Threshold.Signal, actual[i].Threshold.Signal)
+			}
+			if actual[i].Threshold.Operator != testCase.expectedFs[i].Threshold.Operator {
+				t.Errorf("Test case: %s, expected operator %v, got %v", testName, testCase.expectedFs[i].Threshold.Operator, actual[i].Threshold.Operator)
+			}
+			if !compareThresholdValue(actual[i].Threshold.Value, testCase.expectedFs[i].Threshold.Value) {
+				t.Errorf("Test case: %s, expected value %v, got %v", testName, testCase.expectedFs[i].Threshold.Value, actual[i].Threshold.Value)
+			}
+		}
+	}
+}
+
+func TestParseCgroupThresholdConfig(t *testing.T) {
+	testCases := map[string]struct {
+		expr            string
+		expectErr       bool
+		expectedCgroups []CgroupThreshold
+	}{
+		"empty": {
+			expr:            "",
+			expectedCgroups: []CgroupThreshold{},
+		},
+		"quantity": {
+			expr: "kubepods<500Mi",
+			expectedCgroups: []CgroupThreshold{
+				{
+					Path: "kubepods",
+					Threshold: Threshold{
+						Signal:   cgroupSignal("kubepods"),
+						Operator: OpLessThan,
+						Value: ThresholdValue{
+							Quantity: quantityMustParse("500Mi"),
+						},
+					},
+				},
+			},
+		},
+		"leading-slash-and-percentage": {
+			expr: "/system.slice<10%",
+			expectedCgroups: []CgroupThreshold{
+				{
+					Path: "system.slice",
+					Threshold: Threshold{
+						Signal:   cgroupSignal("system.slice"),
+						Operator: OpLessThan,
+						Value: ThresholdValue{
+							Percentage: 0.1,
+						},
+					},
+				},
+			},
+		},
+		"duplicate-path": {
+			expr:      "kubepods<500Mi,kubepods<1Gi",
+			expectErr: true,
+		},
+		"empty-path": {
+			expr:      "/<500Mi",
+			expectErr: true,
+		},
+		"missing-operator": {
+			expr:      "kubepods",
+			expectErr: true,
+		},
+	}
+	for testName, testCase := range testCases {
+		actual, err := ParseCgroupThresholdConfig(testCase.expr)
+		if testCase.expectErr {
+			if err == nil {
+				t.Errorf("Test case: %s, expected error but got none", testName)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test case: %s, unexpected error: %v", testName, err)
+			continue
+		}
+		if len(actual) != len(testCase.expectedCgroups) {
+			t.Errorf("Test case: %s, expected %d thresholds, got %d", testName, len(testCase.expectedCgroups), len(actual))
+			continue
+		}
+		for i := range actual {
+			if actual[i].Path != testCase.expectedCgroups[i].Path {
+				t.Errorf("Test case: %s, expected path %v, got %v", testName, testCase.expectedCgroups[i].Path, actual[i].Path)
+			}
+			if actual[i].Threshold.Signal != testCase.expectedCgroups[i].Threshold.Signal {
+				t.Errorf("Test case: %s, expected signal %v, got %v", testName, testCase.expectedCgroups[i].Threshold.Signal, actual[i].Threshold.Signal)
+			}
+			if actual[i].Threshold.Operator != testCase.expectedCgroups[i].Threshold.Operator {
+				t.Errorf("Test case: %s, expected operator %v, got %v", testName, testCase.expectedCgroups[i].Threshold.Operator, actual[i].Threshold.Operator)
+			}
+			if !compareThresholdValue(actual[i].Threshold.Value, testCase.expectedCgroups[i].Threshold.Value) {
+				t.Errorf("Test case: %s, expected value %v, got %v", testName, testCase.expectedCgroups[i].Threshold.Value, actual[i].Threshold.Value)
+			}
+		}
+	}
+}
+
+func TestSoftEvictionGracePeriod(t *testing.T) {
+	podWithGracePeriod := func(seconds int64) *api.Pod {
+		pod := newPod("pod", []api.Container{
+			newContainer("ctr", newResourceList("", ""), newResourceList("", "")),
+		}, nil)
+		pod.Spec.TerminationGracePeriodSeconds = &seconds
+		return pod
+	}
+	testCases := map[string]struct {
+		pod                      *api.Pod
+		maxPodGracePeriodSeconds int64
+		honorPodGracePeriod      bool
+		result                   int64
+	}{
+		"pod-does-not-declare": {
+			pod: newPod("pod", []api.Container{
+				newContainer("ctr", newResourceList("", ""), newResourceList("", "")),
+			}, nil),
+			maxPodGracePeriodSeconds: 30,
+			result:                   30,
+		},
+		"pod-declares-shorter": {
+			pod:                      podWithGracePeriod(10),
+			maxPodGracePeriodSeconds: 30,
+			result:                   10,
+		},
+		"pod-declares-longer": {
+			pod:                      podWithGracePeriod(60),
+			maxPodGracePeriodSeconds: 30,
+			result:                   30,
+		},
+		"pod-declares-longer-honored": {
+			pod:                      podWithGracePeriod(60),
+			maxPodGracePeriodSeconds: 30,
+			honorPodGracePeriod:      true,
+			result:                   60,
+		},
+	}
+	for testName, testCase := range testCases {
+		actual := softEvictionGracePeriod(testCase.pod, testCase.maxPodGracePeriodSeconds, testCase.honorPodGracePeriod)
+		if actual != testCase.result {
+			t.Errorf("Test case: %s, expected: %d, actual: %d", testName, testCase.result, actual)
+		}
+	}
+}
+
+// TestOrderedBySwap ensures we order pods by greediest swap consumer.
+func TestOrderedBySwap(t *testing.T) {
+	pod1 := newPod("swap-high", []api.Container{
+		newContainer("swap-high", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	pod2 := newPod("swap-low", []api.Container{
+		newContainer("swap-low", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	pod3 := newPod("swap-none", []api.Container{
+		newContainer("swap-none", newResourceList("", ""), newResourceList("", "")),
+	}, nil)
+	stats := map[*api.Pod]statsapi.PodStats{
+		pod1: newPodSwapStats(pod1, resource.MustParse("500Mi")),
+		pod2: newPodSwapStats(pod2, resource.MustParse("50Mi")),
+		pod3: newPodSwapStats(pod3, resource.MustParse("0")),
+	}
+	statsFn := func(pod *api.Pod) (statsapi.PodStats, bool) {
+		result, found := stats[pod]
+		return result, found
+	}
+	pods := []*api.Pod{pod3, pod2, pod1}
+	orderedBy(swap(statsFn)).Sort(pods)
+	expected := []*api.Pod{pod1, pod2, pod3}
+	for i := range expected {
+		if pods[i] != expected[i] {
+			t.Errorf("Expected pod[%d]: %s, but got: %s", i, expected[i].Name, pods[i].Name)
+		}
+	}
+}
+
 // TestOrderedByQoSMemory ensures we order by qosComparator and then memory consumption relative to request.
 func TestOrderedByQoSMemory(t *testing.T) {
 	pod1 := newPod("best-effort-high", []api.Container{
@@ -654,9 +1236,13 @@ func TestOrderedByQoSMemory(t *testing.T) {
 
 type fakeSummaryProvider struct {
 	result *statsapi.Summary
+	err    error
 }
 
 func (f *fakeSummaryProvider) Get() (*statsapi.Summary, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
 	return f.result, nil
 }
 
@@ -697,6 +1283,8 @@ func TestMakeSignalObservations(t *testing.T) {
 	}
 	nodeAvailableBytes := uint64(1024 * 1024 * 1024)
 	nodeWorkingSetBytes := uint64(1024 * 1024 * 1024)
+	nodeSwapAvailableBytes := uint64(1024 * 1024 * 512)
+	nodeSwapUsageBytes := uint64(1024 * 1024 * 512)
 	imageFsAvailableBytes := uint64(1024 * 1024)
 	imageFsCapacityBytes := uint64(1024 * 1024 * 2)
 	nodeFsAvailableBytes := uint64(1024)
@@ -708,8 +1296,10 @@ func TestMakeSignalObservations(t *testing.T) {
 	fakeStats := &statsapi.Summary{
 		Node: statsapi.NodeStats{
 			Memory: &statsapi.MemoryStats{
-				AvailableBytes:  &nodeAvailableBytes,
-				WorkingSetBytes: &nodeWorkingSetBytes,
+				AvailableBytes:     &nodeAvailableBytes,
+				WorkingSetBytes:    &nodeWorkingSetBytes,
+				SwapAvailableBytes: &nodeSwapAvailableBytes,
+				SwapUsageBytes:     &nodeSwapUsageBytes,
 			},
 			Runtime: &statsapi.RuntimeStats{
 				ImageFs: &statsapi.FsStats{
@@ -740,7 +1330,7 @@ func TestMakeSignalObservations(t *testing.T) {
 	for _, pod := range pods {
 		fakeStats.Pods = append(fakeStats.Pods, newPodStats(pod, containerWorkingSetBytes))
 	}
-	actualObservations, statsFunc, err := makeSignalObservations(provider)
+	actualObservations, statsFunc, err := makeSignalObservations(provider, 100)
 
 	if err != nil {
 		t.Errorf("Unexpected err: %v", err)
@@ -755,6 +1345,16 @@ func TestMakeSignalObservations(t *testing.T) {
 	if expectedBytes := int64(nodeWorkingSetBytes + nodeAvailableBytes); memQuantity.capacity.Value() != expectedBytes {
 		t.Errorf("Expected %v, actual: %v", expectedBytes, memQuantity.capacity.Value())
 	}
+	swapQuantity, found := actualObservations[SignalSwapAvailable]
+	if !found {
+		t.Errorf("Expected available swap observation: %v", err)
+	}
+	if expectedBytes := int64(nodeSwapAvailableBytes); swapQuantity.available.Value() != expectedBytes {
+		t.Errorf("Expected %v, actual: %v", expectedBytes, swapQuantity.available.Value())
+	}
+	if expectedBytes := int64(nodeSwapAvailableBytes + nodeSwapUsageBytes); swapQuantity.capacity.Value() != expectedBytes {
+		t.Errorf("Expected %v, actual: %v", expectedBytes, swapQuantity.capacity.Value())
+	}
 	nodeFsQuantity, found := actualObservations[SignalNodeFsAvailable]
 	if !found {
 		t.Errorf("Expected available nodefs observation: %v", err)
@@ -809,6 +1409,55 @@ func TestMakeSignalObservations(t *testing.T) {
 	}
 }
 
+func TestMakeSignalObservationsInactiveFile(t *testing.T) {
+	nodeAvailableBytes := uint64(1024 * 1024 * 1024)
+	nodeWorkingSetBytes := uint64(1024 * 1024 * 1024)
+	nodeUsageBytes := nodeWorkingSetBytes + uint64(1024*1024*512)
+	inactiveFileBytes := int64(nodeUsageBytes - nodeWorkingSetBytes)
+	provider := &fakeSummaryProvider{
+		result: &statsapi.Summary{
+			Node: statsapi.NodeStats{
+				Memory: &statsapi.MemoryStats{
+					AvailableBytes:  &nodeAvailableBytes,
+					UsageBytes:      &nodeUsageBytes,
+					WorkingSetBytes: &nodeWorkingSetBytes,
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inactiveFileReclaimablePercent int64
+		expectedAvailable              int64
+	}{
+		"fully-reclaimable": {
+			inactiveFileReclaimablePercent: 100,
+			expectedAvailable:              int64(nodeAvailableBytes),
+		},
+		"half-reclaimable": {
+			inactiveFileReclaimablePercent: 50,
+			expectedAvailable:              int64(nodeAvailableBytes) - inactiveFileBytes/2,
+		},
+		"not-reclaimable": {
+			inactiveFileReclaimablePercent: 0,
+			expectedAvailable:              int64(nodeAvailableBytes) - inactiveFileBytes,
+		},
+	}
+	for testName, testCase := range testCases {
+		observations, _, err := makeSignalObservations(provider, testCase.inactiveFileReclaimablePercent)
+		if err != nil {
+			t.Errorf("%s: unexpected err: %v", testName, err)
+		}
+		observed, found := observations[SignalMemoryAvailable]
+		if !found {
+			t.Errorf("%s: expected available memory observation", testName)
+			continue
+		}
+		if observed.available.Value() != testCase.expectedAvailable {
+			t.Errorf("%s: expected %v, actual: %v", testName, testCase.expectedAvailable, observed.available.Value())
+		}
+	}
+}
+
 func TestThresholdsMet(t *testing.T) {
 	hardThreshold := Threshold{
 		Signal:   SignalMemoryAvailable,
@@ -820,6 +1469,13 @@ func TestThresholdsMet(t *testing.T) {
 			Quantity: quantityMustParse("500Mi"),
 		},
 	}
+	greaterThanThreshold := Threshold{
+		Signal:   SignalMemoryAvailable,
+		Operator: OpGreaterThan,
+		Value: ThresholdValue{
+			Quantity: quantityMustParse("1Gi"),
+		},
+	}
 	testCases := map[string]struct {
 		enforceMinReclaim bool
 		thresholds        []Threshold
@@ -872,6 +1528,26 @@ func TestThresholdsMet(t *testing.T) {
 			},
 			result: []Threshold{},
 		},
+		"greater-than-threshold-met": {
+			enforceMinReclaim: false,
+			thresholds:        []Threshold{greaterThanThreshold},
+			observations: signalObservations{
+				SignalMemoryAvailable: signalObservation{
+					available: quantityMustParse("2Gi"),
+				},
+			},
+			result: []Threshold{greaterThanThreshold},
+		},
+		"greater-than-threshold-not-met": {
+			enforceMinReclaim: false,
+			thresholds:        []Threshold{greaterThanThreshold},
+			observations: signalObservations{
+				SignalMemoryAvailable: signalObservation{
+					available: quantityMustParse("500Mi"),
+				},
+			},
+			result: []Threshold{},
+		},
 	}
 	for testName, testCase := range testCases {
 		actual := thresholdsMet(testCase.thresholds, testCase.observations, testCase.enforceMinReclaim)
@@ -1530,6 +2206,23 @@ func newPodMemoryStats(pod *api.Pod, workingSet resource.Quantity) statsapi.PodS
 	return result
 }
 
+func newPodSwapStats(pod *api.Pod, swapUsage resource.Quantity) statsapi.PodStats {
+	result := statsapi.PodStats{
+		PodRef: statsapi.PodReference{
+			Name: pod.Name, Namespace: pod.Namespace, UID: string(pod.UID),
+		},
+	}
+	for range pod.Spec.Containers {
+		swapUsageBytes := uint64(swapUsage.Value())
+		result.Containers = append(result.Containers, statsapi.ContainerStats{
+			Memory: &statsapi.MemoryStats{
+				SwapUsageBytes: &swapUsageBytes,
+			},
+		})
+	}
+	return result
+}
+
 func newResourceList(cpu, memory string) api.ResourceList {
 	res := api.ResourceList{}
 	if cpu != "" {
@@ -39,6 +39,16 @@ const (
 	SignalImageFsAvailable Signal = "imagefs.available"
 	// SignalImageFsInodesFree is amount of inodes available on filesystem that container runtime uses for storing images and container writeable layers.
 	SignalImageFsInodesFree Signal = "imagefs.inodesFree"
+	// SignalPidAvailable is amount of PIDs available for pod allocation
+	SignalPidAvailable Signal = "pid.available"
+	// SignalSwapAvailable is swap available (i.e. swap limit - swap usage), in bytes.
+	SignalSwapAvailable Signal = "memory.swap.available"
+	// fsSignalPrefix is the prefix of signals for additional local filesystems identified by mount path.
+	fsSignalPrefix = "fs.available:"
+	// customSignalPrefix is the prefix of signals for custom resources identified by resource name.
+	customSignalPrefix = "custom.available:"
+	// cgroupSignalPrefix is the prefix of signals for memory available within a top-level cgroup identified by its path.
+	cgroupSignalPrefix = "memory.cgroup.available:"
 )
 
 // fsStatsType defines the types of filesystem stats to collect.
@@ -59,6 +69,8 @@ type ThresholdOperator string
 const (
 	// OpLessThan is the operator that expresses a less than operator.
 	OpLessThan ThresholdOperator = "LessThan"
+	// OpGreaterThan is the operator that expresses a greater than operator.
+	OpGreaterThan ThresholdOperator = "GreaterThan"
 )
 
 // Config holds information about how eviction is configured.
@@ -69,6 +81,94 @@ type Config struct {
 	MaxPodGracePeriodSeconds int64
 	// Thresholds define the set of conditions monitored to trigger eviction.
 	Thresholds []Threshold
+	// KernelMemcgNotification if true will integrate with the kernel memcg notification to determine if memory thresholds are crossed.
+	KernelMemcgNotification bool
+	// DryRun if true will evaluate thresholds and rank pods for eviction, but only record events about what would have happened.
+	DryRun bool
+	// AdaptiveMonitoring if true will tighten the monitoring interval while a threshold is observed and relax it when the node is healthy.
+	AdaptiveMonitoring bool
+	// EvictMultiplePods if true allows the manager to evict more than one pod in a single pass under a hard threshold, until the estimated reclaim relieves pressure.
+	EvictMultiplePods bool
+	// EmergencyThresholds define the floor below which pod safe-to-evict annotations are ignored when selecting victims.
+	EmergencyThresholds []Threshold
+	// HonorPodGracePeriod if true allows soft evictions to use the pod's full terminationGracePeriodSeconds even when it exceeds MaxPodGracePeriodSeconds.
+	HonorPodGracePeriod bool
+	// CordonThresholdCrossings is the number of hard threshold crossings within the cordon window that causes the manager to mark the node unschedulable.  a zero value disables cordoning.
+	CordonThresholdCrossings int
+	// FilesystemThresholds define pressure signals for additional local filesystems identified by mount path.
+	FilesystemThresholds []FilesystemThreshold
+	// CgroupThresholds define memory pressure signals scoped to a top-level cgroup (e.g. kubepods),
+	// so evictions react to pod-attributable usage rather than host daemons consuming memory.
+	CgroupThresholds []CgroupThreshold
+	// AdmissionPolicy controls which pods are rejected for admission while the node reports memory pressure.
+	AdmissionPolicy AdmissionPolicy
+	// InactiveFileReclaimablePercent is the percentage of inactive page cache treated as reclaimable when computing memory.available.
+	// a value of 100 preserves the kernel working set semantics; lower values discount inactive_file as reclaimable memory.
+	InactiveFileReclaimablePercent int64
+	// CustomResourceThresholds define pressure signals for custom resources observed via a pluggable stats source.
+	CustomResourceThresholds []CustomResourceThreshold
+	// MaxEvictionsPerHour caps the number of pods evicted within any one hour window.  once the cap
+	// is reached the manager keeps reporting pressure conditions and emitting events but does not
+	// evict further pods.  a zero value disables the cap.
+	MaxEvictionsPerHour int
+	// PodNotifier, if non-nil, is invoked to deliver a pre-eviction warning to the likely victim
+	// of a soft threshold whose notify period has been reached (e.g. via the pod notify subresource
+	// or a SIGTERM-precursor signal).
+	PodNotifier PodNotifierFunc
+	// SkipStaticPods if true excludes static pods from eviction ranking, since the kubelet
+	// immediately restarts an evicted static pod from its local manifest.
+	SkipStaticPods bool
+	// StatsStalenessPeriod is the maximum age the stats summary may have before the manager
+	// skips threshold evaluation, so pods are not evicted based on stale data.  the zero
+	// value disables staleness checking.
+	StatsStalenessPeriod time.Duration
+}
+
+// PodNotifierFunc delivers a pre-eviction warning to the specified pod.
+type PodNotifierFunc func(pod *api.Pod, message string) error
+
+// CustomResourceStatsFunc observes the current availability and capacity of a custom resource.
+type CustomResourceStatsFunc func() (available int64, capacity int64, err error)
+
+// CustomResourceThreshold is an eviction threshold for a custom resource (e.g. an opaque or
+// device-plugin provided resource) whose availability is observed via a pluggable stats source.
+// pods are ranked for eviction by their declared usage of the resource.
+type CustomResourceThreshold struct {
+	// Resource is the name of the custom resource.
+	Resource api.ResourceName
+	// StatsFunc observes the current availability and capacity of the resource.
+	StatsFunc CustomResourceStatsFunc
+	// Threshold is evaluated against the observed availability.  its signal is derived from the resource name.
+	Threshold Threshold
+}
+
+// AdmissionPolicy describes which pods are rejected for admission while the node reports memory pressure.
+type AdmissionPolicy string
+
+const (
+	// AdmissionPolicyBestEffort rejects only BestEffort pods under memory pressure.
+	AdmissionPolicyBestEffort AdmissionPolicy = "best-effort"
+	// AdmissionPolicyBurstable rejects BestEffort pods and Burstable pods whose memory request exceeds the memory last observed available under memory pressure.
+	AdmissionPolicyBurstable AdmissionPolicy = "burstable"
+	// AdmissionPolicyAll rejects all non-critical pods under memory pressure.
+	AdmissionPolicyAll AdmissionPolicy = "all"
+)
+
+// FilesystemThreshold is an eviction threshold for an additional local filesystem identified by its mount path.
+type FilesystemThreshold struct {
+	// Path is the mount path of the filesystem to observe.
+	Path string
+	// Threshold is evaluated against the bytes available on the filesystem.  its signal is derived from the path.
+	Threshold Threshold
+}
+
+// CgroupThreshold is an eviction threshold for memory available within a top-level cgroup
+// identified by its path relative to the cgroup root (e.g. kubepods or system.slice).
+type CgroupThreshold struct {
+	// Path is the path of the memory cgroup to observe, relative to the cgroup root.
+	Path string
+	// Threshold is evaluated against limit - usage of the memory cgroup.  its signal is derived from the path.
+	Threshold Threshold
 }
 
 // ThresholdValue is a value holder that abstracts literal versus percentage based quantity
@@ -93,6 +193,9 @@ type Threshold struct {
 	GracePeriod time.Duration
 	// MinReclaim represents the minimum amount of resource to reclaim if the threshold is met.
 	MinReclaim *ThresholdValue
+	// NotifyPeriod is how long before the grace period expires that the likely victim is
+	// pre-notified so it can shed load or checkpoint.  a zero value disables pre-notification.
+	NotifyPeriod time.Duration
 }
 
 // Manager evaluates when an eviction threshold for node stability has been met on the node.
@@ -105,6 +208,64 @@ type Manager interface {
 
 	// IsUnderDiskPressure returns true if the node is under disk pressure.
 	IsUnderDiskPressure() bool
+
+	// IsUnderPIDPressure returns true if the node is under PID pressure.
+	IsUnderPIDPressure() bool
+
+	// NodeConditionMessage returns a human-readable explanation for why the specified
+	// node condition is reported, or an empty string if no threshold is driving it.
+	NodeConditionMessage(condition api.NodeConditionType) string
+
+	// DebugInfo returns a snapshot of the manager's internal state for debug endpoints.
+	DebugInfo() DebugInfo
+
+	// Simulate returns the ordered victim list and expected reclaim for the provided pods
+	// under hypothetical signal observations, without killing anything.
+	Simulate(activePods []*api.Pod, overrides map[Signal]resource.Quantity) SimulationResult
+}
+
+// EvictionRecord describes a single eviction decision made by the manager.
+type EvictionRecord struct {
+	// Pod is the namespaced name of the evicted pod.
+	Pod string `json:"pod"`
+	// Message is the detailed message recorded for the eviction.
+	Message string `json:"message"`
+	// Time is when the eviction occurred.
+	Time unversioned.Time `json:"time"`
+}
+
+// DebugInfo is a point-in-time snapshot of eviction manager state served by the kubelet
+// debug endpoint.
+type DebugInfo struct {
+	// Observations maps each signal to its last observed available and capacity values.
+	Observations map[Signal]string `json:"observations"`
+	// ThresholdsMet are the thresholds currently met, formatted for display.
+	ThresholdsMet []string `json:"thresholdsMet"`
+	// ThresholdsFirstObservedAt records when each observed threshold was first seen.
+	ThresholdsFirstObservedAt map[string]unversioned.Time `json:"thresholdsFirstObservedAt"`
+	// NodeConditions are the pressure conditions currently active.
+	NodeConditions []api.NodeConditionType `json:"nodeConditions"`
+	// Evictions are the most recent eviction decisions, oldest first.
+	Evictions []EvictionRecord `json:"evictions"`
+}
+
+// SimulationResult describes the evictions the manager would perform for a hypothetical
+// set of observations, without killing anything.
+type SimulationResult struct {
+	// Error is set if the simulation could not be performed.
+	Error string `json:"error,omitempty"`
+	// ResourceToReclaim is the starved resource that would drive eviction.
+	ResourceToReclaim api.ResourceName `json:"resourceToReclaim,omitempty"`
+	// Victims is the ordered list of pods that would be selected for eviction.
+	Victims []SimulatedVictim `json:"victims"`
+}
+
+// SimulatedVictim is a pod that would be evicted in a simulation.
+type SimulatedVictim struct {
+	// Pod identifies the pod.
+	Pod string `json:"pod"`
+	// Reclaim is the expected amount of the starved resource that evicting the pod would reclaim.
+	Reclaim string `json:"reclaim,omitempty"`
 }
 
 // DiskInfoProvider is responsible for informing the manager how disk is configured.
@@ -117,6 +278,21 @@ type DiskInfoProvider interface {
 type ImageGC interface {
 	// DeleteUnusedImages deletes unused images and returns the number of bytes freed, or an error.
 	DeleteUnusedImages() (int64, error)
+	// DeleteImagesByCostBenefit deletes unused images ordered by reclaim cost/benefit (size
+	// multiplied by time since last use) and returns the number of bytes freed, or an error.
+	DeleteImagesByCostBenefit() (int64, error)
+}
+
+// ContainerGC is responsible for performing garbage collection of unused containers.
+type ContainerGC interface {
+	// DeleteAllUnusedContainers deletes all unused containers, even those that belong to pods that are terminated, but not deleted.
+	DeleteAllUnusedContainers() error
+}
+
+// LogsReclaimer is responsible for reclaiming disk consumed by container logs.
+type LogsReclaimer interface {
+	// ReclaimLogs truncates or rotates the oldest container logs and returns the number of bytes freed, or an error.
+	ReclaimLogs() (int64, error)
 }
 
 // KillPodFunc kills a pod.
@@ -128,6 +304,37 @@ type ImageGC interface {
 // gracePeriodOverride - the grace period override to use instead of what is on the pod spec
 type KillPodFunc func(pod *api.Pod, status api.PodStatus, gracePeriodOverride *int64) error
 
+// CordonFunc marks the node unschedulable (true) or schedulable (false).
+type CordonFunc func(unschedulable bool) error
+
+// PodTerminationOptions describe how a pod should be terminated.
+type PodTerminationOptions struct {
+	// GracePeriodOverride is the grace period to use instead of what is on the pod spec, if any.
+	GracePeriodOverride *int64
+	// Reason is a brief CamelCase reason recorded on the pod status (i.e. why its killed).
+	Reason string
+	// Message is a human readable message recorded on the pod status.
+	Message string
+}
+
+// PodTerminator knows how to terminate a pod.  it is the single contract shared by the
+// eviction manager, the runtime, and tests, so alternate terminators can be plugged in.
+type PodTerminator interface {
+	// TerminatePod fails the pod with the provided options and kills its containers.
+	// It must block until either the pod is terminated or an error is encountered.
+	TerminatePod(pod *api.Pod, opts PodTerminationOptions) error
+}
+
+// TerminatePod allows a KillPodFunc to be used as a PodTerminator.
+func (f KillPodFunc) TerminatePod(pod *api.Pod, opts PodTerminationOptions) error {
+	status := api.PodStatus{
+		Phase:   api.PodFailed,
+		Reason:  opts.Reason,
+		Message: opts.Message,
+	}
+	return f(pod, status, opts.GracePeriodOverride)
+}
+
 // ActivePodsFunc returns pods bound to the kubelet that are active (i.e. non-terminal state)
 type ActivePodsFunc func() []*api.Pod
 
@@ -156,6 +363,14 @@ type thresholdsObservedAt map[Threshold]time.Time
 // nodeConditionsObservedAt maps a node condition to a time that it was observed
 type nodeConditionsObservedAt map[api.NodeConditionType]time.Time
 
+// ThresholdNotifier notifies the user when an attribute crosses a threshold value
+type ThresholdNotifier interface {
+	Start(stopCh <-chan struct{})
+}
+
+// thresholdNotifierHandlerFunc is a function that takes action in response to a crossed threshold
+type thresholdNotifierHandlerFunc func(thresholdDescription string)
+
 // nodeReclaimFunc is a function that knows how to reclaim a resource from the node without impacting pods.
 type nodeReclaimFunc func() (*resource.Quantity, error)
 
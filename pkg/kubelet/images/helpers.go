@@ -18,6 +18,7 @@ package images
 
 import (
 	"fmt"
+	"sync"
 
 	"k8s.io/kubernetes/pkg/api"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
@@ -48,3 +49,44 @@ func (ts throttledImageService) PullImage(image kubecontainer.ImageSpec, secrets
 	}
 	return fmt.Errorf("pull QPS exceeded.")
 }
+
+// coalesceImagePulling wraps kubecontainer.ImageService so that concurrent
+// pulls of the same image share a single underlying pull. The duplicate
+// callers block until the shared pull finishes and receive its result.
+func coalesceImagePulling(imageService kubecontainer.ImageService) kubecontainer.ImageService {
+	return &coalescingImageService{
+		ImageService: imageService,
+		inflight:     make(map[string]*inflightPull),
+	}
+}
+
+type coalescingImageService struct {
+	kubecontainer.ImageService
+	lock     sync.Mutex
+	inflight map[string]*inflightPull
+}
+
+type inflightPull struct {
+	done chan struct{}
+	err  error
+}
+
+func (cs *coalescingImageService) PullImage(image kubecontainer.ImageSpec, secrets []api.Secret) error {
+	cs.lock.Lock()
+	if pull, ok := cs.inflight[image.Image]; ok {
+		cs.lock.Unlock()
+		<-pull.done
+		return pull.err
+	}
+	pull := &inflightPull{done: make(chan struct{})}
+	cs.inflight[image.Image] = pull
+	cs.lock.Unlock()
+
+	pull.err = cs.ImageService.PullImage(image, secrets)
+
+	cs.lock.Lock()
+	delete(cs.inflight, image.Image)
+	cs.lock.Unlock()
+	close(pull.done)
+	return pull.err
+}
@@ -50,6 +50,10 @@ type ImageGCManager interface {
 
 	// Delete all unused images and returns the number of bytes freed. The number of bytes freed is always returned.
 	DeleteUnusedImages() (int64, error)
+
+	// Delete all unused images ordered by reclaim cost/benefit (size multiplied by time since
+	// last use) and returns the number of bytes freed. The number of bytes freed is always returned.
+	DeleteImagesByCostBenefit() (int64, error)
 }
 
 // A policy for garbage collecting images. Policy defines an allowed band in
@@ -255,6 +259,13 @@ func (im *realImageGCManager) DeleteUnusedImages() (int64, error) {
 	return im.freeSpace(math.MaxInt64, time.Now())
 }
 
+func (im *realImageGCManager) DeleteImagesByCostBenefit() (int64, error) {
+	freeTime := time.Now()
+	return im.freeSpaceOrderedBy(math.MaxInt64, freeTime, func(images []evictionInfo) {
+		sort.Sort(byCostBenefit{images: images, freeTime: freeTime})
+	})
+}
+
 // Tries to free bytesToFree worth of images on the disk.
 //
 // Returns the number of bytes free and an error if any occurred. The number of
@@ -262,6 +273,14 @@ func (im *realImageGCManager) DeleteUnusedImages() (int64, error) {
 // Note that error may be nil and the number of bytes free may be less
 // than bytesToFree.
 func (im *realImageGCManager) freeSpace(bytesToFree int64, freeTime time.Time) (int64, error) {
+	return im.freeSpaceOrderedBy(bytesToFree, freeTime, func(images []evictionInfo) {
+		sort.Sort(byLastUsedAndDetected(images))
+	})
+}
+
+// Tries to free bytesToFree worth of images on the disk, deleting them in the order
+// established by the specified sort function.
+func (im *realImageGCManager) freeSpaceOrderedBy(bytesToFree int64, freeTime time.Time, sortImages func([]evictionInfo)) (int64, error) {
 	err := im.detectImages(freeTime)
 	if err != nil {
 		return 0, err
@@ -278,7 +297,7 @@ func (im *realImageGCManager) freeSpace(bytesToFree int64, freeTime time.Time) (
 			imageRecord: *record,
 		})
 	}
-	sort.Sort(byLastUsedAndDetected(images))
+	sortImages(images)
 
 	// Delete unused images until we've freed up enough space.
 	var deletionErrors []error
@@ -338,6 +357,25 @@ func (ev byLastUsedAndDetected) Less(i, j int) bool {
 	}
 }
 
+// byCostBenefit ranks images so those with the greatest reclaim benefit come first, where
+// benefit is the image size multiplied by the time since the image was last used.  images
+// still in use have a lastUsed at or after freeTime and therefore sort last.
+type byCostBenefit struct {
+	images   []evictionInfo
+	freeTime time.Time
+}
+
+func (ev byCostBenefit) Len() int      { return len(ev.images) }
+func (ev byCostBenefit) Swap(i, j int) { ev.images[i], ev.images[j] = ev.images[j], ev.images[i] }
+func (ev byCostBenefit) Less(i, j int) bool {
+	return ev.benefit(i) > ev.benefit(j)
+}
+
+// benefit computes the image size multiplied by the seconds since the image was last used.
+func (ev byCostBenefit) benefit(i int) int64 {
+	return ev.images[i].size * int64(ev.freeTime.Sub(ev.images[i].lastUsed)/time.Second)
+}
+
 func isImageUsed(image container.Image, imagesInUse sets.String) bool {
 	// Check the image ID.
 	if _, ok := imagesInUse[image.ID]; ok {
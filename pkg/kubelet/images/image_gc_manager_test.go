@@ -18,6 +18,7 @@ package images
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -351,6 +352,29 @@ func TestFreeSpaceTiesBrokenByDetectedTime(t *testing.T) {
 	assert.Len(fakeRuntime.ImageList, 1)
 }
 
+func TestFreeSpaceOrderedByCostBenefit(t *testing.T) {
+	manager, fakeRuntime, _ := newRealImageGCManager(ImageGCPolicy{})
+	fakeRuntime.ImageList = []container.Image{
+		makeImage(0, 10),
+		makeImage(1, 4096),
+	}
+
+	// Image 0 was used least recently, but image 1 is large enough that its reclaim
+	// benefit (size multiplied by time since last use) is greater.
+	freeTime := time.Now()
+	manager.imageRecords[imageID(0)] = &imageRecord{lastUsed: freeTime.Add(-10 * time.Hour), size: 10}
+	manager.imageRecords[imageID(1)] = &imageRecord{lastUsed: freeTime.Add(-time.Hour), size: 4096}
+
+	spaceFreed, err := manager.freeSpaceOrderedBy(1, freeTime, func(images []evictionInfo) {
+		sort.Sort(byCostBenefit{images: images, freeTime: freeTime})
+	})
+	assert := assert.New(t)
+	require.NoError(t, err)
+	assert.EqualValues(4096, spaceFreed)
+	assert.Len(fakeRuntime.ImageList, 1)
+	assert.Equal(imageID(0), fakeRuntime.ImageList[0].ID)
+}
+
 func TestGarbageCollectBelowLowThreshold(t *testing.T) {
 	policy := ImageGCPolicy{
 		HighThresholdPercent: 90,
@@ -40,14 +40,19 @@ type imageManager struct {
 
 var _ ImageManager = &imageManager{}
 
-func NewImageManager(recorder record.EventRecorder, imageService kubecontainer.ImageService, imageBackOff *flowcontrol.Backoff, serialized bool, qps float32, burst int) ImageManager {
-	imageService = throttleImagePulling(imageService, qps, burst)
+func NewImageManager(recorder record.EventRecorder, imageService kubecontainer.ImageService, imageBackOff *flowcontrol.Backoff, serialized bool, maxParallelism int, qps float32, burst int) ImageManager {
+	// Coalescing is applied outside the throttle so that a duplicate pull of
+	// an image already being pulled does not consume a QPS token.
+	imageService = coalesceImagePulling(throttleImagePulling(imageService, qps, burst))
 
 	var puller imagePuller
 	if serialized {
 		puller = newSerialImagePuller(imageService)
 	} else {
-		puller = newParallelImagePuller(imageService)
+		if maxParallelism < 1 {
+			maxParallelism = 1
+		}
+		puller = newParallelImagePuller(imageService, maxParallelism)
 	}
 	return &imageManager{
 		recorder:     recorder,
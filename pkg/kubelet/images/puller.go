@@ -32,14 +32,18 @@ var _, _ imagePuller = &parallelImagePuller{}, &serialImagePuller{}
 
 type parallelImagePuller struct {
 	imageService kubecontainer.ImageService
+	// tokens bounds the number of pulls the puller runs at once.
+	tokens chan struct{}
 }
 
-func newParallelImagePuller(imageService kubecontainer.ImageService) imagePuller {
-	return &parallelImagePuller{imageService}
+func newParallelImagePuller(imageService kubecontainer.ImageService, maxParallelism int) imagePuller {
+	return &parallelImagePuller{imageService, make(chan struct{}, maxParallelism)}
 }
 
 func (pip *parallelImagePuller) pullImage(spec kubecontainer.ImageSpec, pullSecrets []api.Secret, errChan chan<- error) {
 	go func() {
+		pip.tokens <- struct{}{}
+		defer func() { <-pip.tokens }()
 		errChan <- pip.imageService.PullImage(spec, pullSecrets)
 	}()
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/kubernetes/pkg/api"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+)
+
+// countingImageService counts in-flight and total PullImage calls, blocking
+// each pull until release is closed.
+type countingImageService struct {
+	kubecontainer.ImageService
+	lock     sync.Mutex
+	inflight int
+	maxSeen  int
+	pulls    int
+	release  chan struct{}
+}
+
+func newCountingImageService() *countingImageService {
+	return &countingImageService{release: make(chan struct{})}
+}
+
+func (cs *countingImageService) PullImage(image kubecontainer.ImageSpec, secrets []api.Secret) error {
+	cs.lock.Lock()
+	cs.inflight++
+	cs.pulls++
+	if cs.inflight > cs.maxSeen {
+		cs.maxSeen = cs.inflight
+	}
+	cs.lock.Unlock()
+	<-cs.release
+	cs.lock.Lock()
+	cs.inflight--
+	cs.lock.Unlock()
+	return nil
+}
+
+func TestParallelPullerBoundsConcurrency(t *testing.T) {
+	const (
+		maxParallelism = 2
+		pulls          = 5
+	)
+	service := newCountingImageService()
+	puller := newParallelImagePuller(service, maxParallelism)
+
+	errChan := make(chan error, pulls)
+	for i := 0; i < pulls; i++ {
+		spec := kubecontainer.ImageSpec{Image: fmt.Sprintf("image-%d", i)}
+		puller.pullImage(spec, nil, errChan)
+	}
+	// Wait for the puller to saturate its parallelism limit, then let the
+	// pulls finish.
+	assert.NoError(t, waitFor(func() bool {
+		service.lock.Lock()
+		defer service.lock.Unlock()
+		return service.inflight == maxParallelism
+	}))
+	close(service.release)
+	for i := 0; i < pulls; i++ {
+		assert.NoError(t, <-errChan)
+	}
+
+	assert.Equal(t, pulls, service.pulls)
+	assert.Equal(t, maxParallelism, service.maxSeen)
+}
+
+func TestCoalesceImagePulling(t *testing.T) {
+	const pulls = 5
+	service := newCountingImageService()
+	coalescing := coalesceImagePulling(service)
+
+	var wg sync.WaitGroup
+	pull := func() {
+		defer wg.Done()
+		assert.NoError(t, coalescing.PullImage(kubecontainer.ImageSpec{Image: "image"}, nil))
+	}
+	wg.Add(1)
+	go pull()
+	// Wait until the first pull has reached the underlying service, so that
+	// the duplicates issued below find it in flight.
+	assert.NoError(t, waitFor(func() bool {
+		service.lock.Lock()
+		defer service.lock.Unlock()
+		return service.inflight == 1
+	}))
+	for i := 1; i < pulls; i++ {
+		wg.Add(1)
+		go pull()
+	}
+	// Give the duplicates time to queue behind the first pull, then let it
+	// finish.
+	time.Sleep(100 * time.Millisecond)
+	close(service.release)
+	wg.Wait()
+
+	assert.Equal(t, 1, service.pulls)
+}
+
+// waitFor polls the condition until it is true, with a generous timeout so
+// that slow test machines do not flake.
+func waitFor(condition func() bool) error {
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("timed out waiting for condition")
+}
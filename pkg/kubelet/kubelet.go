@@ -42,7 +42,9 @@ import (
 	"k8s.io/kubernetes/pkg/fields"
 	internalApi "k8s.io/kubernetes/pkg/kubelet/api"
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
+	"k8s.io/kubernetes/pkg/kubelet/checkpoint"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/dockershim"
@@ -53,8 +55,10 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/images"
 	"k8s.io/kubernetes/pkg/kubelet/kuberuntime"
 	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	kubeletlogs "k8s.io/kubernetes/pkg/kubelet/logs"
 	"k8s.io/kubernetes/pkg/kubelet/metrics"
 	"k8s.io/kubernetes/pkg/kubelet/network"
+	"k8s.io/kubernetes/pkg/kubelet/nodeshutdown"
 	"k8s.io/kubernetes/pkg/kubelet/pleg"
 	kubepod "k8s.io/kubernetes/pkg/kubelet/pod"
 	"k8s.io/kubernetes/pkg/kubelet/prober"
@@ -345,14 +349,44 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		RootFreeDiskMB:   int(kubeCfg.LowDiskSpaceThresholdMB),
 	}
 
-	thresholds, err := eviction.ParseThresholdConfig(kubeCfg.EvictionHard, kubeCfg.EvictionSoft, kubeCfg.EvictionSoftGracePeriod, kubeCfg.EvictionMinimumReclaim)
+	thresholds, err := eviction.ParseThresholdConfig(kubeCfg.EvictionHard, kubeCfg.EvictionSoft, kubeCfg.EvictionSoftGracePeriod, kubeCfg.EvictionMinimumReclaim, kubeCfg.ExperimentalEvictionSoftNotifyPeriod)
+	if err != nil {
+		return nil, err
+	}
+	emergencyThresholds, err := eviction.ParseThresholdConfig(kubeCfg.EvictionEmergencyFloor, "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	filesystemThresholds, err := eviction.ParseFilesystemThresholdConfig(kubeCfg.EvictionFilesystemThresholds)
+	if err != nil {
+		return nil, err
+	}
+	cgroupThresholds, err := eviction.ParseCgroupThresholdConfig(kubeCfg.EvictionCgroupThresholds)
+	if err != nil {
+		return nil, err
+	}
+	admissionPolicy, err := eviction.ParseAdmissionPolicy(kubeCfg.ExperimentalEvictionAdmissionPolicy)
 	if err != nil {
 		return nil, err
 	}
 	evictionConfig := eviction.Config{
-		PressureTransitionPeriod: kubeCfg.EvictionPressureTransitionPeriod.Duration,
-		MaxPodGracePeriodSeconds: int64(kubeCfg.EvictionMaxPodGracePeriod),
-		Thresholds:               thresholds,
+		PressureTransitionPeriod:       kubeCfg.EvictionPressureTransitionPeriod.Duration,
+		MaxPodGracePeriodSeconds:       int64(kubeCfg.EvictionMaxPodGracePeriod),
+		Thresholds:                     thresholds,
+		KernelMemcgNotification:        kubeCfg.ExperimentalKernelMemcgNotification,
+		DryRun:                         kubeCfg.ExperimentalEvictionDryRun,
+		AdaptiveMonitoring:             kubeCfg.ExperimentalEvictionAdaptiveMonitoring,
+		EvictMultiplePods:              kubeCfg.ExperimentalEvictionEvictMultiplePods,
+		EmergencyThresholds:            emergencyThresholds,
+		HonorPodGracePeriod:            kubeCfg.ExperimentalEvictionHonorPodGracePeriod,
+		CordonThresholdCrossings:       int(kubeCfg.ExperimentalEvictionCordonThreshold),
+		FilesystemThresholds:           filesystemThresholds,
+		CgroupThresholds:               cgroupThresholds,
+		AdmissionPolicy:                admissionPolicy,
+		InactiveFileReclaimablePercent: int64(kubeCfg.ExperimentalEvictionInactiveFilePercent),
+		MaxEvictionsPerHour:            int(kubeCfg.ExperimentalEvictionMaxPerHour),
+		SkipStaticPods:                 kubeCfg.ExperimentalEvictionSkipStaticPods,
+		StatsStalenessPeriod:           kubeCfg.ExperimentalEvictionStatsStaleness.Duration,
 	}
 
 	reservation, err := ParseReservation(kubeCfg.KubeReserved, kubeCfg.SystemReserved)
@@ -403,8 +437,6 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	}
 	containerRefManager := kubecontainer.NewRefManager()
 
-	oomWatcher := NewOOMWatcher(kubeDeps.CAdvisorInterface, kubeDeps.Recorder)
-
 	klet := &Kubelet{
 		hostname:                       hostname,
 		nodeName:                       nodeName,
@@ -425,6 +457,9 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		nodeInfo:                       nodeInfo,
 		masterServiceNamespace:         kubeCfg.MasterServiceNamespace,
 		streamingConnectionIdleTimeout: kubeCfg.StreamingConnectionIdleTimeout.Duration,
+		allowedNotifySignals:           sets.NewString(kubeCfg.AllowedNotifySignals...),
+		notifyLimiter:                  newNotificationLimiter(kubeCfg.NotifyQPS, kubeCfg.NotifyBurst),
+		notifyRecorder:                 newNotificationRecorder(),
 		recorder:                       kubeDeps.Recorder,
 		cadvisor:                       kubeDeps.CAdvisorInterface,
 		diskSpaceManager:               diskSpaceManager,
@@ -434,7 +469,6 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		nodeLabels:                kubeCfg.NodeLabels,
 		nodeStatusUpdateFrequency: kubeCfg.NodeStatusUpdateFrequency.Duration,
 		os:                kubeDeps.OSInterface,
-		oomWatcher:        oomWatcher,
 		cgroupsPerQOS:     kubeCfg.ExperimentalCgroupsPerQOS,
 		cgroupRoot:        kubeCfg.CgroupRoot,
 		mounter:           kubeDeps.Mounter,
@@ -494,6 +528,14 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	klet.podCache = kubecontainer.NewCache()
 	klet.podManager = kubepod.NewBasicPodManager(kubepod.NewBasicMirrorClient(klet.kubeClient))
 
+	klet.checkpointManager, err = checkpoint.NewManager(klet.getCheckpointsDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize checkpoint manager: %v", err)
+	}
+
+	// The oom watcher needs the pod manager to attribute oom kills to pods.
+	klet.oomWatcher = NewOOMWatcher(kubeDeps.CAdvisorInterface, kubeDeps.Recorder, klet.podManager)
+
 	if kubeCfg.RemoteRuntimeEndpoint != "" {
 		// kubeCfg.RemoteImageEndpoint is same as kubeCfg.RemoteRuntimeEndpoint if not explicitly specified
 		if kubeCfg.RemoteImageEndpoint == "" {
@@ -598,6 +640,7 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 			klet.httpClient,
 			imageBackOff,
 			kubeCfg.SerializeImagePulls,
+			int(kubeCfg.MaxParallelImagePulls),
 			float32(kubeCfg.RegistryPullQPS),
 			int(kubeCfg.RegistryBurst),
 			klet.cpuCFSQuota,
@@ -609,6 +652,20 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 		}
 		klet.containerRuntime = runtime
 		klet.runner = runtime
+
+		// Container log files written by CRI runtimes are rotated by the
+		// kubelet, since the runtimes do not rotate them on their own.
+		containerLogMaxSize, err := resource.ParseQuantity(kubeCfg.ContainerLogMaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration: container log max size %q: %v", kubeCfg.ContainerLogMaxSize, err)
+		}
+		klet.containerLogManager, err = kubeletlogs.NewContainerLogManager(kuberuntime.PodLogsRootDirectory, kubeletlogs.LogRotatePolicy{
+			MaxSize:  containerLogMaxSize.Value(),
+			MaxFiles: int(kubeCfg.ContainerLogMaxFiles),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration: %v", err)
+		}
 	} else {
 		switch kubeCfg.ContainerRuntime {
 		case "docker":
@@ -633,6 +690,7 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 				klet.cpuCFSQuota,
 				imageBackOff,
 				kubeCfg.SerializeImagePulls,
+				int(kubeCfg.MaxParallelImagePulls),
 				kubeCfg.EnableCustomMetrics,
 				// If using "kubenet", the Kubernetes network plugin that wraps
 				// CNI's bridge plugin, it knows how to set the hairpin veth flag
@@ -668,6 +726,7 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 				kubecontainer.RealOS{},
 				imageBackOff,
 				kubeCfg.SerializeImagePulls,
+				int(kubeCfg.MaxParallelImagePulls),
 				float32(kubeCfg.RegistryPullQPS),
 				int(kubeCfg.RegistryBurst),
 				kubeCfg.RuntimeRequestTimeout.Duration,
@@ -706,6 +765,27 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 
 	klet.statusManager = status.NewManager(kubeClient, klet.podManager)
 
+	// setup cpuManager. The static policy never assigns the node's reserved
+	// CPUs exclusively, so system daemons keep CPU time under load.
+	nodeConfig := klet.containerManager.GetNodeConfig()
+	reservedCPUsMilli := int64(0)
+	if cpuQuantity, ok := nodeConfig.SystemReserved[api.ResourceCPU]; ok {
+		reservedCPUsMilli += cpuQuantity.MilliValue()
+	}
+	if cpuQuantity, ok := nodeConfig.KubeReserved[api.ResourceCPU]; ok {
+		reservedCPUsMilli += cpuQuantity.MilliValue()
+	}
+	klet.cpuManager, err = cpumanager.NewManager(
+		kubeCfg.CPUManagerPolicy,
+		kubeCfg.CPUManagerReconcilePeriod.Duration,
+		machineInfo,
+		int((reservedCPUsMilli+999)/1000),
+		klet.getCheckpointsDir(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize cpu manager: %v", err)
+	}
+
 	klet.probeManager = prober.NewManager(
 		klet.statusManager,
 		klet.livenessManager,
@@ -751,13 +831,28 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	klet.setNodeStatusFuncs = klet.defaultNodeStatusFuncs()
 
 	// setup eviction manager
-	evictionManager, evictionAdmitHandler, err := eviction.NewManager(klet.resourceAnalyzer, evictionConfig, killPodNow(klet.podWorkers, kubeDeps.Recorder), klet.imageManager, kubeDeps.Recorder, nodeRef, klet.clock)
+	// TODO: pass a LogsReclaimer once the kubelet can prune container logs directly.
+	evictionManager, evictionAdmitHandler, err := eviction.NewManager(klet.resourceAnalyzer, evictionConfig, killPodNow(klet.podWorkers, kubeDeps.Recorder), klet.imageManager, klet.containerGC, nil, klet.setNodeUnschedulable, kubeDeps.Recorder, nodeRef, klet.clock)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize eviction manager: %v", err)
 	}
 	klet.evictionManager = evictionManager
-	klet.admitHandlers.AddPodAdmitHandler(evictionAdmitHandler)
+	klet.evictionMonitoringPeriod = kubeCfg.EvictionMonitoringPeriod.Duration
+	klet.admitHandlers.AddNamedPodAdmitHandler("eviction", evictionAdmitHandler)
+
+	// setup shutdown manager
+	klet.shutdownManager = nodeshutdown.NewManager(&nodeshutdown.Config{
+		DBus:                            utildbus.New(),
+		Clock:                           klet.clock,
+		Recorder:                        kubeDeps.Recorder,
+		NodeRef:                         nodeRef,
+		GetPodsFunc:                     klet.getActivePods,
+		KillPodFunc:                     killPodNow(klet.podWorkers, kubeDeps.Recorder),
+		SyncNodeStatusFunc:              klet.syncNodeStatus,
+		ShutdownGracePeriodRequested:    kubeCfg.ShutdownGracePeriod.Duration,
+		ShutdownGracePeriodCriticalPods: kubeCfg.ShutdownGracePeriodCriticalPods.Duration,
+	})
 
 	// add sysctl admission
 	runtimeSupport, err := sysctl.NewRuntimeAdmitHandler(klet.containerRuntime)
@@ -775,9 +870,9 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	if err != nil {
 		return nil, err
 	}
-	klet.admitHandlers.AddPodAdmitHandler(runtimeSupport)
-	klet.admitHandlers.AddPodAdmitHandler(safeWhitelist)
-	klet.admitHandlers.AddPodAdmitHandler(unsafeWhitelist)
+	klet.admitHandlers.AddNamedPodAdmitHandler("sysctlRuntimeSupport", runtimeSupport)
+	klet.admitHandlers.AddNamedPodAdmitHandler("sysctlSafeWhitelist", safeWhitelist)
+	klet.admitHandlers.AddNamedPodAdmitHandler("sysctlUnsafeWhitelist", unsafeWhitelist)
 
 	// enable active deadline handler
 	activeDeadlineHandler, err := newActiveDeadlineHandler(klet.statusManager, kubeDeps.Recorder, klet.clock)
@@ -787,14 +882,17 @@ func NewMainKubelet(kubeCfg *componentconfig.KubeletConfiguration, kubeDeps *Kub
 	klet.AddPodSyncLoopHandler(activeDeadlineHandler)
 	klet.AddPodSyncHandler(activeDeadlineHandler)
 
-	klet.admitHandlers.AddPodAdmitHandler(lifecycle.NewPredicateAdmitHandler(klet.getNodeAnyWay))
+	klet.admitHandlers.AddNamedPodAdmitHandler("predicates", lifecycle.NewPredicateAdmitHandler(klet.getNodeAnyWay))
+	// Admit handlers registered by out-of-tree packages compiled into the
+	// kubelet run after the built-in handlers.
+	klet.admitHandlers.AddRegisteredAdmitHandlers()
 	// apply functional Option's
 	for _, opt := range kubeDeps.Options {
 		opt(klet)
 	}
 
 	klet.appArmorValidator = apparmor.NewValidator(kubeCfg.ContainerRuntime)
-	klet.softAdmitHandlers.AddPodAdmitHandler(lifecycle.NewAppArmorAdmitHandler(klet.appArmorValidator))
+	klet.softAdmitHandlers.AddNamedPodAdmitHandler("appArmor", lifecycle.NewAppArmorAdmitHandler(klet.appArmorValidator))
 
 	// Finally, put the most recent version of the config on the Kubelet, so
 	// people can see how it was configured.
@@ -839,6 +937,20 @@ type Kubelet struct {
 	// Needed to observe and respond to situations that could impact node stability
 	evictionManager eviction.Manager
 
+	// Gracefully terminates pods before an impending node shutdown.
+	shutdownManager nodeshutdown.Manager
+
+	// Persists pod admission decisions across kubelet restarts, so pods that
+	// were already running on the node are readmitted after a restart.
+	checkpointManager checkpoint.Manager
+
+	// Assigns exclusive CPUs to Guaranteed pods when the static CPU manager
+	// policy is enabled.
+	cpuManager cpumanager.Manager
+
+	// duration the eviction manager waits between monitoring passes when the node is healthy
+	evictionMonitoringPeriod time.Duration
+
 	// Needed to report events for containers belonging to deleted/modified pods.
 	// Tracks references for reporting events
 	containerRefManager *kubecontainer.RefManager
@@ -900,6 +1012,17 @@ type Kubelet struct {
 	// connections open before terminating them
 	streamingConnectionIdleTimeout time.Duration
 
+	// The set of POSIX signal names the notify subresource may deliver to a
+	// container without a declared notification handler.
+	allowedNotifySignals sets.String
+
+	// notifyLimiter rate limits and queues notification deliveries per pod.
+	notifyLimiter *notificationLimiter
+
+	// notifyRecorder remembers delivered notifications that should be re-sent
+	// after the target container restarts.
+	notifyRecorder *notificationRecorder
+
 	// The EventRecorder to use
 	recorder record.EventRecorder
 
@@ -933,6 +1056,11 @@ type Kubelet struct {
 	// Container runtime.
 	containerRuntime kubecontainer.Runtime
 
+	// containerLogManager rotates the container log files written under the
+	// pod log directory. Only set when the kubelet manages the container
+	// log files, i.e. when CRI is enabled.
+	containerLogManager kubeletlogs.ContainerLogManager
+
 	// reasonCache caches the failure reason of the last creation of all containers, which is
 	// used for generating ContainerStatus.
 	reasonCache *ReasonCache
@@ -1052,14 +1180,14 @@ type Kubelet struct {
 	setNodeStatusFuncs []func(*api.Node) error
 
 	// TODO: think about moving this to be centralized in PodWorkers in follow-on.
-	// the list of handlers to call during pod admission.
-	admitHandlers lifecycle.PodAdmitHandlers
+	// the ordered chain of handlers to call during pod admission.
+	admitHandlers lifecycle.AdmitHandlerChain
 
 	// softAdmithandlers are applied to the pod after it is admitted by the Kubelet, but before it is
 	// run. A pod rejected by a softAdmitHandler will be left in a Pending state indefinitely. If a
 	// rejected pod should not be recreated, or the scheduler is not aware of the rejection rule, the
 	// admission rule should be applied by a softAdmitHandler.
-	softAdmitHandlers lifecycle.PodAdmitHandlers
+	softAdmitHandlers lifecycle.AdmitHandlerChain
 
 	// the list of handlers to call during pod sync loop.
 	lifecycle.PodSyncLoopHandlers
@@ -1174,12 +1302,18 @@ func (kl *Kubelet) initializeModules() error {
 		}
 	}
 
-	// Step 4: Start the image manager.
+	// Step 4: Start the container log manager, if the kubelet manages the
+	// container log files.
+	if kl.containerLogManager != nil {
+		kl.containerLogManager.Start()
+	}
+
+	// Step 5: Start the image manager.
 	if err := kl.imageManager.Start(); err != nil {
 		return fmt.Errorf("Failed to start ImageManager, images may not be garbage collected: %v", err)
 	}
 
-	// Step 5: Start container manager.
+	// Step 6: Start container manager.
 	node, err := kl.getNodeAnyWay()
 	if err != nil {
 		glog.Errorf("Cannot get Node info: %v", err)
@@ -1190,14 +1324,30 @@ func (kl *Kubelet) initializeModules() error {
 		return fmt.Errorf("Failed to start ContainerManager %v", err)
 	}
 
-	// Step 6: Start out of memory watcher.
+	// Step 7: Start out of memory watcher.
 	if err := kl.oomWatcher.Start(kl.nodeRef); err != nil {
 		return fmt.Errorf("Failed to start OOM watcher %v", err)
 	}
 
-	// Step 7: Start resource analyzer
+	// Step 8: Start resource analyzer
 	kl.resourceAnalyzer.Start()
 
+	// Step 9: Start the shutdown manager to terminate pods gracefully before
+	// the node powers off.
+	if kl.shutdownManager != nil {
+		if err := kl.shutdownManager.Start(); err != nil {
+			return fmt.Errorf("Failed to start node shutdown manager: %v", err)
+		}
+	}
+
+	// Step 10: Start the cpu manager.
+	if kl.cpuManager != nil {
+		updater, _ := kl.containerRuntime.(cpumanager.ContainerCPUSetUpdater)
+		if err := kl.cpuManager.Start(kl.getActivePods, kl.statusManager, updater); err != nil {
+			return fmt.Errorf("Failed to start cpu manager: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1209,7 +1359,11 @@ func (kl *Kubelet) initializeRuntimeDependentModules() {
 		glog.Fatalf("Failed to start cAdvisor %v", err)
 	}
 	// eviction manager must start after cadvisor because it needs to know if the container runtime has a dedicated imagefs
-	if err := kl.evictionManager.Start(kl, kl.getActivePods, evictionMonitoringPeriod); err != nil {
+	monitoringPeriod := kl.evictionMonitoringPeriod
+	if monitoringPeriod == 0 {
+		monitoringPeriod = evictionMonitoringPeriod
+	}
+	if err := kl.evictionManager.Start(kl, kl.getActivePods, monitoringPeriod); err != nil {
 		kl.runtimeState.setInternalError(fmt.Errorf("failed to start eviction manager %v", err))
 	}
 }
@@ -1666,10 +1820,8 @@ func (kl *Kubelet) canAdmitPod(pods []*api.Pod, pod *api.Pod) (bool, string, str
 	// TODO: move out of disk check into a pod admitter
 	// TODO: out of resource eviction should have a pod admitter call-out
 	attrs := &lifecycle.PodAdmitAttributes{Pod: pod, OtherPods: pods}
-	for _, podAdmitHandler := range kl.admitHandlers {
-		if result := podAdmitHandler.Admit(attrs); !result.Admit {
-			return false, result.Reason, result.Message
-		}
+	if result := kl.admitHandlers.Admit(attrs); !result.Admit {
+		return false, result.Reason, result.Message
 	}
 	// TODO: When disk space scheduling is implemented (#11976), remove the out-of-disk check here and
 	// add the disk space predicate to predicates.GeneralPredicates.
@@ -1686,10 +1838,8 @@ func (kl *Kubelet) canRunPod(pod *api.Pod) lifecycle.PodAdmitResult {
 	// Get "OtherPods". Rejected pods are failed, so only include admitted pods that are alive.
 	attrs.OtherPods = kl.filterOutTerminatedPods(kl.podManager.GetPods())
 
-	for _, handler := range kl.softAdmitHandlers {
-		if result := handler.Admit(attrs); !result.Admit {
-			return result
-		}
+	if result := kl.softAdmitHandlers.Admit(attrs); !result.Admit {
+		return result
 	}
 
 	// TODO: Refactor as a soft admit handler.
@@ -1820,6 +1970,12 @@ func (kl *Kubelet) syncLoopIteration(configCh <-chan kubetypes.PodUpdate, handle
 			}
 		}
 
+		if e.Type == pleg.ContainerStarted {
+			if containerID, ok := e.Data.(string); ok {
+				go kl.redeliverNotifications(e.ID, containerID)
+			}
+		}
+
 		if e.Type == pleg.ContainerDied {
 			if containerID, ok := e.Data.(string); ok {
 				kl.cleanUpContainersInPod(e.ID, containerID)
@@ -1920,10 +2076,21 @@ func (kl *Kubelet) HandlePodAdditions(pods []*api.Pod) {
 		// We failed pods that we rejected, so activePods include all admitted
 		// pods that are alive.
 		activePods := kl.filterOutTerminatedPods(allPods)
-		// Check if we can admit the pod; if not, reject it.
+		// Check if we can admit the pod; if not, reject it. Pods admitted
+		// before a kubelet restart were already running on the node, so
+		// rejecting them now would only disrupt their workloads.
 		if ok, reason, message := kl.canAdmitPod(activePods, pod); !ok {
-			kl.rejectPod(pod, reason, message)
-			continue
+			if kl.checkpointManager != nil && kl.checkpointManager.WasAdmitted(pod.UID) {
+				glog.Warningf("Pod %q failed admission after kubelet restart (%s: %s), readmitting since it was admitted before the restart", format.Pod(pod), reason, message)
+			} else {
+				kl.rejectPod(pod, reason, message)
+				continue
+			}
+		}
+		if kl.checkpointManager != nil {
+			if err := kl.checkpointManager.WritePodAdmission(pod.UID); err != nil {
+				glog.Errorf("Failed to checkpoint admission of pod %q: %v", format.Pod(pod), err)
+			}
 		}
 		kl.podManager.AddPod(pod)
 		mirrorPod, _ := kl.podManager.GetMirrorPodByPod(pod)
@@ -1959,6 +2126,11 @@ func (kl *Kubelet) HandlePodRemoves(pods []*api.Pod) {
 			kl.handleMirrorPod(pod, start)
 			continue
 		}
+		if kl.checkpointManager != nil {
+			if err := kl.checkpointManager.DeletePodAdmission(pod.UID); err != nil {
+				glog.Errorf("Failed to remove admission checkpoint of pod %q: %v", format.Pod(pod), err)
+			}
+		}
 		// Deletion is allowed to fail because the periodic cleanup routine
 		// will trigger deletion again.
 		if err := kl.deletePod(pod); err != nil {
@@ -25,8 +25,10 @@ import (
 	"github.com/golang/glog"
 	"k8s.io/kubernetes/cmd/kubelet/app/options"
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/kubelet/eviction"
 	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util"
 	nodeutil "k8s.io/kubernetes/pkg/util/node"
@@ -46,6 +48,12 @@ func (kl *Kubelet) getPodsDir() string {
 	return path.Join(kl.getRootDir(), options.DefaultKubeletPodsDirName)
 }
 
+// getCheckpointsDir returns the full path to the directory under which the
+// kubelet persists its checkpoints.
+func (kl *Kubelet) getCheckpointsDir() string {
+	return path.Join(kl.getRootDir(), options.DefaultKubeletCheckpointsDirName)
+}
+
 // getPluginsDir returns the full path to the directory under which plugin
 // directories are created.  Plugins can use these directories for data that
 // they need to persist.  Plugins should create subdirectories under this named
@@ -213,6 +221,17 @@ func (kl *Kubelet) GetNodeConfig() cm.NodeConfig {
 	return kl.containerManager.GetNodeConfig()
 }
 
+// GetEvictionDebugInfo returns a snapshot of eviction manager state for debugging.
+func (kl *Kubelet) GetEvictionDebugInfo() eviction.DebugInfo {
+	return kl.evictionManager.DebugInfo()
+}
+
+// SimulateEviction returns what the eviction manager would evict under hypothetical
+// signal observations, without killing anything.
+func (kl *Kubelet) SimulateEviction(overrides map[eviction.Signal]resource.Quantity) eviction.SimulationResult {
+	return kl.evictionManager.Simulate(kl.getActivePods(), overrides)
+}
+
 // Returns host IP or nil in case of error.
 func (kl *Kubelet) GetHostIP() (net.IP, error) {
 	node, err := kl.GetNode()
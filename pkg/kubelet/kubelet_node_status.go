@@ -33,6 +33,7 @@ import (
 	"k8s.io/kubernetes/pkg/cloudprovider"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/events"
 	"k8s.io/kubernetes/pkg/kubelet/util/sliceutils"
 	utilnet "k8s.io/kubernetes/pkg/util/net"
@@ -207,6 +208,15 @@ func (kl *Kubelet) initialNode() (*api.Node, error) {
 		glog.Infof("Controller attach/detach is disabled for this node; Kubelet will attach and detach volumes")
 	}
 
+	if _, ok := kl.containerRuntime.(kubecontainer.ContainerNotifier); ok {
+		if node.Annotations == nil {
+			node.Annotations = make(map[string]string)
+		}
+
+		glog.Infof("Setting node annotation to advertise notification support")
+		node.Annotations[kubecontainer.NotifySupportedAnnotation] = kubecontainer.NotifySupportedHandlers
+	}
+
 	// @question: should this be place after the call to the cloud provider? which also applies labels
 	for k, v := range kl.nodeLabels {
 		if cv, found := node.ObjectMeta.Labels[k]; found {
@@ -349,6 +359,25 @@ func (kl *Kubelet) tryUpdateNodeStatus() error {
 	return err
 }
 
+// setNodeUnschedulable updates the unschedulable field of the node object in the
+// apiserver.  it is injected into the eviction manager so the node can cordon itself
+// when hard eviction thresholds are repeatedly crossed.
+func (kl *Kubelet) setNodeUnschedulable(unschedulable bool) error {
+	if kl.kubeClient == nil {
+		return nil
+	}
+	node, err := kl.kubeClient.Core().Nodes().Get(string(kl.nodeName))
+	if err != nil {
+		return fmt.Errorf("error getting node %q: %v", kl.nodeName, err)
+	}
+	if node.Spec.Unschedulable == unschedulable {
+		return nil
+	}
+	node.Spec.Unschedulable = unschedulable
+	_, err = kl.kubeClient.Core().Nodes().Update(node)
+	return err
+}
+
 // recordNodeStatusEvent records an event of the given type with the given
 // message for the node.
 func (kl *Kubelet) recordNodeStatusEvent(eventtype, event string) {
@@ -593,6 +622,11 @@ func (kl *Kubelet) setNodeReadyCondition(node *api.Node) {
 	currentTime := unversioned.NewTime(kl.clock.Now())
 	var newNodeReadyCondition api.NodeCondition
 	rs := append(kl.runtimeState.runtimeErrors(), kl.runtimeState.networkErrors()...)
+	if kl.shutdownManager != nil {
+		if err := kl.shutdownManager.ShutdownStatus(); err != nil {
+			rs = append(rs, err.Error())
+		}
+	}
 	if len(rs) == 0 {
 		newNodeReadyCondition = api.NodeCondition{
 			Type:              api.NodeReady,
@@ -695,6 +729,11 @@ func (kl *Kubelet) setNodeMemoryPressureCondition(node *api.Node) {
 			condition.LastTransitionTime = currentTime
 			kl.recordNodeStatusEvent(api.EventTypeNormal, "NodeHasInsufficientMemory")
 		}
+		// Refresh the message with the specific thresholds that are met so the
+		// explanation tracks the observed state while pressure persists.
+		if message := kl.evictionManager.NodeConditionMessage(api.NodeMemoryPressure); message != "" {
+			condition.Message = message
+		}
 	} else {
 		if condition.Status != api.ConditionFalse {
 			condition.Status = api.ConditionFalse
@@ -753,6 +792,11 @@ func (kl *Kubelet) setNodeDiskPressureCondition(node *api.Node) {
 			condition.LastTransitionTime = currentTime
 			kl.recordNodeStatusEvent(api.EventTypeNormal, "NodeHasDiskPressure")
 		}
+		// Refresh the message with the specific thresholds that are met so the
+		// explanation tracks the observed state while pressure persists.
+		if message := kl.evictionManager.NodeConditionMessage(api.NodeDiskPressure); message != "" {
+			condition.Message = message
+		}
 	} else {
 		if condition.Status != api.ConditionFalse {
 			condition.Status = api.ConditionFalse
@@ -768,6 +812,64 @@ func (kl *Kubelet) setNodeDiskPressureCondition(node *api.Node) {
 	}
 }
 
+// setNodePIDPressureCondition for the node.
+// TODO: this needs to move somewhere centralized...
+func (kl *Kubelet) setNodePIDPressureCondition(node *api.Node) {
+	currentTime := unversioned.NewTime(kl.clock.Now())
+	var condition *api.NodeCondition
+
+	// Check if NodePIDPressure condition already exists and if it does, just pick it up for update.
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == api.NodePIDPressure {
+			condition = &node.Status.Conditions[i]
+		}
+	}
+
+	newCondition := false
+	// If the NodePIDPressure condition doesn't exist, create one
+	if condition == nil {
+		condition = &api.NodeCondition{
+			Type:   api.NodePIDPressure,
+			Status: api.ConditionUnknown,
+		}
+		// cannot be appended to node.Status.Conditions here because it gets
+		// copied to the slice. So if we append to the slice here none of the
+		// updates we make below are reflected in the slice.
+		newCondition = true
+	}
+
+	// Update the heartbeat time
+	condition.LastHeartbeatTime = currentTime
+
+	// Note: The conditions below take care of the case when a new NodePIDPressure condition is
+	// created and as well as the case when the condition already exists. When a new condition
+	// is created its status is set to api.ConditionUnknown which matches either
+	// condition.Status != api.ConditionTrue or
+	// condition.Status != api.ConditionFalse in the conditions below depending on whether
+	// the kubelet is under PID pressure or not.
+	if kl.evictionManager.IsUnderPIDPressure() {
+		if condition.Status != api.ConditionTrue {
+			condition.Status = api.ConditionTrue
+			condition.Reason = "KubeletHasInsufficientPID"
+			condition.Message = "kubelet has insufficient PID available"
+			condition.LastTransitionTime = currentTime
+			kl.recordNodeStatusEvent(api.EventTypeNormal, "NodeHasInsufficientPID")
+		}
+	} else {
+		if condition.Status != api.ConditionFalse {
+			condition.Status = api.ConditionFalse
+			condition.Reason = "KubeletHasSufficientPID"
+			condition.Message = "kubelet has sufficient PID available"
+			condition.LastTransitionTime = currentTime
+			kl.recordNodeStatusEvent(api.EventTypeNormal, "NodeHasSufficientPID")
+		}
+	}
+
+	if newCondition {
+		node.Status.Conditions = append(node.Status.Conditions, *condition)
+	}
+}
+
 // Set OODcondition for the node.
 func (kl *Kubelet) setNodeOODCondition(node *api.Node) {
 	currentTime := unversioned.NewTime(kl.clock.Now())
@@ -886,6 +988,7 @@ func (kl *Kubelet) defaultNodeStatusFuncs() []func(*api.Node) error {
 		withoutError(kl.setNodeOODCondition),
 		withoutError(kl.setNodeMemoryPressureCondition),
 		withoutError(kl.setNodeDiskPressureCondition),
+		withoutError(kl.setNodePIDPressureCondition),
 		withoutError(kl.setNodeReadyCondition),
 		withoutError(kl.setNodeVolumesInUseStatus),
 		withoutError(kl.recordNodeSchedulableEvent),
@@ -18,6 +18,7 @@ package kubelet
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -40,7 +41,10 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/envvars"
+	"k8s.io/kubernetes/pkg/kubelet/events"
 	"k8s.io/kubernetes/pkg/kubelet/images"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	"k8s.io/kubernetes/pkg/kubelet/metrics"
 	"k8s.io/kubernetes/pkg/kubelet/server/remotecommand"
 	"k8s.io/kubernetes/pkg/kubelet/status"
 	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
@@ -704,6 +708,21 @@ func (kl *Kubelet) HandlePodCleanups() error {
 	// TODO: is here the best place to forget pod workers?
 	kl.podWorkers.ForgetNonExistingPodWorkers(desiredPods)
 	kl.probeManager.CleanupPods(activePods)
+	kl.notifyLimiter.cleanupPods(desiredPods)
+	kl.notifyRecorder.cleanupPods(desiredPods)
+
+	if kl.checkpointManager != nil {
+		// Remove admission checkpoints of pods the pod manager no longer
+		// knows about, e.g. pods removed while the kubelet was down.
+		knownPods := make(map[types.UID]bool)
+		for _, pod := range allPods {
+			knownPods[pod.UID] = true
+		}
+		for _, pod := range mirrorPods {
+			knownPods[pod.UID] = true
+		}
+		kl.checkpointManager.GarbageCollect(knownPods)
+	}
 
 	runningPods, err := kl.runtimeCache.GetPods()
 	if err != nil {
@@ -1261,6 +1280,342 @@ func (kl *Kubelet) RunInContainer(podFullName string, podUID types.UID, containe
 	return kl.runner.RunInContainer(container.ID, cmd, 0)
 }
 
+// defaultNotificationTimeout bounds how long a notification handler may run when
+// the caller does not request an explicit deadline.
+const defaultNotificationTimeout = 30 * time.Second
+
+// NotifyInContainer delivers a notification message or a raw signal to a container,
+// returning the runtime's structured response as an array of bytes. Each delivery
+// attempt, successful or not, is recorded as an event on the pod along with the user
+// who requested it. A zero timeout applies defaultNotificationTimeout; handlers that
+// do not complete in time fail with kubecontainer.ErrNotificationTimedOut.
+func (kl *Kubelet) NotifyInContainer(podFullName string, podUID types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+	notificationName := message
+	if len(signal) > 0 {
+		notificationName = signal
+	}
+	metrics.NotificationsReceived.WithLabelValues(notificationName).Inc()
+	notifier, ok := kl.containerRuntime.(kubecontainer.ContainerNotifier)
+	if !ok {
+		return nil, fmt.Errorf("notify is not supported by runtime")
+	}
+
+	if user == "" {
+		user = "unknown"
+	}
+	pod, containerID, action, message, err := kl.prepareNotification(podFullName, podUID, containerName, message, signal, params, user)
+	if err != nil {
+		return nil, err
+	}
+	if timeout <= 0 {
+		timeout = defaultNotificationTimeout
+	}
+	release, err := kl.notifyLimiter.admit(pod.UID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	start := time.Now()
+	result, err := notifyWithTimeout(notifier, containerID, message, action, payload, timeout)
+	metrics.NotificationLatency.WithLabelValues(notificationName).Observe(metrics.SinceInMicroseconds(start))
+	if err != nil {
+		metrics.NotificationsFailed.WithLabelValues(notificationName).Inc()
+		kl.recorder.Eventf(pod, api.EventTypeWarning, events.FailedNotification, "Failed to deliver notification %q to container %q for user %q: %v", message, containerName, user, err)
+		return nil, err
+	}
+	metrics.NotificationsDelivered.WithLabelValues(notificationName).Inc()
+	kl.recorder.Eventf(pod, api.EventTypeNormal, events.NotificationDelivered, "Delivered notification %q to container %q for user %q (code %d)", message, containerName, user, result.Code)
+	if len(signal) == 0 {
+		kl.recordNotificationForRedelivery(pod, containerName, message, payload, params, user)
+	}
+	return json.Marshal(result)
+}
+
+// NotifyAll delivers the named notification to every container on the node
+// that declares a handler for it, one container at a time so a broadcast
+// rolls out gradually and the per-pod rate limits still apply. Containers
+// that do not declare the notification are skipped silently; failed
+// deliveries are reported in the result instead of aborting the broadcast.
+func (kl *Kubelet) NotifyAll(name string, payload []byte, user string) ([]kubecontainer.BroadcastNotificationResult, error) {
+	results := []kubecontainer.BroadcastNotificationResult{}
+	for _, pod := range kl.GetPods() {
+		podFullName := kubecontainer.GetPodFullName(pod)
+		for i := range pod.Spec.Containers {
+			container := &pod.Spec.Containers[i]
+			declared := false
+			for _, notification := range container.Notifications {
+				if notification.Name == name {
+					declared = true
+					break
+				}
+			}
+			if !declared {
+				continue
+			}
+			result := kubecontainer.BroadcastNotificationResult{
+				Pod:                         pod.Name,
+				Namespace:                   pod.Namespace,
+				ContainerNotificationResult: kubecontainer.ContainerNotificationResult{Container: container.Name},
+			}
+			data, err := kl.NotifyInContainer(podFullName, pod.UID, container.Name, name, "", payload, nil, 0, user)
+			if err != nil {
+				result.Error = err.Error()
+			} else if len(data) > 0 {
+				if err := json.Unmarshal(data, &result.NotificationResult); err != nil {
+					result.Error = err.Error()
+				}
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// recordNotificationForRedelivery remembers a delivered notification when its
+// declaration asks for redelivery after a container restart. Only buffered
+// deliveries are recorded; a streamed delivery has no client to stream to when
+// it is replayed.
+func (kl *Kubelet) recordNotificationForRedelivery(pod *api.Pod, containerName string, message string, payload []byte, params map[string]string, user string) {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name != containerName {
+			continue
+		}
+		for _, notification := range pod.Spec.Containers[i].Notifications {
+			if notification.Name == message && notification.RedeliverOnRestart {
+				kl.notifyRecorder.record(pod.UID, containerName, message, recordedNotification{
+					message: message,
+					payload: payload,
+					params:  params,
+					user:    user,
+				})
+			}
+		}
+	}
+}
+
+// redeliverNotifications re-sends the recorded notifications of a container
+// that just started. Records only exist once a notification declared with
+// redeliverOnRestart has been delivered, so a container's first start is a
+// no-op. Failures are surfaced as events on the pod by NotifyInContainer.
+func (kl *Kubelet) redeliverNotifications(podUID types.UID, containerID string) {
+	pod, ok := kl.podManager.GetPodByUID(podUID)
+	if !ok {
+		return
+	}
+	podFullName := kubecontainer.GetPodFullName(pod)
+	pods, err := kl.containerRuntime.GetPods(false)
+	if err != nil {
+		glog.Errorf("Unable to list containers for notification redelivery: %v", err)
+		return
+	}
+	runtimePod := kubecontainer.Pods(pods).FindPod(podFullName, podUID)
+	var containerName string
+	for _, container := range runtimePod.Containers {
+		if container.ID.ID == containerID {
+			containerName = container.Name
+			break
+		}
+	}
+	if len(containerName) == 0 {
+		return
+	}
+	for _, record := range kl.notifyRecorder.forContainer(podUID, containerName) {
+		glog.V(2).Infof("Redelivering notification %q to restarted container %q of pod %q", record.message, containerName, format.Pod(pod))
+		if _, err := kl.NotifyInContainer(podFullName, podUID, containerName, record.message, "", record.payload, record.params, 0, record.user); err != nil {
+			glog.Warningf("Failed to redeliver notification %q to container %q of pod %q: %v", record.message, containerName, format.Pod(pod), err)
+		}
+	}
+}
+
+// NotifyInContainerStream delivers a notification like NotifyInContainer but
+// streams the handler's output to out as it is produced. Only exec handlers
+// can stream, and no deadline is applied: the stream lasts until the handler
+// completes or the client goes away.
+func (kl *Kubelet) NotifyInContainerStream(podFullName string, podUID types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, user string, out io.Writer) error {
+	notificationName := message
+	if len(signal) > 0 {
+		notificationName = signal
+	}
+	metrics.NotificationsReceived.WithLabelValues(notificationName).Inc()
+	streamer, ok := kl.containerRuntime.(kubecontainer.ContainerNotificationStreamer)
+	if !ok {
+		return fmt.Errorf("streaming notify is not supported by runtime")
+	}
+
+	if user == "" {
+		user = "unknown"
+	}
+	pod, containerID, action, message, err := kl.prepareNotification(podFullName, podUID, containerName, message, signal, params, user)
+	if err != nil {
+		return err
+	}
+	release, err := kl.notifyLimiter.admit(pod.UID)
+	if err != nil {
+		return err
+	}
+	defer release()
+	start := time.Now()
+	err = streamer.NotifyContainerStream(containerID, message, action, payload, out)
+	metrics.NotificationLatency.WithLabelValues(notificationName).Observe(metrics.SinceInMicroseconds(start))
+	if err != nil {
+		metrics.NotificationsFailed.WithLabelValues(notificationName).Inc()
+		kl.recorder.Eventf(pod, api.EventTypeWarning, events.FailedNotification, "Failed to deliver notification %q to container %q for user %q: %v", message, containerName, user, err)
+		return err
+	}
+	metrics.NotificationsDelivered.WithLabelValues(notificationName).Inc()
+	kl.recorder.Eventf(pod, api.EventTypeNormal, events.NotificationDelivered, "Delivered notification %q to container %q for user %q (streamed)", message, containerName, user)
+	return nil
+}
+
+// prepareNotification resolves and validates a notification target, returning
+// the pod, the running container's ID and the action to perform. The returned
+// message is the notification name, or the signal name for raw signal
+// deliveries. Deliveries that do not satisfy the declaration's security
+// requirements are rejected here, before the handler runs.
+func (kl *Kubelet) prepareNotification(podFullName string, podUID types.UID, containerName string, message string, signal string, params map[string]string, user string) (*api.Pod, kubecontainer.ContainerID, *kubecontainer.NotificationAction, string, error) {
+	var containerID kubecontainer.ContainerID
+	pod, ok := kl.podManager.GetPodByFullName(podFullName)
+	if !ok {
+		return nil, containerID, nil, "", fmt.Errorf("pod not found (%q)", podFullName)
+	}
+	var containerSpec *api.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == containerName {
+			containerSpec = &pod.Spec.Containers[i]
+			break
+		}
+	}
+	if containerSpec == nil {
+		return nil, containerID, nil, "", fmt.Errorf("container %q not found in pod %q", containerName, podFullName)
+	}
+	var action *kubecontainer.NotificationAction
+	if len(signal) > 0 {
+		if !kl.allowedNotifySignals.Has(signal) {
+			return nil, containerID, nil, "", fmt.Errorf("signal %q is not allowed by this kubelet; allowed signals are %v", signal, kl.allowedNotifySignals.List())
+		}
+		if len(params) > 0 {
+			return nil, containerID, nil, "", fmt.Errorf("a signal does not accept parameters")
+		}
+		action = &kubecontainer.NotificationAction{Signal: signal}
+		message = signal
+	} else {
+		var notification *api.ContainerNotification
+		for i := range containerSpec.Notifications {
+			if containerSpec.Notifications[i].Name == message {
+				notification = &containerSpec.Notifications[i]
+				break
+			}
+		}
+		if notification == nil {
+			return nil, containerID, nil, "", fmt.Errorf("container %q does not declare notification %q", containerName, message)
+		}
+		if len(notification.AllowedUsers) > 0 {
+			allowed := false
+			for _, name := range notification.AllowedUsers {
+				if name == user {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return nil, containerID, nil, "", fmt.Errorf("user %q is not allowed to deliver notification %q", user, message)
+			}
+		}
+		if notification.RequireUnprivileged && containerSpec.SecurityContext != nil && containerSpec.SecurityContext.Privileged != nil && *containerSpec.SecurityContext.Privileged {
+			return nil, containerID, nil, "", fmt.Errorf("notification %q requires an unprivileged container, but container %q runs privileged", message, containerName)
+		}
+		var err error
+		action, err = notificationAction(&notification.Handler, containerSpec)
+		if err != nil {
+			return nil, containerID, nil, "", err
+		}
+		for name := range params {
+			if !actionDeclaresParam(action, name) {
+				return nil, containerID, nil, "", fmt.Errorf("notification %q does not declare parameter %q", message, name)
+			}
+		}
+		expandNotificationAction(action, params)
+	}
+
+	container, err := kl.findContainer(podFullName, podUID, containerName)
+	if err != nil {
+		return nil, containerID, nil, "", err
+	}
+	if container == nil {
+		return nil, containerID, nil, "", fmt.Errorf("container not found (%q)", containerName)
+	}
+	return pod, container.ID, action, message, nil
+}
+
+// notifyWithTimeout delivers the notification through the runtime, failing with
+// kubecontainer.ErrNotificationTimedOut if the handler is still running when the
+// deadline passes. The runtime cannot interrupt a handler mid-flight, so a timed
+// out handler may keep running in the container; only its result is discarded.
+func notifyWithTimeout(notifier kubecontainer.ContainerNotifier, id kubecontainer.ContainerID, message string, action *kubecontainer.NotificationAction, payload []byte, timeout time.Duration) (*kubecontainer.NotificationResult, error) {
+	type response struct {
+		result *kubecontainer.NotificationResult
+		err    error
+	}
+	ch := make(chan response, 1)
+	go func() {
+		result, err := notifier.NotifyContainer(id, message, action, payload)
+		ch <- response{result: result, err: err}
+	}()
+	select {
+	case resp := <-ch:
+		return resp.result, resp.err
+	case <-time.After(timeout):
+		return nil, kubecontainer.ErrNotificationTimedOut
+	}
+}
+
+// notificationAction translates a declared notification handler into the action
+// the container runtime should perform to deliver it. Named HTTP ports are
+// resolved against the container's port list.
+func notificationAction(handler *api.NotificationHandler, container *api.Container) (*kubecontainer.NotificationAction, error) {
+	switch {
+	case handler.Exec != nil:
+		// Copy the command so that parameter expansion does not write back
+		// into the pod spec cached by the pod manager.
+		return &kubecontainer.NotificationAction{Exec: append([]string(nil), handler.Exec.Command...)}, nil
+	case handler.HTTPGet != nil:
+		port, err := lifecycle.ResolvePort(handler.HTTPGet.Port, container)
+		if err != nil {
+			return nil, err
+		}
+		return &kubecontainer.NotificationAction{HTTPPort: port, HTTPPath: handler.HTTPGet.Path}, nil
+	case handler.Signal != nil:
+		return &kubecontainer.NotificationAction{Signal: handler.Signal.Signal}, nil
+	}
+	return nil, fmt.Errorf("no action declared in notification handler")
+}
+
+// actionDeclaresParam returns true if the action references the named
+// parameter as a $(NAME) placeholder in its exec command or HTTP path.
+func actionDeclaresParam(action *kubecontainer.NotificationAction, name string) bool {
+	placeholder := "$(" + name + ")"
+	for _, arg := range action.Exec {
+		if strings.Contains(arg, placeholder) {
+			return true
+		}
+	}
+	return strings.Contains(action.HTTPPath, placeholder)
+}
+
+// expandNotificationAction substitutes the provided parameters for their
+// $(NAME) placeholders in the action. Placeholders without a matching
+// parameter are left untouched.
+func expandNotificationAction(action *kubecontainer.NotificationAction, params map[string]string) {
+	if len(params) == 0 {
+		return
+	}
+	mapping := expansion.MappingFuncFor(params)
+	for i, arg := range action.Exec {
+		action.Exec[i] = expansion.Expand(arg, mapping)
+	}
+	action.HTTPPath = expansion.Expand(action.HTTPPath, mapping)
+}
+
 // ExecInContainer executes a command in a container, connecting the supplied
 // stdin/stdout/stderr to the command's IO streams.
 func (kl *Kubelet) ExecInContainer(podFullName string, podUID types.UID, containerName string, cmd []string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool, resize <-chan term.Size, timeout time.Duration) error {
@@ -1523,3 +1523,17 @@ func TestTruncatePodHostname(t *testing.T) {
 		assert.Equal(t, test.output, output)
 	}
 }
+
+func TestExpandNotificationActionDoesNotMutateHandler(t *testing.T) {
+	handler := &api.NotificationHandler{
+		Exec: &api.ExecAction{Command: []string{"notify", "--level=$(LEVEL)"}},
+	}
+	action, err := notificationAction(handler, &api.Container{})
+	assert.NoError(t, err)
+
+	expandNotificationAction(action, map[string]string{"LEVEL": "high"})
+	assert.Equal(t, []string{"notify", "--level=high"}, action.Exec)
+	// The handler comes from the pod spec cached by the pod manager, so the
+	// expansion must not write back into it.
+	assert.Equal(t, []string{"notify", "--level=$(LEVEL)"}, handler.Exec.Command)
+}
@@ -119,6 +119,7 @@ func NewFakeKubeRuntimeManager(runtimeService internalApi.RuntimeService, imageS
 		kubeRuntimeManager,
 		flowcontrol.NewBackOff(time.Second, 300*time.Second),
 		false,
+		1,
 		0, // Disable image pull throttling by setting QPS to 0,
 		0,
 	)
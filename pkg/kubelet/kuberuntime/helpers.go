@@ -234,7 +234,7 @@ func buildFullContainerLogsPath(podUID types.UID, containerName string, restartC
 
 // buildPodLogsDirectory builds absolute log directory path for a pod sandbox.
 func buildPodLogsDirectory(podUID types.UID) string {
-	return filepath.Join(podLogsRootDirectory, string(podUID))
+	return filepath.Join(PodLogsRootDirectory, string(podUID))
 }
 
 // toKubeRuntimeStatus converts the runtimeApi.RuntimeStatus to kubecontainer.RuntimeStatus.
@@ -294,9 +294,9 @@ func (cgc *containerGC) evictPodLogsDirectories(allSourcesReady bool) error {
 	osInterface := cgc.manager.osInterface
 	if allSourcesReady {
 		// Only remove pod logs directories when all sources are ready.
-		dirs, err := osInterface.ReadDir(podLogsRootDirectory)
+		dirs, err := osInterface.ReadDir(PodLogsRootDirectory)
 		if err != nil {
-			return fmt.Errorf("failed to read podLogsRootDirectory %q: %v", podLogsRootDirectory, err)
+			return fmt.Errorf("failed to read PodLogsRootDirectory %q: %v", PodLogsRootDirectory, err)
 		}
 		for _, dir := range dirs {
 			name := dir.Name()
@@ -304,7 +304,7 @@ func (cgc *containerGC) evictPodLogsDirectories(allSourcesReady bool) error {
 			if !cgc.isPodDeleted(podUID) {
 				continue
 			}
-			err := osInterface.RemoveAll(filepath.Join(podLogsRootDirectory, name))
+			err := osInterface.RemoveAll(filepath.Join(PodLogsRootDirectory, name))
 			if err != nil {
 				glog.Errorf("Failed to remove pod logs directory %q: %v", name, err)
 			}
@@ -306,7 +306,7 @@ func TestPodLogDirectoryGC(t *testing.T) {
 	fakePodGetter.pods["123"] = makeTestPod("foo1", "new", "123", nil)
 	fakePodGetter.pods["456"] = makeTestPod("foo2", "new", "456", nil)
 	files := []string{"123", "456", "789", "012"}
-	removed := []string{filepath.Join(podLogsRootDirectory, "789"), filepath.Join(podLogsRootDirectory, "012")}
+	removed := []string{filepath.Join(PodLogsRootDirectory, "789"), filepath.Join(PodLogsRootDirectory, "012")}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -25,6 +25,8 @@ import (
 	"io"
 	"math"
 	"os"
+	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/docker/docker/pkg/jsonlog"
@@ -34,13 +36,12 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 )
 
-// Notice that the current kuberuntime logs implementation doesn't handle
-// log rotation.
-// * It will not retrieve logs in rotated log file.
-// * If log rotation happens when following the log:
-//   * If the rotation is using create mode, we'll still follow the old file.
-//   * If the rotation is using copytruncate, we'll be reading at the original position and get nothing.
-// TODO(random-liu): Support log rotation.
+// The kubelet rotates container logs with copy-truncate (see
+// pkg/kubelet/logs), writing rotated files next to the log file with a
+// timestamp suffix. ReadLogs includes those rotated files, oldest first, so
+// `kubectl logs` sees the logs across rotations. Only the live log file is
+// followed; a rotation that happens while following loses the lines written
+// between the copy and the truncation.
 
 // streamType is the type of the stream.
 type streamType string
@@ -108,22 +109,129 @@ func newLogOptions(apiOpts *api.PodLogOptions, now time.Time) *logOptions {
 	return opts
 }
 
-// ReadLogs read the container log and redirect into stdout and stderr.
+// ReadLogs reads the container log, including its rotated files, and
+// redirects the entries into stdout and stderr.
 func ReadLogs(path string, apiOpts *api.PodLogOptions, stdout, stderr io.Writer) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return fmt.Errorf("failed to open log file %q: %v", path, err)
-	}
-	defer f.Close()
-
 	// Convert api.PodLogOptions into internal log options.
 	opts := newLogOptions(apiOpts, time.Now())
 
+	paths := logFilePaths(path)
 	// Search start point based on tail line.
-	start, err := tail(f, opts.tail)
+	first, start, err := tailAcrossFiles(paths, opts.tail)
 	if err != nil {
 		return fmt.Errorf("failed to tail %d lines of log file %q: %v", opts.tail, path, err)
 	}
+
+	writer := newLogWriter(stdout, stderr, opts)
+	for i := first; i < len(paths); i++ {
+		offset := int64(0)
+		if i == first {
+			offset = start
+		}
+		// Only the live log file can be followed.
+		follow := opts.follow && i == len(paths)-1
+		if err := readLogFile(paths[i], offset, opts, writer, follow); err != nil {
+			if err == errMaximumWrite {
+				glog.V(2).Infof("Finish parsing log file %q, hit bytes limit %d(bytes)", path, opts.bytes)
+				return nil
+			}
+			if os.IsNotExist(err) {
+				// A rotated file may be pruned between listing and reading it.
+				if i < len(paths)-1 {
+					continue
+				}
+				return fmt.Errorf("failed to open log file %q: %v", paths[i], err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// logFilePaths returns the rotated files of the given container log, oldest
+// first, followed by the log file itself. The fixed width timestamp suffix of
+// rotated files makes their lexical order the chronological one.
+func logFilePaths(path string) []string {
+	rotated, err := filepath.Glob(path + ".*")
+	if err != nil {
+		// Glob only fails on a malformed pattern; fall back to the live file.
+		return []string{path}
+	}
+	sort.Strings(rotated)
+	return append(rotated, path)
+}
+
+// tailAcrossFiles returns the index of the file to start reading at and the
+// offset within that file, such that at most n lines are read across the
+// given files. If n < 0 everything is read from the first file on.
+func tailAcrossFiles(paths []string, n int64) (int, int64, error) {
+	if n < 0 {
+		return 0, 0, nil
+	}
+	remain := n
+	for i := len(paths) - 1; i > 0; i-- {
+		f, err := os.Open(paths[i])
+		if err != nil {
+			// A rotated file may be pruned between listing and opening it.
+			if os.IsNotExist(err) && i < len(paths)-1 {
+				continue
+			}
+			return 0, 0, err
+		}
+		count, err := countLines(f)
+		if err != nil {
+			f.Close()
+			return 0, 0, err
+		}
+		if count >= remain {
+			start, err := tail(f, remain)
+			f.Close()
+			return i, start, err
+		}
+		remain -= count
+		f.Close()
+	}
+	f, err := os.Open(paths[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	start, err := tail(f, remain)
+	return 0, start, err
+}
+
+// countLines returns the number of complete lines in the file.
+func countLines(f io.ReadSeeker) (int64, error) {
+	if _, err := f.Seek(0, os.SEEK_SET); err != nil {
+		return 0, err
+	}
+	var cnt int64
+	buf := make([]byte, blockSize)
+	for {
+		n, err := f.Read(buf)
+		cnt += int64(bytes.Count(buf[:n], eol))
+		if err == io.EOF {
+			return cnt, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// readLogFile reads one log file from the given offset and writes the parsed
+// entries through the writer, optionally following the file for new entries.
+// It returns errMaximumWrite once the writer hits its bytes limit.
+func readLogFile(path string, start int64, opts *logOptions, writer *logWriter, follow bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return err
+		}
+		return fmt.Errorf("failed to open log file %q: %v", path, err)
+	}
+	defer f.Close()
+
 	if _, err := f.Seek(start, os.SEEK_SET); err != nil {
 		return fmt.Errorf("failed to seek %d in log file %q: %v", start, path, err)
 	}
@@ -133,7 +241,6 @@ func ReadLogs(path string, apiOpts *api.PodLogOptions, stdout, stderr io.Writer)
 	// Do not create watcher here because it is not needed if `Follow` is false.
 	var watcher *fsnotify.Watcher
 	var parse parseFunc
-	writer := newLogWriter(stdout, stderr, opts)
 	msg := &logMessage{}
 	for {
 		l, err := r.ReadBytes(eol[0])
@@ -141,7 +248,7 @@ func ReadLogs(path string, apiOpts *api.PodLogOptions, stdout, stderr io.Writer)
 			if err != io.EOF { // This is an real error
 				return fmt.Errorf("failed to read log file %q: %v", path, err)
 			}
-			if !opts.follow {
+			if !follow {
 				// Return directly when reading to the end if not follow.
 				if len(l) > 0 {
 					glog.Warningf("Incomplete line in log file %q: %q", path, l)
@@ -186,8 +293,7 @@ func ReadLogs(path string, apiOpts *api.PodLogOptions, stdout, stderr io.Writer)
 		// Write the log line into the stream.
 		if err := writer.write(msg); err != nil {
 			if err == errMaximumWrite {
-				glog.V(2).Infof("Finish parsing log file %q, hit bytes limit %d(bytes)", path, opts.bytes)
-				return nil
+				return err
 			}
 			glog.Errorf("Failed with err %v when writing log for log file %q: %+v", err, path, msg)
 		}
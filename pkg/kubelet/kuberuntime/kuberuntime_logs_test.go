@@ -18,6 +18,10 @@ package kuberuntime
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -267,3 +271,46 @@ func TestTail(t *testing.T) {
 		assert.Equal(t, s, test.start)
 	}
 }
+
+func TestReadRotatedLogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kuberuntime-logs-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "container_0.log")
+	line := func(i int) string {
+		return fmt.Sprintf("2016-10-06T00:17:0%d.000000000Z stdout line %d\n", i, i)
+	}
+	// Two rotated files and the live log file, containing lines 0..5.
+	assert.NoError(t, ioutil.WriteFile(path+".20161006-001700", []byte(line(0)+line(1)), 0644))
+	assert.NoError(t, ioutil.WriteFile(path+".20161006-001702", []byte(line(2)+line(3)), 0644))
+	assert.NoError(t, ioutil.WriteFile(path, []byte(line(4)+line(5)), 0644))
+
+	for c, test := range []struct {
+		apiOpts *api.PodLogOptions
+		expect  string
+	}{
+		{ // all lines, across all files in order
+			apiOpts: &api.PodLogOptions{},
+			expect:  "line 0\nline 1\nline 2\nline 3\nline 4\nline 5\n",
+		},
+		{ // tail within the live file
+			apiOpts: &api.PodLogOptions{TailLines: &[]int64{1}[0]},
+			expect:  "line 5\n",
+		},
+		{ // tail spanning the live and a rotated file
+			apiOpts: &api.PodLogOptions{TailLines: &[]int64{3}[0]},
+			expect:  "line 3\nline 4\nline 5\n",
+		},
+		{ // tail longer than all logs
+			apiOpts: &api.PodLogOptions{TailLines: &[]int64{10}[0]},
+			expect:  "line 0\nline 1\nline 2\nline 3\nline 4\nline 5\n",
+		},
+	} {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		assert.NoError(t, ReadLogs(path, test.apiOpts, stdout, stderr), "TestCase #%d", c)
+		assert.Equal(t, test.expect, stdout.String(), "TestCase #%d", c)
+		assert.Empty(t, stderr.String(), "TestCase #%d", c)
+	}
+}
@@ -49,7 +49,7 @@ const (
 	// The api version of kubelet runtime api
 	kubeRuntimeAPIVersion = "0.1.0"
 	// The root directory for pod logs
-	podLogsRootDirectory = "/var/log/pods"
+	PodLogsRootDirectory = "/var/log/pods"
 	// A minimal shutdown window for avoiding unnecessary SIGKILLs
 	minimumGracePeriodInSeconds = 2
 
@@ -127,6 +127,7 @@ func NewKubeGenericRuntimeManager(
 	httpClient types.HttpGetter,
 	imageBackOff *flowcontrol.Backoff,
 	serializeImagePulls bool,
+	maxParallelImagePulls int,
 	imagePullQPS float32,
 	imagePullBurst int,
 	cpuCFSQuota bool,
@@ -169,11 +170,11 @@ func NewKubeGenericRuntimeManager(
 		typedVersion.GetRuntimeApiVersion())
 
 	// If the container logs directory does not exist, create it.
-	// TODO: create podLogsRootDirectory at kubelet.go when kubelet is refactored to
+	// TODO: create PodLogsRootDirectory at kubelet.go when kubelet is refactored to
 	// new runtime interface
-	if _, err := osInterface.Stat(podLogsRootDirectory); os.IsNotExist(err) {
-		if err := osInterface.MkdirAll(podLogsRootDirectory, 0755); err != nil {
-			glog.Errorf("Failed to create directory %q: %v", podLogsRootDirectory, err)
+	if _, err := osInterface.Stat(PodLogsRootDirectory); os.IsNotExist(err) {
+		if err := osInterface.MkdirAll(PodLogsRootDirectory, 0755); err != nil {
+			glog.Errorf("Failed to create directory %q: %v", PodLogsRootDirectory, err)
 		}
 	}
 
@@ -182,6 +183,7 @@ func NewKubeGenericRuntimeManager(
 		kubeRuntimeManager,
 		imageBackOff,
 		serializeImagePulls,
+		maxParallelImagePulls,
 		imagePullQPS,
 		imagePullBurst)
 	kubeRuntimeManager.runner = lifecycle.NewHandlerRunner(httpClient, kubeRuntimeManager, kubeRuntimeManager)
@@ -50,7 +50,7 @@ func TestCreatePodSandbox(t *testing.T) {
 	fakeOS := m.osInterface.(*containertest.FakeOS)
 	fakeOS.MkdirAllFn = func(path string, perm os.FileMode) error {
 		// Check pod logs root directory is created.
-		assert.Equal(t, filepath.Join(podLogsRootDirectory, "12345678"), path)
+		assert.Equal(t, filepath.Join(PodLogsRootDirectory, "12345678"), path)
 		assert.Equal(t, os.FileMode(0755), perm)
 		return nil
 	}
@@ -0,0 +1,101 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/kubelet/metrics"
+)
+
+// AdmitHandlerChain invokes pod admit handlers in the order they were added,
+// recording per-handler latency and rejection metrics. The first handler that
+// rejects the pod decides the result; remaining handlers are not consulted.
+type AdmitHandlerChain struct {
+	handlers []namedAdmitHandler
+}
+
+type namedAdmitHandler struct {
+	name    string
+	handler PodAdmitHandler
+}
+
+var _ PodAdmitTarget = &AdmitHandlerChain{}
+
+// AddNamedPodAdmitHandler appends the handler to the chain under the given
+// name. The name identifies the handler in metrics and log messages.
+func (c *AdmitHandlerChain) AddNamedPodAdmitHandler(name string, handler PodAdmitHandler) {
+	c.handlers = append(c.handlers, namedAdmitHandler{name: name, handler: handler})
+}
+
+// AddPodAdmitHandler implements PodAdmitTarget. The handler is named after its
+// Go type.
+func (c *AdmitHandlerChain) AddPodAdmitHandler(a PodAdmitHandler) {
+	c.AddNamedPodAdmitHandler(strings.TrimPrefix(fmt.Sprintf("%T", a), "*"), a)
+}
+
+// Admit invokes each handler in the chain in sequence. If any handler rejects,
+// the pod is rejected.
+func (c *AdmitHandlerChain) Admit(attrs *PodAdmitAttributes) PodAdmitResult {
+	for _, h := range c.handlers {
+		start := time.Now()
+		result := h.handler.Admit(attrs)
+		metrics.PodAdmissionLatency.WithLabelValues(h.name).Observe(metrics.SinceInMicroseconds(start))
+		if !result.Admit {
+			metrics.PodAdmissionRejections.WithLabelValues(h.name, result.Reason).Inc()
+			return result
+		}
+	}
+	return PodAdmitResult{Admit: true}
+}
+
+// Registered handlers appended to every kubelet's admission chain, after the
+// built-in handlers.
+var registeredAdmitHandlersMutex sync.Mutex
+var registeredAdmitHandlers []namedAdmitHandler
+
+// RegisterPodAdmitHandler is called by out-of-tree admit handler
+// implementations on initialization to register themselves, like so:
+//   func init() {
+//    	lifecycle.RegisterPodAdmitHandler("name", &myHandler{...})
+//   }
+// The package must be compiled into the kubelet; registered handlers run after
+// the built-in handlers, in registration order.
+func RegisterPodAdmitHandler(name string, handler PodAdmitHandler) {
+	registeredAdmitHandlersMutex.Lock()
+	defer registeredAdmitHandlersMutex.Unlock()
+	for _, h := range registeredAdmitHandlers {
+		if h.name == name {
+			glog.Fatalf("Pod admit handler %q was registered twice", name)
+		}
+	}
+	glog.V(4).Infof("Registered pod admit handler %q", name)
+	registeredAdmitHandlers = append(registeredAdmitHandlers, namedAdmitHandler{name: name, handler: handler})
+}
+
+// AddRegisteredAdmitHandlers appends every handler registered through
+// RegisterPodAdmitHandler to the chain, in registration order.
+func (c *AdmitHandlerChain) AddRegisteredAdmitHandlers() {
+	registeredAdmitHandlersMutex.Lock()
+	defer registeredAdmitHandlersMutex.Unlock()
+	c.handlers = append(c.handlers, registeredAdmitHandlers...)
+}
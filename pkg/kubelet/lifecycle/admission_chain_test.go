@@ -0,0 +1,87 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// fakeAdmitHandler records its invocation and returns a canned result.
+type fakeAdmitHandler struct {
+	id      string
+	result  PodAdmitResult
+	invoked *[]string
+}
+
+func (f *fakeAdmitHandler) Admit(attrs *PodAdmitAttributes) PodAdmitResult {
+	*f.invoked = append(*f.invoked, f.id)
+	return f.result
+}
+
+func TestAdmitHandlerChainOrder(t *testing.T) {
+	invoked := []string{}
+	admit := PodAdmitResult{Admit: true}
+	chain := &AdmitHandlerChain{}
+	chain.AddNamedPodAdmitHandler("first", &fakeAdmitHandler{id: "first", result: admit, invoked: &invoked})
+	chain.AddNamedPodAdmitHandler("second", &fakeAdmitHandler{id: "second", result: admit, invoked: &invoked})
+	chain.AddNamedPodAdmitHandler("third", &fakeAdmitHandler{id: "third", result: admit, invoked: &invoked})
+
+	result := chain.Admit(&PodAdmitAttributes{Pod: &api.Pod{}})
+	if !result.Admit {
+		t.Errorf("expected the pod to be admitted, got %+v", result)
+	}
+	if expected := []string{"first", "second", "third"}; !reflect.DeepEqual(expected, invoked) {
+		t.Errorf("expected handlers to run in order %v, got %v", expected, invoked)
+	}
+}
+
+func TestAdmitHandlerChainFirstRejectionWins(t *testing.T) {
+	invoked := []string{}
+	chain := &AdmitHandlerChain{}
+	chain.AddNamedPodAdmitHandler("first", &fakeAdmitHandler{id: "first", result: PodAdmitResult{Admit: true}, invoked: &invoked})
+	chain.AddNamedPodAdmitHandler("second", &fakeAdmitHandler{id: "second", result: PodAdmitResult{Admit: false, Reason: "OutOfLuck", Message: "no"}, invoked: &invoked})
+	chain.AddNamedPodAdmitHandler("third", &fakeAdmitHandler{id: "third", result: PodAdmitResult{Admit: true}, invoked: &invoked})
+
+	result := chain.Admit(&PodAdmitAttributes{Pod: &api.Pod{}})
+	if result.Admit {
+		t.Errorf("expected the pod to be rejected")
+	}
+	if result.Reason != "OutOfLuck" {
+		t.Errorf("expected reason OutOfLuck, got %q", result.Reason)
+	}
+	if expected := []string{"first", "second"}; !reflect.DeepEqual(expected, invoked) {
+		t.Errorf("expected the chain to stop at the rejecting handler, got %v", invoked)
+	}
+}
+
+func TestRegisteredAdmitHandlers(t *testing.T) {
+	invoked := []string{}
+	admit := PodAdmitResult{Admit: true}
+	RegisterPodAdmitHandler("testRegistered", &fakeAdmitHandler{id: "registered", result: admit, invoked: &invoked})
+
+	chain := &AdmitHandlerChain{}
+	chain.AddNamedPodAdmitHandler("builtin", &fakeAdmitHandler{id: "builtin", result: admit, invoked: &invoked})
+	chain.AddRegisteredAdmitHandlers()
+
+	chain.Admit(&PodAdmitAttributes{Pod: &api.Pod{}})
+	if expected := []string{"builtin", "registered"}; !reflect.DeepEqual(expected, invoked) {
+		t.Errorf("expected registered handlers to run after built-in handlers, got %v", invoked)
+	}
+}
@@ -77,13 +77,13 @@ func (hr *HandlerRunner) Run(containerID kubecontainer.ContainerID, pod *api.Pod
 	}
 }
 
-// resolvePort attempts to turn an IntOrString port reference into a concrete port number.
+// ResolvePort attempts to turn an IntOrString port reference into a concrete port number.
 // If portReference has an int value, it is treated as a literal, and simply returns that value.
 // If portReference is a string, an attempt is first made to parse it as an integer.  If that fails,
 // an attempt is made to find a port with the same name in the container spec.
 // If a port with the same name is found, it's ContainerPort value is returned.  If no matching
 // port is found, an error is returned.
-func resolvePort(portReference intstr.IntOrString, container *api.Container) (int, error) {
+func ResolvePort(portReference intstr.IntOrString, container *api.Container) (int, error) {
 	if portReference.Type == intstr.Int {
 		return portReference.IntValue(), nil
 	}
@@ -118,7 +118,7 @@ func (hr *HandlerRunner) runHTTPHandler(pod *api.Pod, container *api.Container,
 		port = 80
 	} else {
 		var err error
-		port, err = resolvePort(handler.HTTPGet.Port, container)
+		port, err = ResolvePort(handler.HTTPGet.Port, container)
 		if err != nil {
 			return "", err
 		}
@@ -32,7 +32,7 @@ import (
 
 func TestResolvePortInt(t *testing.T) {
 	expected := 80
-	port, err := resolvePort(intstr.FromInt(expected), &api.Container{})
+	port, err := ResolvePort(intstr.FromInt(expected), &api.Container{})
 	if port != expected {
 		t.Errorf("expected: %d, saw: %d", expected, port)
 	}
@@ -49,7 +49,7 @@ func TestResolvePortString(t *testing.T) {
 			{Name: name, ContainerPort: int32(expected)},
 		},
 	}
-	port, err := resolvePort(intstr.FromString(name), container)
+	port, err := ResolvePort(intstr.FromString(name), container)
 	if port != expected {
 		t.Errorf("expected: %d, saw: %d", expected, port)
 	}
@@ -66,7 +66,7 @@ func TestResolvePortStringUnknown(t *testing.T) {
 			{Name: "bar", ContainerPort: expected},
 		},
 	}
-	port, err := resolvePort(intstr.FromString(name), container)
+	port, err := ResolvePort(intstr.FromString(name), container)
 	if port != -1 {
 		t.Errorf("expected: -1, saw: %d", port)
 	}
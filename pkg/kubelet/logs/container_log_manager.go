@@ -0,0 +1,163 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+const (
+	// logMonitorPeriod is the period container log files are checked against
+	// the rotation policy.
+	logMonitorPeriod = 10 * time.Second
+	// timestampFormat is the format of the rotated log file suffix. It is
+	// fixed width so that the lexical order of rotated file names is also
+	// their chronological order.
+	timestampFormat = "20060102-150405"
+)
+
+// LogRotatePolicy is the policy container log rotation applies to every
+// container log file managed by the kubelet.
+type LogRotatePolicy struct {
+	// MaxSize is the size in bytes a log file may reach before it is rotated.
+	MaxSize int64
+	// MaxFiles is the maximum number of log files that can be present for a
+	// container, including the file being written to.
+	MaxFiles int
+}
+
+// ContainerLogManager bounds the disk usage of container log files written
+// under the kubelet's pod log directory by rotating files that exceed the
+// configured size and pruning the oldest rotated files.
+type ContainerLogManager interface {
+	// Start the periodic log rotation loop.
+	Start()
+}
+
+type containerLogManager struct {
+	// logDir is the directory below which container log files are managed,
+	// laid out as <logDir>/<pod uid>/<container log file>.
+	logDir string
+	policy LogRotatePolicy
+}
+
+// NewContainerLogManager returns a ContainerLogManager rotating the container
+// log files under logDir according to the given policy.
+func NewContainerLogManager(logDir string, policy LogRotatePolicy) (ContainerLogManager, error) {
+	if policy.MaxSize <= 0 {
+		return nil, fmt.Errorf("invalid container log max size %d, must be positive", policy.MaxSize)
+	}
+	if policy.MaxFiles < 2 {
+		return nil, fmt.Errorf("invalid container log max files %d, must be at least 2", policy.MaxFiles)
+	}
+	return &containerLogManager{
+		logDir: logDir,
+		policy: policy,
+	}, nil
+}
+
+func (c *containerLogManager) Start() {
+	go wait.Forever(func() {
+		if err := c.rotateLogs(); err != nil {
+			glog.Errorf("Failed to rotate container logs: %v", err)
+		}
+	}, logMonitorPeriod)
+}
+
+// rotateLogs checks every container log file against the rotation policy and
+// rotates the files which exceed the maximum size.
+func (c *containerLogManager) rotateLogs() error {
+	logs, err := filepath.Glob(filepath.Join(c.logDir, "*", "*.log"))
+	if err != nil {
+		return fmt.Errorf("failed to list container log files in %q: %v", c.logDir, err)
+	}
+	for _, log := range logs {
+		info, err := os.Stat(log)
+		if err != nil {
+			// The container may have just been removed, which is not an error.
+			if !os.IsNotExist(err) {
+				glog.Errorf("Failed to stat container log %q: %v", log, err)
+			}
+			continue
+		}
+		if info.Size() < c.policy.MaxSize {
+			continue
+		}
+		if err := c.rotateLog(log); err != nil {
+			glog.Errorf("Failed to rotate container log %q: %v", log, err)
+		}
+	}
+	return nil
+}
+
+// rotateLog copies the log file aside and truncates it in place, so that the
+// runtime keeps writing to the same inode and does not need to reopen the
+// log. Log lines written between the copy and the truncation are lost, which
+// is the usual copy-truncate rotation tradeoff.
+func (c *containerLogManager) rotateLog(log string) error {
+	rotated := fmt.Sprintf("%s.%s", log, time.Now().Format(timestampFormat))
+	if err := copyFile(log, rotated); err != nil {
+		return err
+	}
+	if err := os.Truncate(log, 0); err != nil {
+		return fmt.Errorf("failed to truncate %q: %v", log, err)
+	}
+	return c.removeExcessRotatedLogs(log)
+}
+
+// removeExcessRotatedLogs removes the oldest rotated files of the log until
+// at most MaxFiles files, including the log itself, remain.
+func (c *containerLogManager) removeExcessRotatedLogs(log string) error {
+	rotated, err := filepath.Glob(log + ".*")
+	if err != nil {
+		return fmt.Errorf("failed to list rotated files of %q: %v", log, err)
+	}
+	sort.Strings(rotated)
+	for len(rotated) > c.policy.MaxFiles-1 {
+		if err := os.Remove(rotated[0]); err != nil {
+			return fmt.Errorf("failed to remove rotated log %q: %v", rotated[0], err)
+		}
+		rotated = rotated[1:]
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %v", src, err)
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %v", dst, err)
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("failed to copy %q to %q: %v", src, dst, err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewContainerLogManagerPolicyValidation(t *testing.T) {
+	if _, err := NewContainerLogManager("/var/log/pods", LogRotatePolicy{MaxSize: 0, MaxFiles: 5}); err == nil {
+		t.Errorf("expected an error for non-positive max size")
+	}
+	if _, err := NewContainerLogManager("/var/log/pods", LogRotatePolicy{MaxSize: 1024, MaxFiles: 1}); err == nil {
+		t.Errorf("expected an error for max files below 2")
+	}
+	if _, err := NewContainerLogManager("/var/log/pods", LogRotatePolicy{MaxSize: 1024, MaxFiles: 2}); err != nil {
+		t.Errorf("unexpected error for a valid policy: %v", err)
+	}
+}
+
+func TestRotateLogs(t *testing.T) {
+	logDir, err := ioutil.TempDir("", "container-log-manager-test")
+	if err != nil {
+		t.Fatalf("failed to create temp log directory: %v", err)
+	}
+	defer os.RemoveAll(logDir)
+
+	podDir := filepath.Join(logDir, "poduid")
+	if err := os.Mkdir(podDir, 0755); err != nil {
+		t.Fatalf("failed to create pod log directory: %v", err)
+	}
+	smallLog := filepath.Join(podDir, "small_0.log")
+	if err := ioutil.WriteFile(smallLog, []byte("short\n"), 0644); err != nil {
+		t.Fatalf("failed to write log file: %v", err)
+	}
+	largeLog := filepath.Join(podDir, "large_0.log")
+	content := []byte("this line pushes the log over the rotation threshold\n")
+	if err := ioutil.WriteFile(largeLog, content, 0644); err != nil {
+		t.Fatalf("failed to write log file: %v", err)
+	}
+
+	m, err := NewContainerLogManager(logDir, LogRotatePolicy{MaxSize: 20, MaxFiles: 2})
+	if err != nil {
+		t.Fatalf("failed to create container log manager: %v", err)
+	}
+	if err := m.(*containerLogManager).rotateLogs(); err != nil {
+		t.Fatalf("unexpected error rotating logs: %v", err)
+	}
+
+	// The small log is left alone.
+	if rotated, _ := filepath.Glob(smallLog + ".*"); len(rotated) != 0 {
+		t.Errorf("expected no rotated files for %q, got %v", smallLog, rotated)
+	}
+	// The large log is truncated and its content moved to a rotated file.
+	info, err := os.Stat(largeLog)
+	if err != nil {
+		t.Fatalf("failed to stat rotated log: %v", err)
+	}
+	if info.Size() != 0 {
+		t.Errorf("expected %q to be truncated, got size %d", largeLog, info.Size())
+	}
+	rotated, _ := filepath.Glob(largeLog + ".*")
+	if len(rotated) != 1 {
+		t.Fatalf("expected one rotated file for %q, got %v", largeLog, rotated)
+	}
+	got, err := ioutil.ReadFile(rotated[0])
+	if err != nil {
+		t.Fatalf("failed to read rotated log: %v", err)
+	}
+	if string(got) != string(content) {
+		t.Errorf("unexpected rotated log content: expected %q, got %q", content, got)
+	}
+}
+
+func TestRemoveExcessRotatedLogs(t *testing.T) {
+	logDir, err := ioutil.TempDir("", "container-log-manager-test")
+	if err != nil {
+		t.Fatalf("failed to create temp log directory: %v", err)
+	}
+	defer os.RemoveAll(logDir)
+
+	log := filepath.Join(logDir, "container_0.log")
+	files := []string{
+		log + ".20161020-120000",
+		log + ".20161020-120100",
+		log + ".20161020-120200",
+		log,
+	}
+	for _, f := range files {
+		if err := ioutil.WriteFile(f, []byte("x\n"), 0644); err != nil {
+			t.Fatalf("failed to write log file: %v", err)
+		}
+	}
+
+	m, err := NewContainerLogManager(logDir, LogRotatePolicy{MaxSize: 1024, MaxFiles: 3})
+	if err != nil {
+		t.Fatalf("failed to create container log manager: %v", err)
+	}
+	if err := m.(*containerLogManager).removeExcessRotatedLogs(log); err != nil {
+		t.Fatalf("unexpected error removing rotated logs: %v", err)
+	}
+
+	rotated, _ := filepath.Glob(log + ".*")
+	expected := []string{log + ".20161020-120100", log + ".20161020-120200"}
+	if len(rotated) != len(expected) || rotated[0] != expected[0] || rotated[1] != expected[1] {
+		t.Errorf("expected the oldest rotated file to be removed, got %v", rotated)
+	}
+}
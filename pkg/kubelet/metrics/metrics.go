@@ -43,6 +43,19 @@ const (
 	RuntimeOperationsKey        = "runtime_operations"
 	RuntimeOperationsLatencyKey = "runtime_operations_latency_microseconds"
 	RuntimeOperationsErrorsKey  = "runtime_operations_errors"
+	// Metrics keys of eviction manager operations
+	EvictionsKey                  = "evictions"
+	EvictionLatencyKey            = "eviction_latency_microseconds"
+	EvictionThresholdCrossingsKey = "eviction_threshold_crossings"
+	EvictionNodeReclaimBytesKey   = "eviction_node_reclaim_bytes"
+	// Metrics keys of notification deliveries
+	NotificationsReceivedKey  = "notifications_received"
+	NotificationsDeliveredKey = "notifications_delivered"
+	NotificationsFailedKey    = "notifications_failed"
+	NotificationLatencyKey    = "notification_latency_microseconds"
+	// Metrics keys of pod admission
+	PodAdmissionLatencyKey    = "pod_admission_latency_microseconds"
+	PodAdmissionRejectionsKey = "pod_admission_rejections"
 )
 
 var (
@@ -169,6 +182,86 @@ var (
 		},
 		[]string{"operation_type"},
 	)
+	Evictions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      EvictionsKey,
+			Help:      "Cumulative number of pod evictions by eviction signal.",
+		},
+		[]string{"eviction_signal"},
+	)
+	EvictionLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      EvictionLatencyKey,
+			Help:      "Latency in microseconds to evict a single pod. Broken down by eviction signal.",
+		},
+		[]string{"eviction_signal"},
+	)
+	EvictionThresholdCrossings = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      EvictionThresholdCrossingsKey,
+			Help:      "Cumulative number of times an eviction threshold was newly crossed, by eviction signal.",
+		},
+		[]string{"eviction_signal"},
+	)
+	EvictionNodeReclaimBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      EvictionNodeReclaimBytesKey,
+			Help:      "Cumulative number of bytes reclaimed via node-level reclaim (e.g. image gc) by starved resource.",
+		},
+		[]string{"resource"},
+	)
+	NotificationsReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      NotificationsReceivedKey,
+			Help:      "Cumulative number of notification requests received by notification name.",
+		},
+		[]string{"notification"},
+	)
+	NotificationsDelivered = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      NotificationsDeliveredKey,
+			Help:      "Cumulative number of notifications whose handler completed by notification name.",
+		},
+		[]string{"notification"},
+	)
+	NotificationsFailed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      NotificationsFailedKey,
+			Help:      "Cumulative number of notification deliveries that failed by notification name.",
+		},
+		[]string{"notification"},
+	)
+	NotificationLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      NotificationLatencyKey,
+			Help:      "Latency in microseconds of a notification handler. Broken down by notification name.",
+		},
+		[]string{"notification"},
+	)
+	PodAdmissionLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      PodAdmissionLatencyKey,
+			Help:      "Latency in microseconds of a pod admit handler. Broken down by handler name.",
+		},
+		[]string{"handler"},
+	)
+	PodAdmissionRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeletSubsystem,
+			Name:      PodAdmissionRejectionsKey,
+			Help:      "Cumulative number of pods rejected at admission. Broken down by handler name and rejection reason.",
+		},
+		[]string{"handler", "reason"},
+	)
 )
 
 var registerMetrics sync.Once
@@ -194,6 +287,16 @@ func Register(containerCache kubecontainer.RuntimeCache) {
 		prometheus.MustRegister(RuntimeOperations)
 		prometheus.MustRegister(RuntimeOperationsLatency)
 		prometheus.MustRegister(RuntimeOperationsErrors)
+		prometheus.MustRegister(Evictions)
+		prometheus.MustRegister(EvictionLatency)
+		prometheus.MustRegister(EvictionThresholdCrossings)
+		prometheus.MustRegister(EvictionNodeReclaimBytes)
+		prometheus.MustRegister(NotificationsReceived)
+		prometheus.MustRegister(NotificationsDelivered)
+		prometheus.MustRegister(NotificationsFailed)
+		prometheus.MustRegister(NotificationLatency)
+		prometheus.MustRegister(PodAdmissionLatency)
+		prometheus.MustRegister(PodAdmissionRejections)
 	})
 }
 
@@ -0,0 +1,293 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodeshutdown watches for an impending system shutdown via systemd
+// inhibitor locks and terminates the node's pods gracefully before the node
+// powers off.
+package nodeshutdown
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	godbus "github.com/godbus/dbus"
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/kubelet/eviction"
+	"k8s.io/kubernetes/pkg/kubelet/util/format"
+	"k8s.io/kubernetes/pkg/util/clock"
+	utildbus "k8s.io/kubernetes/pkg/util/dbus"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+)
+
+const (
+	logindService   = "org.freedesktop.login1"
+	logindPath      = "/org/freedesktop/login1"
+	logindInterface = "org.freedesktop.login1.Manager"
+
+	nodeShutdownReason  = "Shutdown"
+	nodeShutdownMessage = "Node is shutting down, evicting pods"
+
+	// criticalPodAnnotationKey is the annotation that marks a pod as critical
+	// to the node, mirroring the eviction manager. Critical pods are
+	// terminated after all other pods during a node shutdown.
+	criticalPodAnnotationKey = "scheduler.alpha.kubernetes.io/critical-pod"
+)
+
+// Manager delays an impending system shutdown with a systemd inhibitor lock
+// and uses the time to terminate the node's pods gracefully. Non-critical
+// pods are terminated first so that critical pods (e.g. DNS or networking
+// addons) keep serving as long as possible.
+type Manager interface {
+	// Start acquires the inhibitor lock and begins watching for shutdown.
+	Start() error
+	// ShutdownStatus returns an error while a node shutdown is in progress,
+	// so callers can report the node as not ready.
+	ShutdownStatus() error
+}
+
+// Config holds the tunables and kubelet hooks of the shutdown manager.
+type Config struct {
+	DBus               utildbus.Interface
+	Clock              clock.Clock
+	Recorder           record.EventRecorder
+	NodeRef            *api.ObjectReference
+	GetPodsFunc        eviction.ActivePodsFunc
+	KillPodFunc        eviction.KillPodFunc
+	SyncNodeStatusFunc func()
+	// ShutdownGracePeriodRequested is the total duration the node shutdown
+	// is delayed for pod termination.
+	ShutdownGracePeriodRequested time.Duration
+	// ShutdownGracePeriodCriticalPods is the portion of the total grace
+	// period reserved for terminating critical pods.
+	ShutdownGracePeriodCriticalPods time.Duration
+}
+
+// NewManager returns a shutdown manager for the given configuration, or a
+// no-op manager if no shutdown grace period was requested or the grace
+// periods are inconsistent.
+func NewManager(conf *Config) Manager {
+	if conf.ShutdownGracePeriodRequested == 0 {
+		return &managerStub{}
+	}
+	if conf.ShutdownGracePeriodCriticalPods >= conf.ShutdownGracePeriodRequested {
+		// A critical pod grace period at least as long as the total would
+		// leave the other pods no time to terminate at all.
+		glog.Errorf("Disabling graceful node shutdown: shutdown grace period for critical pods %v must be smaller than the shutdown grace period %v", conf.ShutdownGracePeriodCriticalPods, conf.ShutdownGracePeriodRequested)
+		return &managerStub{}
+	}
+	return &managerImpl{conf: conf}
+}
+
+type managerImpl struct {
+	conf        *Config
+	inhibitLock godbus.UnixFD
+
+	lock             sync.Mutex
+	nodeShuttingDown bool
+}
+
+var _ Manager = &managerImpl{}
+
+func (m *managerImpl) Start() error {
+	systemBus, err := m.conf.DBus.SystemBus()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the system bus: %v", err)
+	}
+
+	delayMax, err := m.inhibitDelayMax(systemBus)
+	if err != nil {
+		return err
+	}
+	if delayMax < m.conf.ShutdownGracePeriodRequested {
+		glog.Warningf("Node shutdown can only be delayed by %v, which is less than the requested shutdown grace period %v. Consider raising InhibitDelayMaxSec in logind.conf.", delayMax, m.conf.ShutdownGracePeriodRequested)
+	}
+	if err := m.acquireInhibitLock(systemBus); err != nil {
+		return err
+	}
+
+	rule := fmt.Sprintf("type='signal',sender='%s',path='%s',interface='%s',member='PrepareForShutdown'", logindService, logindPath, logindInterface)
+	systemBus.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, rule)
+	signals := make(chan *godbus.Signal, 1)
+	systemBus.Signal(signals)
+
+	go func() {
+		defer utilruntime.HandleCrash()
+
+		for signal := range signals {
+			if signal.Name != logindInterface+".PrepareForShutdown" {
+				continue
+			}
+			if len(signal.Body) < 1 {
+				continue
+			}
+			if shuttingDown, ok := signal.Body[0].(bool); !ok || !shuttingDown {
+				continue
+			}
+			m.processShutdownEvent()
+		}
+	}()
+	return nil
+}
+
+func (m *managerImpl) ShutdownStatus() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.nodeShuttingDown {
+		return fmt.Errorf("node is shutting down")
+	}
+	return nil
+}
+
+// inhibitDelayMax returns the maximum duration logind delays a shutdown for
+// inhibitor lock holders.
+func (m *managerImpl) inhibitDelayMax(bus utildbus.Connection) (time.Duration, error) {
+	var value godbus.Variant
+	err := bus.Object(logindService, logindPath).Call("org.freedesktop.DBus.Properties.Get", 0, logindInterface, "InhibitDelayMaxUSec").Store(&value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read InhibitDelayMaxUSec from logind: %v", err)
+	}
+	usec, ok := value.Value().(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected type %T for InhibitDelayMaxUSec", value.Value())
+	}
+	return time.Duration(usec) * time.Microsecond, nil
+}
+
+// acquireInhibitLock takes a delay inhibitor lock on shutdown, which makes
+// logind emit PrepareForShutdown and wait before powering off the node.
+func (m *managerImpl) acquireInhibitLock(bus utildbus.Connection) error {
+	var fd godbus.UnixFD
+	err := bus.Object(logindService, logindPath).Call(logindInterface+".Inhibit", 0, "shutdown", "kubelet", "Kubelet needs time to terminate pods.", "delay").Store(&fd)
+	if err != nil {
+		return fmt.Errorf("failed to acquire shutdown inhibitor lock: %v", err)
+	}
+	m.inhibitLock = fd
+	return nil
+}
+
+// releaseInhibitLock lets the pending shutdown proceed.
+func (m *managerImpl) releaseInhibitLock() {
+	if m.inhibitLock <= 0 {
+		return
+	}
+	if err := os.NewFile(uintptr(m.inhibitLock), "inhibitor-lock").Close(); err != nil {
+		glog.Errorf("Failed to release shutdown inhibitor lock: %v", err)
+	}
+	m.inhibitLock = 0
+}
+
+// processShutdownEvent marks the node as shutting down, terminates all pods
+// and releases the inhibitor lock so the shutdown can proceed.
+func (m *managerImpl) processShutdownEvent() {
+	glog.Infof("Shutdown manager detected an impending node shutdown")
+	m.lock.Lock()
+	m.nodeShuttingDown = true
+	m.lock.Unlock()
+
+	// Mark the node as not ready so controllers stop scheduling to it and
+	// do not treat the shutdown as a crash.
+	m.conf.SyncNodeStatusFunc()
+	m.conf.Recorder.Eventf(m.conf.NodeRef, api.EventTypeNormal, nodeShutdownReason, nodeShutdownMessage)
+
+	nonCriticalPods, criticalPods := splitCriticalPods(m.conf.GetPodsFunc())
+	criticalGracePeriod := m.conf.ShutdownGracePeriodCriticalPods
+	nonCriticalGracePeriod := m.conf.ShutdownGracePeriodRequested - criticalGracePeriod
+	m.killPods(nonCriticalPods, nonCriticalGracePeriod)
+	m.killPods(criticalPods, criticalGracePeriod)
+
+	m.releaseInhibitLock()
+}
+
+// killPods terminates the given pods in parallel and waits for them, bounded
+// by the grace period so a stuck pod cannot hold up the rest of the shutdown.
+func (m *managerImpl) killPods(pods []*api.Pod, gracePeriod time.Duration) {
+	if len(pods) == 0 {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		wg.Add(1)
+		go func(pod *api.Pod) {
+			defer wg.Done()
+
+			gracePeriodOverride := int64(gracePeriod.Seconds())
+			if pod.Spec.TerminationGracePeriodSeconds != nil && *pod.Spec.TerminationGracePeriodSeconds < gracePeriodOverride {
+				gracePeriodOverride = *pod.Spec.TerminationGracePeriodSeconds
+			}
+			glog.V(2).Infof("Shutdown manager killing pod %q with grace period %d", format.Pod(pod), gracePeriodOverride)
+			status := api.PodStatus{
+				Phase:   api.PodFailed,
+				Reason:  nodeShutdownReason,
+				Message: nodeShutdownMessage,
+			}
+			if err := m.conf.KillPodFunc(pod, status, &gracePeriodOverride); err != nil {
+				glog.Errorf("Shutdown manager failed to kill pod %q: %v", format.Pod(pod), err)
+			}
+		}(pod)
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(doneCh)
+	}()
+	select {
+	case <-doneCh:
+	case <-m.conf.Clock.After(gracePeriod):
+		glog.Errorf("Shutdown manager timed out waiting for %d pods to terminate", len(pods))
+	}
+}
+
+// splitCriticalPods separates the critical pods from the others, preserving
+// the input order within each group.
+func splitCriticalPods(pods []*api.Pod) (nonCriticalPods, criticalPods []*api.Pod) {
+	for _, pod := range pods {
+		if isCriticalPod(pod) {
+			criticalPods = append(criticalPods, pod)
+		} else {
+			nonCriticalPods = append(nonCriticalPods, pod)
+		}
+	}
+	return nonCriticalPods, criticalPods
+}
+
+// isCriticalPod returns true if the pod is critical to the node. Only pods
+// in the kube-system namespace may be marked critical.
+func isCriticalPod(pod *api.Pod) bool {
+	if pod.Namespace != api.NamespaceSystem {
+		return false
+	}
+	_, found := pod.Annotations[criticalPodAnnotationKey]
+	return found
+}
+
+// managerStub is used when graceful node shutdown is disabled.
+type managerStub struct{}
+
+var _ Manager = &managerStub{}
+
+func (m *managerStub) Start() error {
+	return nil
+}
+
+func (m *managerStub) ShutdownStatus() error {
+	return nil
+}
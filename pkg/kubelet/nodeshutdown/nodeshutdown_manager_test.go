@@ -0,0 +1,165 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeshutdown
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	godbus "github.com/godbus/dbus"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util/clock"
+	utildbus "k8s.io/kubernetes/pkg/util/dbus"
+)
+
+func makePod(name, namespace string, critical bool, gracePeriod *int64) *api.Pod {
+	pod := &api.Pod{}
+	pod.Name = name
+	pod.Namespace = namespace
+	pod.UID = types.UID("uid-" + name)
+	if critical {
+		pod.Annotations = map[string]string{criticalPodAnnotationKey: ""}
+	}
+	pod.Spec.TerminationGracePeriodSeconds = gracePeriod
+	return pod
+}
+
+func TestManagerDisabled(t *testing.T) {
+	configs := map[string]*Config{
+		"no grace period requested": {},
+		"critical pod grace period not smaller than the total": {
+			ShutdownGracePeriodRequested:    10 * time.Second,
+			ShutdownGracePeriodCriticalPods: 10 * time.Second,
+		},
+	}
+	for name, conf := range configs {
+		manager := NewManager(conf)
+		if _, enabled := manager.(*managerImpl); enabled {
+			t.Errorf("%s: the manager should be disabled", name)
+		}
+		if err := manager.Start(); err != nil {
+			t.Errorf("%s: Start() of the disabled manager failed: %v", name, err)
+		}
+		if err := manager.ShutdownStatus(); err != nil {
+			t.Errorf("%s: ShutdownStatus() of the disabled manager failed: %v", name, err)
+		}
+	}
+}
+
+func TestProcessShutdownEvent(t *testing.T) {
+	gracePeriod := int64(5)
+	pods := []*api.Pod{
+		makePod("regular", "default", false, nil),
+		makePod("short-grace", "default", false, &gracePeriod),
+		makePod("critical", api.NamespaceSystem, true, nil),
+	}
+
+	type kill struct {
+		name        string
+		gracePeriod int64
+	}
+	var (
+		lock            sync.Mutex
+		kills           []kill
+		nodeStatusSyncs int
+	)
+	manager := &managerImpl{conf: &Config{
+		Clock:    clock.RealClock{},
+		Recorder: &record.FakeRecorder{},
+		NodeRef:  &api.ObjectReference{Kind: "Node", Name: "test-node"},
+		GetPodsFunc: func() []*api.Pod {
+			return pods
+		},
+		KillPodFunc: func(pod *api.Pod, status api.PodStatus, gracePeriodOverride *int64) error {
+			lock.Lock()
+			defer lock.Unlock()
+			kills = append(kills, kill{name: pod.Name, gracePeriod: *gracePeriodOverride})
+			return nil
+		},
+		SyncNodeStatusFunc: func() {
+			nodeStatusSyncs++
+		},
+		ShutdownGracePeriodRequested:    30 * time.Second,
+		ShutdownGracePeriodCriticalPods: 10 * time.Second,
+	}}
+
+	manager.processShutdownEvent()
+
+	if err := manager.ShutdownStatus(); err == nil {
+		t.Errorf("expected ShutdownStatus() to fail during shutdown")
+	}
+	if nodeStatusSyncs != 1 {
+		t.Errorf("expected 1 node status sync, got %d", nodeStatusSyncs)
+	}
+	if len(kills) != len(pods) {
+		t.Fatalf("expected %d killed pods, got %v", len(pods), kills)
+	}
+	// The critical pod must be terminated after all other pods.
+	if kills[2].name != "critical" {
+		t.Errorf("expected the critical pod to be killed last, got %v", kills)
+	}
+	for _, k := range kills {
+		var expected int64
+		switch k.name {
+		case "regular":
+			expected = 20
+		case "short-grace":
+			expected = 5
+		case "critical":
+			expected = 10
+		}
+		if k.gracePeriod != expected {
+			t.Errorf("pod %q killed with grace period %d, expected %d", k.name, k.gracePeriod, expected)
+		}
+	}
+}
+
+func TestStart(t *testing.T) {
+	fakeConn := utildbus.NewFakeConnection()
+	fakeConn.SetBusObject(func(method string, args ...interface{}) ([]interface{}, error) {
+		return nil, nil
+	})
+	inhibited := false
+	fakeConn.AddObject(logindService, logindPath, func(method string, args ...interface{}) ([]interface{}, error) {
+		switch method {
+		case "org.freedesktop.DBus.Properties.Get":
+			return []interface{}{godbus.MakeVariant(uint64(time.Minute / time.Microsecond))}, nil
+		case logindInterface + ".Inhibit":
+			inhibited = true
+			return []interface{}{godbus.UnixFD(-1)}, nil
+		}
+		return nil, nil
+	})
+
+	manager := NewManager(&Config{
+		DBus:                         utildbus.NewFake(fakeConn, nil),
+		ShutdownGracePeriodRequested: 30 * time.Second,
+	})
+	if err := manager.Start(); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	if !inhibited {
+		t.Errorf("expected the manager to acquire an inhibitor lock")
+	}
+	if err := manager.ShutdownStatus(); err != nil {
+		t.Errorf("ShutdownStatus() failed before shutdown: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"sync"
+
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util/flowcontrol"
+)
+
+// maxQueuedNotificationsPerPod bounds how many notification deliveries may be
+// in flight or waiting on the rate limiter for a single pod. Requests beyond
+// this depth are rejected immediately.
+const maxQueuedNotificationsPerPod = 5
+
+// notificationLimiter applies a per-pod token bucket rate limit and a bounded
+// queue to notification deliveries so that bursty callers cannot exec-storm a
+// container through the notify subresource.
+type notificationLimiter struct {
+	qps   int32
+	burst int32
+
+	lock sync.Mutex
+	pods map[types.UID]*podNotificationLimiter
+}
+
+type podNotificationLimiter struct {
+	// limiter is nil when rate limiting is disabled (qps <= 0).
+	limiter flowcontrol.RateLimiter
+	queue   chan struct{}
+}
+
+func newNotificationLimiter(qps, burst int32) *notificationLimiter {
+	return &notificationLimiter{
+		qps:   qps,
+		burst: burst,
+		pods:  make(map[types.UID]*podNotificationLimiter),
+	}
+}
+
+// admit waits until the pod's rate limiter permits another notification and
+// returns a function the caller must invoke to release its queue slot once
+// delivery finished. It fails fast with ErrNotificationRateLimited when the
+// pod's queue is already full.
+func (nl *notificationLimiter) admit(uid types.UID) (func(), error) {
+	nl.lock.Lock()
+	pod, ok := nl.pods[uid]
+	if !ok {
+		pod = &podNotificationLimiter{
+			queue: make(chan struct{}, maxQueuedNotificationsPerPod),
+		}
+		if nl.qps > 0 {
+			pod.limiter = flowcontrol.NewTokenBucketRateLimiter(float32(nl.qps), int(nl.burst))
+		}
+		nl.pods[uid] = pod
+	}
+	nl.lock.Unlock()
+
+	select {
+	case pod.queue <- struct{}{}:
+	default:
+		return nil, kubecontainer.ErrNotificationRateLimited
+	}
+	if pod.limiter != nil {
+		pod.limiter.Accept()
+	}
+	return func() { <-pod.queue }, nil
+}
+
+// cleanupPods discards the rate limiting state of pods that are no longer
+// desired.
+func (nl *notificationLimiter) cleanupPods(desiredPods map[types.UID]empty) {
+	nl.lock.Lock()
+	defer nl.lock.Unlock()
+	for uid := range nl.pods {
+		if _, found := desiredPods[uid]; !found {
+			delete(nl.pods, uid)
+		}
+	}
+}
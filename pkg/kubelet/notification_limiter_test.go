@@ -0,0 +1,85 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/types"
+)
+
+func TestNotificationLimiterQueueDepth(t *testing.T) {
+	// Disable the token bucket so only the queue bound applies.
+	nl := newNotificationLimiter(0, 0)
+	uid := types.UID("12345678")
+
+	releases := []func(){}
+	for i := 0; i < maxQueuedNotificationsPerPod; i++ {
+		release, err := nl.admit(uid)
+		if err != nil {
+			t.Fatalf("admit %d: unexpected error: %v", i, err)
+		}
+		releases = append(releases, release)
+	}
+
+	if _, err := nl.admit(uid); err != kubecontainer.ErrNotificationRateLimited {
+		t.Errorf("expected ErrNotificationRateLimited with a full queue, got %v", err)
+	}
+
+	// Other pods are limited independently.
+	release, err := nl.admit(types.UID("87654321"))
+	if err != nil {
+		t.Errorf("unexpected error for a different pod: %v", err)
+	} else {
+		release()
+	}
+
+	// Releasing a slot makes room for the next notification.
+	releases[0]()
+	release, err = nl.admit(uid)
+	if err != nil {
+		t.Errorf("unexpected error after releasing a slot: %v", err)
+	} else {
+		release()
+	}
+	for _, release := range releases[1:] {
+		release()
+	}
+}
+
+func TestNotificationLimiterCleanupPods(t *testing.T) {
+	nl := newNotificationLimiter(0, 0)
+	gone := types.UID("gone")
+	kept := types.UID("kept")
+
+	for _, uid := range []types.UID{gone, kept} {
+		release, err := nl.admit(uid)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		release()
+	}
+
+	nl.cleanupPods(map[types.UID]empty{kept: {}})
+	if _, found := nl.pods[gone]; found {
+		t.Errorf("expected state of pod %q to be cleaned up", gone)
+	}
+	if _, found := nl.pods[kept]; !found {
+		t.Errorf("expected state of pod %q to be kept", kept)
+	}
+}
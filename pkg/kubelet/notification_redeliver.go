@@ -0,0 +1,88 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"sync"
+
+	"k8s.io/kubernetes/pkg/types"
+)
+
+// recordedNotification is the subset of a notification delivery the kubelet
+// needs to replay it after a container restart.
+type recordedNotification struct {
+	message string
+	payload []byte
+	params  map[string]string
+	user    string
+}
+
+// notificationRecorder remembers the most recent delivery of every
+// notification whose declaration sets redeliverOnRestart, keyed by pod UID
+// and container name, so the kubelet can re-send it after the container
+// restarts.
+type notificationRecorder struct {
+	lock sync.Mutex
+	pods map[types.UID]map[string]map[string]recordedNotification
+}
+
+func newNotificationRecorder() *notificationRecorder {
+	return &notificationRecorder{
+		pods: make(map[types.UID]map[string]map[string]recordedNotification),
+	}
+}
+
+// record replaces the remembered delivery of the named notification for the
+// given container.
+func (nr *notificationRecorder) record(uid types.UID, containerName, name string, record recordedNotification) {
+	nr.lock.Lock()
+	defer nr.lock.Unlock()
+	containers, ok := nr.pods[uid]
+	if !ok {
+		containers = make(map[string]map[string]recordedNotification)
+		nr.pods[uid] = containers
+	}
+	notifications, ok := containers[containerName]
+	if !ok {
+		notifications = make(map[string]recordedNotification)
+		containers[containerName] = notifications
+	}
+	notifications[name] = record
+}
+
+// forContainer returns the remembered deliveries for a container.
+func (nr *notificationRecorder) forContainer(uid types.UID, containerName string) []recordedNotification {
+	nr.lock.Lock()
+	defer nr.lock.Unlock()
+	var records []recordedNotification
+	for _, record := range nr.pods[uid][containerName] {
+		records = append(records, record)
+	}
+	return records
+}
+
+// cleanupPods discards the remembered deliveries of pods that are no longer
+// desired.
+func (nr *notificationRecorder) cleanupPods(desiredPods map[types.UID]empty) {
+	nr.lock.Lock()
+	defer nr.lock.Unlock()
+	for uid := range nr.pods {
+		if _, found := desiredPods[uid]; !found {
+			delete(nr.pods, uid)
+		}
+	}
+}
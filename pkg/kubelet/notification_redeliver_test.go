@@ -0,0 +1,72 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/types"
+)
+
+func TestNotificationRecorderRecord(t *testing.T) {
+	nr := newNotificationRecorder()
+	uid := types.UID("12345678")
+
+	if records := nr.forContainer(uid, "foo"); len(records) != 0 {
+		t.Errorf("expected no records before any delivery, got %v", records)
+	}
+
+	nr.record(uid, "foo", "reload", recordedNotification{message: "reload", user: "alice"})
+	nr.record(uid, "foo", "debug", recordedNotification{message: "debug", user: "alice"})
+	// The latest delivery of a notification replaces the previous one.
+	nr.record(uid, "foo", "reload", recordedNotification{message: "reload", user: "bob"})
+
+	records := nr.forContainer(uid, "foo")
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %v", records)
+	}
+	users := map[string]string{}
+	for _, record := range records {
+		users[record.message] = record.user
+	}
+	if users["reload"] != "bob" {
+		t.Errorf("expected the latest delivery of %q to win, got user %q", "reload", users["reload"])
+	}
+
+	// Other containers of the pod are tracked independently.
+	if records := nr.forContainer(uid, "bar"); len(records) != 0 {
+		t.Errorf("expected no records for a different container, got %v", records)
+	}
+}
+
+func TestNotificationRecorderCleanupPods(t *testing.T) {
+	nr := newNotificationRecorder()
+	gone := types.UID("gone")
+	kept := types.UID("kept")
+
+	for _, uid := range []types.UID{gone, kept} {
+		nr.record(uid, "foo", "reload", recordedNotification{message: "reload"})
+	}
+
+	nr.cleanupPods(map[types.UID]empty{kept: {}})
+	if records := nr.forContainer(gone, "foo"); len(records) != 0 {
+		t.Errorf("expected records of pod %q to be cleaned up, got %v", gone, records)
+	}
+	if records := nr.forContainer(kept, "foo"); len(records) != 1 {
+		t.Errorf("expected records of pod %q to be kept, got %v", kept, records)
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package kubelet
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/golang/glog"
 	"github.com/google/cadvisor/events"
 	cadvisorapi "github.com/google/cadvisor/info/v1"
@@ -24,6 +27,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
+	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/runtime"
 )
 
@@ -31,28 +35,43 @@ type OOMWatcher interface {
 	Start(ref *api.ObjectReference) error
 }
 
+// podGetter returns the pod with the given UID, if the kubelet knows about it.
+type podGetter interface {
+	GetPodByUID(types.UID) (*api.Pod, bool)
+}
+
 type realOOMWatcher struct {
-	cadvisor cadvisor.Interface
-	recorder record.EventRecorder
+	cadvisor  cadvisor.Interface
+	recorder  record.EventRecorder
+	podGetter podGetter
 }
 
-func NewOOMWatcher(cadvisor cadvisor.Interface, recorder record.EventRecorder) OOMWatcher {
+func NewOOMWatcher(cadvisor cadvisor.Interface, recorder record.EventRecorder, podGetter podGetter) OOMWatcher {
 	return &realOOMWatcher{
-		cadvisor: cadvisor,
-		recorder: recorder,
+		cadvisor:  cadvisor,
+		recorder:  recorder,
+		podGetter: podGetter,
 	}
 }
 
-const systemOOMEvent = "SystemOOM"
+const (
+	systemOOMEvent = "SystemOOM"
+	oomKilledEvent = "OOMKilled"
+
+	// Prefix of the pod cgroup names created by the pod container manager.
+	podCgroupNamePrefix = "pod"
+)
 
-// Watches cadvisor for system oom's and records an event for every system oom encountered.
+// Watches cadvisor for oom's and records an event for every system oom and
+// every kernel oom kill that can be attributed to a pod.
 func (ow *realOOMWatcher) Start(ref *api.ObjectReference) error {
 	request := events.Request{
 		EventType: map[cadvisorapi.EventType]bool{
-			cadvisorapi.EventOom: true,
+			cadvisorapi.EventOom:     true,
+			cadvisorapi.EventOomKill: true,
 		},
 		ContainerName:        "/",
-		IncludeSubcontainers: false,
+		IncludeSubcontainers: true,
 	}
 	eventChannel, err := ow.cadvisor.WatchEvents(&request)
 	if err != nil {
@@ -63,10 +82,55 @@ func (ow *realOOMWatcher) Start(ref *api.ObjectReference) error {
 		defer runtime.HandleCrash()
 
 		for event := range eventChannel.GetChannel() {
-			glog.V(2).Infof("Got sys oom event from cadvisor: %v", event)
-			ow.recorder.PastEventf(ref, unversioned.Time{Time: event.Timestamp}, api.EventTypeWarning, systemOOMEvent, "System OOM encountered")
+			glog.V(2).Infof("Got oom event from cadvisor: %v", event)
+			if event.EventType == cadvisorapi.EventOomKill {
+				ow.recordOOMKill(event)
+				continue
+			}
+			if event.ContainerName == "/" {
+				ow.recorder.PastEventf(ref, unversioned.Time{Time: event.Timestamp}, api.EventTypeWarning, systemOOMEvent, "System OOM encountered")
+			}
 		}
 		glog.Errorf("Unexpectedly stopped receiving OOM notifications from cAdvisor")
 	}()
 	return nil
 }
+
+// recordOOMKill attributes a kernel oom kill to the victim pod, if the cgroup
+// it happened in belongs to one, and records an OOMKilled event on the pod.
+// This lets users distinguish kernel oom kills from kubelet evictions, which
+// are recorded by the eviction manager instead.
+func (ow *realOOMWatcher) recordOOMKill(event *cadvisorapi.Event) {
+	pod, found := ow.podByCgroupPath(event.ContainerName)
+	if !found {
+		glog.V(3).Infof("No pod found for oom kill in cgroup %q", event.ContainerName)
+		return
+	}
+	message := fmt.Sprintf("Memory cgroup out of memory: killed process in %q", event.ContainerName)
+	if data := event.EventData.OomKill; data != nil {
+		message = fmt.Sprintf("Memory cgroup out of memory: killed process %d (%s)", data.Pid, data.ProcessName)
+	}
+	ref := &api.ObjectReference{
+		Kind:      "Pod",
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		UID:       pod.UID,
+	}
+	ow.recorder.PastEventf(ref, unversioned.Time{Time: event.Timestamp}, api.EventTypeWarning, oomKilledEvent, message)
+}
+
+// podByCgroupPath extracts the pod UID from the pod cgroup segment of the
+// given cgroup path, e.g. /kubepods/burstable/pod<uid>/<container id>, and
+// looks the pod up. The systemd driver suffixes the segment with ".slice".
+func (ow *realOOMWatcher) podByCgroupPath(path string) (*api.Pod, bool) {
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.HasPrefix(segment, podCgroupNamePrefix) {
+			continue
+		}
+		uid := strings.TrimSuffix(strings.TrimPrefix(segment, podCgroupNamePrefix), ".slice")
+		if pod, found := ow.podGetter.GetPodByUID(types.UID(uid)); found {
+			return pod, true
+		}
+	}
+	return nil, false
+}
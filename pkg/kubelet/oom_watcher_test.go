@@ -22,13 +22,25 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/record"
 	cadvisortest "k8s.io/kubernetes/pkg/kubelet/cadvisor/testing"
+	"k8s.io/kubernetes/pkg/types"
 )
 
+type fakeOOMPodGetter struct {
+	pod *api.Pod
+}
+
+func (f *fakeOOMPodGetter) GetPodByUID(uid types.UID) (*api.Pod, bool) {
+	if f.pod != nil && f.pod.UID == uid {
+		return f.pod, true
+	}
+	return nil, false
+}
+
 func TestBasic(t *testing.T) {
 	fakeRecorder := &record.FakeRecorder{}
 	mockCadvisor := &cadvisortest.Fake{}
 	node := &api.ObjectReference{}
-	oomWatcher := NewOOMWatcher(mockCadvisor, fakeRecorder)
+	oomWatcher := NewOOMWatcher(mockCadvisor, fakeRecorder, &fakeOOMPodGetter{})
 	err := oomWatcher.Start(node)
 	if err != nil {
 		t.Errorf("Should not have failed: %v", err)
@@ -37,3 +49,44 @@ func TestBasic(t *testing.T) {
 	// TODO: Improve this test once cadvisor exports events.EventChannel as an interface
 	// and thereby allow using a mock version of cadvisor.
 }
+
+func TestPodByCgroupPath(t *testing.T) {
+	pod := &api.Pod{}
+	pod.Name = "victim"
+	pod.UID = "12345678-1234-1234-1234-123456789012"
+	watcher := &realOOMWatcher{podGetter: &fakeOOMPodGetter{pod: pod}}
+
+	for _, test := range []struct {
+		path  string
+		found bool
+	}{
+		{ // cgroupfs pod cgroup
+			path:  "/kubepods/burstable/pod12345678-1234-1234-1234-123456789012",
+			found: true,
+		},
+		{ // container cgroup under the pod cgroup
+			path:  "/kubepods/pod12345678-1234-1234-1234-123456789012/abcdef123456",
+			found: true,
+		},
+		{ // systemd pod cgroup
+			path:  "/kubepods/pod12345678-1234-1234-1234-123456789012.slice",
+			found: true,
+		},
+		{ // unknown pod
+			path:  "/kubepods/podffffffff-ffff-ffff-ffff-ffffffffffff",
+			found: false,
+		},
+		{ // no pod cgroup in the path
+			path:  "/system.slice/sshd.service",
+			found: false,
+		},
+	} {
+		got, found := watcher.podByCgroupPath(test.path)
+		if found != test.found {
+			t.Errorf("podByCgroupPath(%q): expected found=%v, got %v", test.path, test.found, found)
+		}
+		if found && got != pod {
+			t.Errorf("podByCgroupPath(%q): got unexpected pod %v", test.path, got)
+		}
+	}
+}
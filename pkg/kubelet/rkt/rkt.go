@@ -213,6 +213,7 @@ func New(
 	os kubecontainer.OSInterface,
 	imageBackOff *flowcontrol.Backoff,
 	serializeImagePulls bool,
+	maxParallelImagePulls int,
 	imagePullQPS float32,
 	imagePullBurst int,
 	requestTimeout time.Duration,
@@ -280,7 +281,7 @@ func New(
 	cmdRunner := kubecontainer.DirectStreamingRunner(rkt)
 	rkt.runner = lifecycle.NewHandlerRunner(httpClient, cmdRunner, rkt)
 
-	rkt.imagePuller = images.NewImageManager(recorder, rkt, imageBackOff, serializeImagePulls, imagePullQPS, imagePullBurst)
+	rkt.imagePuller = images.NewImageManager(recorder, rkt, imageBackOff, serializeImagePulls, maxParallelImagePulls, imagePullQPS, imagePullBurst)
 
 	if err := rkt.getVersions(); err != nil {
 		return nil, fmt.Errorf("rkt: error getting version info: %v", err)
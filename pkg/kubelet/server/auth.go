@@ -62,6 +62,7 @@ func isSubpath(subpath, path string) bool {
 //    /metrics/* => verb=<api verb from request>, resource=nodes, name=<node name>, subresource=metrics
 //    /logs/*    => verb=<api verb from request>, resource=nodes, name=<node name>, subresource=log
 //    /spec/*    => verb=<api verb from request>, resource=nodes, name=<node name>, subresource=spec
+//    /notify/*  => verb=<api verb from request>, resource=nodes, name=<node name>, subresource=notify
 func (n nodeAuthorizerAttributesGetter) GetRequestAttributes(u user.Info, r *http.Request) authorizer.Attributes {
 
 	apiVerb := ""
@@ -106,6 +107,8 @@ func (n nodeAuthorizerAttributesGetter) GetRequestAttributes(u user.Info, r *htt
 		attrs.Subresource = "log"
 	case isSubpath(requestPath, specPath):
 		attrs.Subresource = "spec"
+	case isSubpath(requestPath, notifyPath), isSubpath(requestPath, notifyAllPath):
+		attrs.Subresource = "notify"
 	}
 
 	glog.V(5).Infof("Node request attributes: attrs=%#v", attrs)
@@ -18,6 +18,7 @@ package server
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -37,6 +38,7 @@ import (
 
 	"k8s.io/kubernetes/pkg/api"
 	apierrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/api/validation"
@@ -46,6 +48,7 @@ import (
 	"k8s.io/kubernetes/pkg/httplog"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/kubelet/eviction"
 	"k8s.io/kubernetes/pkg/kubelet/server/portforward"
 	"k8s.io/kubernetes/pkg/kubelet/server/remotecommand"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
@@ -64,6 +67,10 @@ const (
 	specPath    = "/spec/"
 	statsPath   = "/stats/"
 	logsPath    = "/logs/"
+	notifyPath  = "/notify/"
+	// notifyAllPath is authorized like notifyPath: broadcasting needs the
+	// same permission as notifying a single pod.
+	notifyAllPath = "/notifyAll/"
 )
 
 // Server is a http.Handler which exposes kubelet functionality over HTTP.
@@ -166,6 +173,9 @@ type HostInterface interface {
 	GetRunningPods() ([]*api.Pod, error)
 	GetPodByName(namespace, name string) (*api.Pod, bool)
 	RunInContainer(name string, uid types.UID, container string, cmd []string) ([]byte, error)
+	NotifyInContainer(name string, uid types.UID, container string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error)
+	NotifyInContainerStream(name string, uid types.UID, container string, message string, signal string, payload []byte, params map[string]string, user string, out io.Writer) error
+	NotifyAll(name string, payload []byte, user string) ([]kubecontainer.BroadcastNotificationResult, error)
 	ExecInContainer(name string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool, resize <-chan term.Size, timeout time.Duration) error
 	AttachContainer(name string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool, resize <-chan term.Size) error
 	GetKubeletContainerLogs(podFullName, containerName string, logOptions *api.PodLogOptions, stdout, stderr io.Writer) error
@@ -176,6 +186,8 @@ type HostInterface interface {
 	GetHostname() string
 	GetNode() (*api.Node, error)
 	GetNodeConfig() cm.NodeConfig
+	GetEvictionDebugInfo() eviction.DebugInfo
+	SimulateEviction(overrides map[eviction.Signal]resource.Quantity) eviction.SimulationResult
 	LatestLoopEntryTime() time.Time
 	ImagesFsInfo() (cadvisorapiv2.FsInfo, error)
 	RootFsInfo() (cadvisorapiv2.FsInfo, error)
@@ -298,6 +310,25 @@ func (s *Server) InstallDebuggingHandlers(criHandler http.Handler) {
 		Operation("getRun"))
 	s.restfulCont.Add(ws)
 
+	ws = new(restful.WebService)
+	ws.
+		Path("/notify")
+	ws.Route(ws.POST("/{podNamespace}/{podID}/{containerName}").
+		To(s.getNotify).
+		Operation("getNotify"))
+	ws.Route(ws.POST("/{podNamespace}/{podID}/{uid}/{containerName}").
+		To(s.getNotify).
+		Operation("getNotify"))
+	s.restfulCont.Add(ws)
+
+	ws = new(restful.WebService)
+	ws.
+		Path("/notifyAll")
+	ws.Route(ws.POST("/{notificationName}").
+		To(s.getNotifyAll).
+		Operation("getNotifyAll"))
+	s.restfulCont.Add(ws)
+
 	ws = new(restful.WebService)
 	ws.
 		Path("/exec")
@@ -396,6 +427,19 @@ func (s *Server) InstallDebuggingHandlers(criHandler http.Handler) {
 		Operation("getRunningPods"))
 	s.restfulCont.Add(ws)
 
+	// The /evictions endpoint reports eviction manager state.
+	ws = new(restful.WebService)
+	ws.
+		Path("/evictions/").
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").
+		To(s.getEvictions).
+		Operation("getEvictions"))
+	ws.Route(ws.GET("/simulate").
+		To(s.getEvictionSimulation).
+		Operation("getEvictionSimulation"))
+	s.restfulCont.Add(ws)
+
 	if criHandler != nil {
 		s.restfulCont.Handle("/cri/", criHandler)
 	}
@@ -553,6 +597,40 @@ func (s *Server) getRunningPods(request *restful.Request, response *restful.Resp
 	writeJsonResponse(response, data)
 }
 
+// getEvictions returns a snapshot of the eviction manager state.
+func (s *Server) getEvictions(request *restful.Request, response *restful.Response) {
+	data, err := json.Marshal(s.host.GetEvictionDebugInfo())
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJsonResponse(response, data)
+}
+
+// getEvictionSimulation returns what the eviction manager would evict under hypothetical
+// signal observations provided as query parameters, e.g. ?signal=memory.available&available=500Mi.
+func (s *Server) getEvictionSimulation(request *restful.Request, response *restful.Response) {
+	signal := request.QueryParameter("signal")
+	if signal == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("missing 'signal' query parameter"))
+		return
+	}
+	available, err := resource.ParseQuantity(request.QueryParameter("available"))
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("invalid 'available' query parameter: %v", err))
+		return
+	}
+	overrides := map[eviction.Signal]resource.Quantity{
+		eviction.Signal(signal): available,
+	}
+	data, err := json.Marshal(s.host.SimulateEviction(overrides))
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJsonResponse(response, data)
+}
+
 // getLogs handles logs requests against the Kubelet.
 func (s *Server) getLogs(request *restful.Request, response *restful.Response) {
 	s.host.ServeLogs(response, request.Request)
@@ -676,6 +754,154 @@ func (s *Server) getRun(request *restful.Request, response *restful.Response) {
 	writeJsonResponse(response, data)
 }
 
+// podActionParams carries the query parameters shared by pod "action"
+// subresources — POST subresources like notify that run something in a pod
+// and report a result. Future subresources should parse their parameters
+// through parsePodActionParams rather than duplicating the parsing.
+type podActionParams struct {
+	payload []byte
+	params  map[string]string
+	timeout time.Duration
+	user    string
+}
+
+// parsePodActionParams extracts the shared action parameters from a request.
+// It writes a BadRequest response and returns false if any parameter is
+// malformed.
+func parsePodActionParams(request *restful.Request, response *restful.Response) (podActionParams, bool) {
+	var p podActionParams
+	if v := request.QueryParameter("payload"); len(v) > 0 {
+		p.payload = []byte(v)
+	}
+	p.params = make(map[string]string)
+	for _, param := range request.Request.URL.Query()["param"] {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			response.WriteError(http.StatusBadRequest, fmt.Errorf("parameter %q is not of the form KEY=VALUE", param))
+			return p, false
+		}
+		p.params[parts[0]] = parts[1]
+	}
+	if t := request.QueryParameter("timeoutSeconds"); len(t) > 0 {
+		seconds, err := strconv.Atoi(t)
+		if err != nil || seconds < 0 {
+			response.WriteError(http.StatusBadRequest, fmt.Errorf("timeoutSeconds %q must be a non-negative integer", t))
+			return p, false
+		}
+		p.timeout = time.Duration(seconds) * time.Second
+	}
+	p.user = request.QueryParameter("user")
+	return p, true
+}
+
+// writePodActionResult maps an action outcome onto the HTTP response,
+// translating the well-known delivery errors to their status codes.
+func writePodActionResult(response *restful.Response, data []byte, err error) {
+	switch {
+	case err == kubecontainer.ErrNotificationRateLimited:
+		response.WriteError(http.StatusTooManyRequests, err)
+	case err == kubecontainer.ErrNotificationTimedOut:
+		response.WriteError(http.StatusGatewayTimeout, err)
+	case err != nil:
+		response.WriteError(http.StatusInternalServerError, err)
+	default:
+		writeJsonResponse(response, data)
+	}
+}
+
+// getNotify handles requests to deliver a notification message to a container.
+func (s *Server) getNotify(request *restful.Request, response *restful.Response) {
+	params := getRequestParams(request)
+	pod, ok := s.host.GetPodByName(params.podNamespace, params.podName)
+	if !ok {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("pod does not exist"))
+		return
+	}
+
+	message := request.QueryParameter("message")
+	signal := request.QueryParameter("signal")
+	actionParams, ok := parsePodActionParams(request, response)
+	if !ok {
+		return
+	}
+	if containers := request.Request.URL.Query()["containers"]; len(containers) > 0 {
+		s.notifyContainers(response, pod, params.podUID, containers, message, signal, actionParams)
+		return
+	}
+	if request.QueryParameter("follow") == "true" {
+		if _, ok := response.ResponseWriter.(http.Flusher); !ok {
+			response.WriteError(http.StatusInternalServerError, fmt.Errorf("unable to convert %v into http.Flusher, cannot stream notification output\n", reflect.TypeOf(response)))
+			return
+		}
+		fw := flushwriter.Wrap(response.ResponseWriter)
+		response.Header().Set("Transfer-Encoding", "chunked")
+		if err := s.host.NotifyInContainerStream(kubecontainer.GetPodFullName(pod), params.podUID, params.containerName, message, signal, actionParams.payload, actionParams.params, actionParams.user, fw); err != nil {
+			response.WriteError(http.StatusBadRequest, err)
+		}
+		return
+	}
+	data, err := s.host.NotifyInContainer(kubecontainer.GetPodFullName(pod), params.podUID, params.containerName, message, signal, actionParams.payload, actionParams.params, actionParams.timeout, actionParams.user)
+	writePodActionResult(response, data, err)
+}
+
+// getNotifyAll handles requests to broadcast a notification to every pod on
+// the node that declares a handler for it.
+func (s *Server) getNotifyAll(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("notificationName")
+	if len(name) == 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("a notification name is required"))
+		return
+	}
+	actionParams, ok := parsePodActionParams(request, response)
+	if !ok {
+		return
+	}
+	results, err := s.host.NotifyAll(name, actionParams.payload, actionParams.user)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJsonResponse(response, data)
+}
+
+// notifyContainers delivers a notification to several containers of a pod in one
+// request and responds with the per-container outcomes. A single "*" entry expands
+// to every container the pod declares. Delivery failures for individual containers
+// are reported in the response rather than failing the whole request.
+func (s *Server) notifyContainers(response *restful.Response, pod *api.Pod, podUID types.UID, containers []string, message string, signal string, actionParams podActionParams) {
+	if len(containers) == 1 && containers[0] == "*" {
+		containers = nil
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+	}
+	podFullName := kubecontainer.GetPodFullName(pod)
+	results := make([]kubecontainer.ContainerNotificationResult, 0, len(containers))
+	for _, container := range containers {
+		result := kubecontainer.ContainerNotificationResult{Container: container}
+		data, err := s.host.NotifyInContainer(podFullName, podUID, container, message, signal, actionParams.payload, actionParams.params, actionParams.timeout, actionParams.user)
+		if err != nil {
+			result.Error = err.Error()
+		} else if len(data) > 0 {
+			if err := json.Unmarshal(data, &result.NotificationResult); err != nil {
+				result.Error = err.Error()
+			}
+		}
+		results = append(results, result)
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJsonResponse(response, data)
+}
+
 // Derived from go-restful writeJSON.
 func writeJsonResponse(response *restful.Response, data []byte) {
 	if data == nil {
@@ -40,9 +40,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/kubernetes/pkg/api"
 	apierrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
+	"k8s.io/kubernetes/pkg/kubelet/eviction"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	kubecontainertesting "k8s.io/kubernetes/pkg/kubelet/container/testing"
 	"k8s.io/kubernetes/pkg/kubelet/server/remotecommand"
@@ -70,6 +72,9 @@ type fakeKubelet struct {
 	runningPodsFunc                    func() ([]*api.Pod, error)
 	logFunc                            func(w http.ResponseWriter, req *http.Request)
 	runFunc                            func(podFullName string, uid types.UID, containerName string, cmd []string) ([]byte, error)
+	notifyFunc                         func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error)
+	notifyStreamFunc                   func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, user string, out io.Writer) error
+	notifyAllFunc                      func(name string, payload []byte, user string) ([]kubecontainer.BroadcastNotificationResult, error)
 	execFunc                           func(pod string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	attachFunc                         func(pod string, uid types.UID, container string, in io.Reader, out, err io.WriteCloser, tty bool) error
 	portForwardFunc                    func(name string, uid types.UID, port uint16, stream io.ReadWriteCloser) error
@@ -130,6 +135,18 @@ func (fk *fakeKubelet) RunInContainer(podFullName string, uid types.UID, contain
 	return fk.runFunc(podFullName, uid, containerName, cmd)
 }
 
+func (fk *fakeKubelet) NotifyInContainer(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+	return fk.notifyFunc(podFullName, uid, containerName, message, signal, payload, params, timeout, user)
+}
+
+func (fk *fakeKubelet) NotifyInContainerStream(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, user string, out io.Writer) error {
+	return fk.notifyStreamFunc(podFullName, uid, containerName, message, signal, payload, params, user, out)
+}
+
+func (fk *fakeKubelet) NotifyAll(name string, payload []byte, user string) ([]kubecontainer.BroadcastNotificationResult, error) {
+	return fk.notifyAllFunc(name, payload, user)
+}
+
 func (fk *fakeKubelet) ExecInContainer(name string, uid types.UID, container string, cmd []string, in io.Reader, out, err io.WriteCloser, tty bool, resize <-chan term.Size, timeout time.Duration) error {
 	return fk.execFunc(name, uid, container, cmd, in, out, err, tty)
 }
@@ -176,6 +193,12 @@ func (_ *fakeKubelet) RootFsInfo() (cadvisorapiv2.FsInfo, error) {
 func (_ *fakeKubelet) GetNode() (*api.Node, error)  { return nil, nil }
 func (_ *fakeKubelet) GetNodeConfig() cm.NodeConfig { return cm.NodeConfig{} }
 
+func (_ *fakeKubelet) GetEvictionDebugInfo() eviction.DebugInfo { return eviction.DebugInfo{} }
+
+func (_ *fakeKubelet) SimulateEviction(overrides map[eviction.Signal]resource.Quantity) eviction.SimulationResult {
+	return eviction.SimulationResult{}
+}
+
 func (fk *fakeKubelet) ListVolumesForPod(podUID types.UID) (map[string]volume.Volume, bool) {
 	return map[string]volume.Volume{}, true
 }
@@ -519,6 +542,317 @@ func TestServeRunInContainer(t *testing.T) {
 	}
 }
 
+func TestServeNotifyInContainer(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	output := "{\"delivered\":true}"
+	podNamespace := "other"
+	podName := "foo"
+	expectedPodName := getPodName(podName, podNamespace)
+	expectedContainerName := "baz"
+	expectedMessage := "low on memory"
+	fw.fakeKubelet.notifyFunc = func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+		if podFullName != expectedPodName {
+			t.Errorf("expected %s, got %s", expectedPodName, podFullName)
+		}
+		if containerName != expectedContainerName {
+			t.Errorf("expected %s, got %s", expectedContainerName, containerName)
+		}
+		if message != expectedMessage {
+			t.Errorf("expected: %s, got %s", expectedMessage, message)
+		}
+
+		return []byte(output), nil
+	}
+
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?message=low%20on%20memory", "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		// copying the response body did not work
+		t.Errorf("Cannot copy resp: %#v", err)
+	}
+	result := string(body)
+	if result != output {
+		t.Errorf("expected %s, got %s", output, result)
+	}
+}
+
+func TestServeNotifyInContainerWithPayload(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	output := "{\"delivered\":true}"
+	podNamespace := "other"
+	podName := "foo"
+	expectedPodName := getPodName(podName, podNamespace)
+	expectedContainerName := "baz"
+	expectedMessage := "reload"
+	expectedPayload := "{\"level\":\"debug\"}"
+	fw.fakeKubelet.notifyFunc = func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+		if podFullName != expectedPodName {
+			t.Errorf("expected %s, got %s", expectedPodName, podFullName)
+		}
+		if containerName != expectedContainerName {
+			t.Errorf("expected %s, got %s", expectedContainerName, containerName)
+		}
+		if message != expectedMessage {
+			t.Errorf("expected: %s, got %s", expectedMessage, message)
+		}
+		if string(payload) != expectedPayload {
+			t.Errorf("expected: %s, got %s", expectedPayload, string(payload))
+		}
+
+		return []byte(output), nil
+	}
+
+	query := url.Values{}
+	query.Add("message", expectedMessage)
+	query.Add("payload", expectedPayload)
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?"+query.Encode(), "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		// copying the response body did not work
+		t.Errorf("Cannot copy resp: %#v", err)
+	}
+	result := string(body)
+	if result != output {
+		t.Errorf("expected %s, got %s", output, result)
+	}
+}
+
+func TestServeNotifyInContainerWithParams(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	output := "{\"delivered\":true}"
+	podNamespace := "other"
+	podName := "foo"
+	expectedPodName := getPodName(podName, podNamespace)
+	expectedContainerName := "baz"
+	expectedMessage := "set-log-level"
+	expectedParams := map[string]string{"LEVEL": "debug", "SINK": "file=stdout"}
+	fw.fakeKubelet.notifyFunc = func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+		if podFullName != expectedPodName {
+			t.Errorf("expected %s, got %s", expectedPodName, podFullName)
+		}
+		if message != expectedMessage {
+			t.Errorf("expected: %s, got %s", expectedMessage, message)
+		}
+		if !reflect.DeepEqual(params, expectedParams) {
+			t.Errorf("expected: %v, got %v", expectedParams, params)
+		}
+
+		return []byte(output), nil
+	}
+
+	query := url.Values{}
+	query.Add("message", expectedMessage)
+	query.Add("param", "LEVEL=debug")
+	query.Add("param", "SINK=file=stdout")
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?"+query.Encode(), "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	query = url.Values{}
+	query.Add("message", expectedMessage)
+	query.Add("param", "not-a-pair")
+	resp, err = http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?"+query.Encode(), "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestServeNotifyInContainerTimeout(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	podNamespace := "other"
+	podName := "foo"
+	expectedContainerName := "baz"
+	expectedTimeout := 5 * time.Second
+	fw.fakeKubelet.notifyFunc = func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+		if timeout != expectedTimeout {
+			t.Errorf("expected timeout %v, got %v", expectedTimeout, timeout)
+		}
+
+		return nil, kubecontainer.ErrNotificationTimedOut
+	}
+
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?message=reload&timeoutSeconds=5", "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		t.Errorf("expected status code %d, got %d", http.StatusGatewayTimeout, resp.StatusCode)
+	}
+
+	resp, err = http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?message=reload&timeoutSeconds=soon", "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestServeNotifyInContainerFollow(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	podNamespace := "other"
+	podName := "foo"
+	expectedPodName := getPodName(podName, podNamespace)
+	expectedContainerName := "baz"
+	expectedMessage := "debug-dump"
+	fw.fakeKubelet.notifyStreamFunc = func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, user string, out io.Writer) error {
+		if podFullName != expectedPodName {
+			t.Errorf("expected %s, got %s", expectedPodName, podFullName)
+		}
+		if message != expectedMessage {
+			t.Errorf("expected: %s, got %s", expectedMessage, message)
+		}
+		fmt.Fprint(out, "chunk one\n")
+		fmt.Fprint(out, "chunk two\n")
+
+		return nil
+	}
+
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/"+expectedContainerName+"?message=debug-dump&follow=true", "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		// copying the response body did not work
+		t.Errorf("Cannot copy resp: %#v", err)
+	}
+	expected := "chunk one\nchunk two\n"
+	if string(body) != expected {
+		t.Errorf("expected %q, got %q", expected, string(body))
+	}
+}
+
+func TestServeNotifyInContainers(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	podNamespace := "other"
+	podName := "foo"
+	expectedPodName := getPodName(podName, podNamespace)
+	fw.fakeKubelet.podByNameFunc = func(namespace, name string) (*api.Pod, bool) {
+		return &api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				UID:       testUID,
+			},
+			Spec: api.PodSpec{
+				Containers: []api.Container{
+					{Name: "bar"},
+					{Name: "baz"},
+				},
+			},
+		}, true
+	}
+	fw.fakeKubelet.notifyFunc = func(podFullName string, uid types.UID, containerName string, message string, signal string, payload []byte, params map[string]string, timeout time.Duration, user string) ([]byte, error) {
+		if podFullName != expectedPodName {
+			t.Errorf("expected %s, got %s", expectedPodName, podFullName)
+		}
+		if containerName == "baz" {
+			return nil, fmt.Errorf("container not running")
+		}
+
+		return []byte("{\"code\":0,\"output\":\"cmVsb2FkZWQ=\"}"), nil
+	}
+
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notify/"+podNamespace+"/"+podName+"/bar?message=reload&containers=%2A", "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		// copying the response body did not work
+		t.Errorf("Cannot copy resp: %#v", err)
+	}
+	results := []kubecontainer.ContainerNotificationResult{}
+	if err := json.Unmarshal(body, &results); err != nil {
+		t.Fatalf("unexpected error parsing %q: %v", string(body), err)
+	}
+	expected := []kubecontainer.ContainerNotificationResult{
+		{Container: "bar", NotificationResult: kubecontainer.NotificationResult{Output: []byte("reloaded")}},
+		{Container: "baz", Error: "container not running"},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected %+v, got %+v", expected, results)
+	}
+}
+
+func TestServeNotifyAll(t *testing.T) {
+	fw := newServerTest()
+	defer fw.testHTTPServer.Close()
+	expectedName := "node-maintenance-imminent"
+	expectedPayload := `{"window":"10m"}`
+	fw.fakeKubelet.notifyAllFunc = func(name string, payload []byte, user string) ([]kubecontainer.BroadcastNotificationResult, error) {
+		if name != expectedName {
+			t.Errorf("expected %s, got %s", expectedName, name)
+		}
+		if string(payload) != expectedPayload {
+			t.Errorf("expected %s, got %s", expectedPayload, string(payload))
+		}
+		return []kubecontainer.BroadcastNotificationResult{
+			{Pod: "foo", Namespace: "other", ContainerNotificationResult: kubecontainer.ContainerNotificationResult{Container: "bar"}},
+			{Pod: "qux", Namespace: "other", ContainerNotificationResult: kubecontainer.ContainerNotificationResult{Container: "baz", Error: "container not running"}},
+		}, nil
+	}
+
+	query := url.Values{}
+	query.Add("payload", expectedPayload)
+	resp, err := http.Post(fw.testHTTPServer.URL+"/notifyAll/"+expectedName+"?"+query.Encode(), "", nil)
+
+	if err != nil {
+		t.Fatalf("Got error POSTing: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		// copying the response body did not work
+		t.Errorf("Cannot copy resp: %#v", err)
+	}
+	results := []kubecontainer.BroadcastNotificationResult{}
+	if err := json.Unmarshal(body, &results); err != nil {
+		t.Fatalf("unexpected error parsing %q: %v", string(body), err)
+	}
+	if len(results) != 2 || results[0].Pod != "foo" || results[1].Error != "container not running" {
+		t.Errorf("unexpected results: %+v", results)
+	}
+}
+
 func TestServeRunInContainerWithUID(t *testing.T) {
 	fw := newServerTest()
 	defer fw.testHTTPServer.Close()
@@ -642,12 +976,15 @@ func TestAuthFilters(t *testing.T) {
 			return "log"
 		case isSubpath(path, metricsPath):
 			return "metrics"
+		case isSubpath(path, notifyPath), isSubpath(path, notifyAllPath):
+			return "notify"
 
 		// Cases for subpaths we expect to map to the "proxy" subresource
 		case isSubpath(path, "/attach"),
 			isSubpath(path, "/configz"),
 			isSubpath(path, "/containerLogs"),
 			isSubpath(path, "/debug"),
+			isSubpath(path, "/evictions"),
 			isSubpath(path, "/exec"),
 			isSubpath(path, "/healthz"),
 			isSubpath(path, "/pods"),
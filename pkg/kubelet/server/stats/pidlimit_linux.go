@@ -0,0 +1,75 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	statsapi "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+)
+
+// pidStats returns the rlimit stats of the node.
+func pidStats() (*statsapi.RlimitStats, error) {
+	rlimit := &statsapi.RlimitStats{}
+
+	// the system-wide limit on the number of tasks is the minimum of
+	// kernel.pid_max and kernel.threads-max.
+	taskMax := int64(-1)
+	for _, file := range []string{"/proc/sys/kernel/pid_max", "/proc/sys/kernel/threads-max"} {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			continue
+		}
+		if taskMax == -1 || taskMax > limit {
+			taskMax = limit
+		}
+	}
+	if taskMax >= 0 {
+		rlimit.MaxPID = &taskMax
+	}
+
+	// the fourth field of /proc/loadavg is runnable/total scheduling entities.
+	content, err := ioutil.ReadFile("/proc/loadavg")
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("unexpected /proc/loadavg content: %q", string(content))
+	}
+	parts := strings.Split(fields[3], "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected /proc/loadavg content: %q", string(content))
+	}
+	runningProcesses, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	rlimit.NumOfRunningProcesses = &runningProcesses
+
+	rlimit.Time = unversioned.NewTime(time.Now())
+	return rlimit, nil
+}
@@ -154,6 +154,11 @@ func (sb *summaryBuilder) build() (*stats.Summary, error) {
 		},
 	}
 
+	// rlimit stats are best-effort; they are not available on all platforms.
+	if rlimit, err := pidStats(); err == nil {
+		nodeStats.Rlimit = rlimit
+	}
+
 	systemContainers := map[string]string{
 		stats.SystemContainerKubelet: sb.nodeConfig.KubeletCgroupsName,
 		stats.SystemContainerRuntime: sb.nodeConfig.RuntimeCgroupsName,
@@ -345,6 +350,12 @@ func (sb *summaryBuilder) containerInfoV2ToStats(
 			availableBytes := info.Spec.Memory.Limit - cstat.Memory.WorkingSet
 			cStats.Memory.AvailableBytes = &availableBytes
 		}
+		cStats.Memory.SwapUsageBytes = &cstat.Memory.Swap
+		// swapAvailableBytes = swap limit (if known) - swap usage
+		if !isMemoryUnlimited(info.Spec.Memory.SwapLimit) {
+			swapAvailableBytes := info.Spec.Memory.SwapLimit - cstat.Memory.Swap
+			cStats.Memory.SwapAvailableBytes = &swapAvailableBytes
+		}
 	}
 
 	sb.containerInfoV2FsStats(info, &cStats)
@@ -369,19 +380,41 @@ func (sb *summaryBuilder) containerInfoV2ToNetworkStats(name string, info *cadvi
 	if !found {
 		return nil
 	}
-	for _, inter := range cstat.Network.Interfaces {
+	netStats := &stats.NetworkStats{
+		Time: unversioned.NewTime(cstat.Timestamp),
+	}
+	for i := range cstat.Network.Interfaces {
+		inter := &cstat.Network.Interfaces[i]
+		iStats := cadvisorInterfaceToSummaryInterface(inter)
 		if inter.Name == network.DefaultInterfaceName {
-			return &stats.NetworkStats{
-				Time:     unversioned.NewTime(cstat.Timestamp),
-				RxBytes:  &inter.RxBytes,
-				RxErrors: &inter.RxErrors,
-				TxBytes:  &inter.TxBytes,
-				TxErrors: &inter.TxErrors,
-			}
+			netStats.InterfaceStats = iStats
 		}
+		netStats.Interfaces = append(netStats.Interfaces, iStats)
+	}
+	if netStats.InterfaceStats.Name == "" {
+		glog.V(4).Infof("Missing default interface %q for %s", network.DefaultInterfaceName, name)
+	}
+	return netStats
+}
+
+// cadvisorInterfaceToSummaryInterface converts a cadvisor interface sample into
+// summary API InterfaceStats.
+func cadvisorInterfaceToSummaryInterface(inter *cadvisorapiv1.InterfaceStats) stats.InterfaceStats {
+	rxBytes := inter.RxBytes
+	rxPackets := inter.RxPackets
+	rxErrors := inter.RxErrors
+	txBytes := inter.TxBytes
+	txPackets := inter.TxPackets
+	txErrors := inter.TxErrors
+	return stats.InterfaceStats{
+		Name:      inter.Name,
+		RxBytes:   &rxBytes,
+		RxPackets: &rxPackets,
+		RxErrors:  &rxErrors,
+		TxBytes:   &txBytes,
+		TxPackets: &txPackets,
+		TxErrors:  &txErrors,
 	}
-	glog.V(4).Infof("Missing default interface %q for %s", network.DefaultInterfaceName, name)
-	return nil
 }
 
 func (sb *summaryBuilder) containerInfoV2ToUserDefinedMetrics(info *cadvisorapiv2.ContainerInfo) []stats.UserDefinedMetric {
@@ -31,8 +31,22 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/leaky"
+	"k8s.io/kubernetes/pkg/types"
 )
 
+// fakeResourceAnalyzer returns canned volume stats for a single pod.
+type fakeResourceAnalyzer struct {
+	podUID         types.UID
+	podVolumeStats PodVolumeStats
+}
+
+func (f *fakeResourceAnalyzer) GetPodVolumeStats(uid types.UID) (PodVolumeStats, bool) {
+	if uid != f.podUID {
+		return PodVolumeStats{}, false
+	}
+	return f.podVolumeStats, true
+}
+
 const (
 	// Offsets from seed value in generated container stats.
 	offsetCPUUsageCores = iota
@@ -43,8 +57,10 @@ const (
 	offsetMemRSSBytes
 	offsetMemWorkingSetBytes
 	offsetNetRxBytes
+	offsetNetRxPackets
 	offsetNetRxErrors
 	offsetNetTxBytes
+	offsetNetTxPackets
 	offsetNetTxErrors
 )
 
@@ -151,8 +167,21 @@ func TestBuildSummary(t *testing.T) {
 		infos[name] = info
 	}
 
+	// Per-volume stats for pod0, as calculated by the fsResourceAnalyzer.
+	volCapacityBytes := uint64(4000)
+	volUsedBytes := uint64(3000)
+	volInodesTotal := uint64(400)
+	pod0Volumes := []kubestats.VolumeStats{{
+		Name:    "vol0",
+		FsStats: kubestats.FsStats{CapacityBytes: &volCapacityBytes, UsedBytes: &volUsedBytes, Inodes: &volInodesTotal},
+	}}
+	resourceAnalyzer := &fakeResourceAnalyzer{
+		podUID:         types.UID(prf0.UID),
+		podVolumeStats: PodVolumeStats{Volumes: pod0Volumes},
+	}
+
 	sb := &summaryBuilder{
-		newFsResourceAnalyzer(&MockStatsProvider{}, time.Minute*5), &node, nodeConfig, rootfs, imagefs, container.ImageStats{}, infos}
+		resourceAnalyzer, &node, nodeConfig, rootfs, imagefs, container.ImageStats{}, infos}
 	summary, err := sb.build()
 
 	assert.NoError(t, err)
@@ -213,6 +242,7 @@ func TestBuildSummary(t *testing.T) {
 
 	assert.EqualValues(t, testTime(creationTime, seedPod0Infra).Unix(), ps.StartTime.Time.Unix())
 	checkNetworkStats(t, "Pod0", seedPod0Infra, ps.Network)
+	assert.Equal(t, pod0Volumes, ps.VolumeStats, "Pod0.VolumeStats")
 
 	// Validate Pod1 Results
 	ps, found = indexPods[prf1]
@@ -223,6 +253,7 @@ func TestBuildSummary(t *testing.T) {
 	checkCPUStats(t, "Pod1Container0", seedPod1Container, con.CPU)
 	checkMemoryStats(t, "Pod1Container0", seedPod1Container, infos["/pod1-c0"], con.Memory)
 	checkNetworkStats(t, "Pod1", seedPod1Infra, ps.Network)
+	assert.Nil(t, ps.VolumeStats, "Pod1.VolumeStats")
 
 	// Validate Pod2 Results
 	ps, found = indexPods[prf2]
@@ -319,17 +350,21 @@ func summaryTestContainerInfo(seed int, podName string, podNamespace string, con
 		},
 		Network: &v2.NetworkStats{
 			Interfaces: []v1.InterfaceStats{{
-				Name:     "eth0",
-				RxBytes:  uint64(seed + offsetNetRxBytes),
-				RxErrors: uint64(seed + offsetNetRxErrors),
-				TxBytes:  uint64(seed + offsetNetTxBytes),
-				TxErrors: uint64(seed + offsetNetTxErrors),
+				Name:      "eth0",
+				RxBytes:   uint64(seed + offsetNetRxBytes),
+				RxPackets: uint64(seed + offsetNetRxPackets),
+				RxErrors:  uint64(seed + offsetNetRxErrors),
+				TxBytes:   uint64(seed + offsetNetTxBytes),
+				TxPackets: uint64(seed + offsetNetTxPackets),
+				TxErrors:  uint64(seed + offsetNetTxErrors),
 			}, {
-				Name:     "cbr0",
-				RxBytes:  100,
-				RxErrors: 100,
-				TxBytes:  100,
-				TxErrors: 100,
+				Name:      "cbr0",
+				RxBytes:   100,
+				RxPackets: 100,
+				RxErrors:  100,
+				TxBytes:   100,
+				TxPackets: 100,
+				TxErrors:  100,
 			}},
 		},
 		CustomMetrics: generateCustomMetrics(spec.CustomMetrics),
@@ -349,10 +384,25 @@ func testTime(base time.Time, seed int) time.Time {
 func checkNetworkStats(t *testing.T, label string, seed int, stats *kubestats.NetworkStats) {
 	assert.NotNil(t, stats)
 	assert.EqualValues(t, testTime(timestamp, seed).Unix(), stats.Time.Time.Unix(), label+".Net.Time")
+	// The default interface is reported inline.
+	assert.Equal(t, "eth0", stats.Name, label+".Net.Name")
 	assert.EqualValues(t, seed+offsetNetRxBytes, *stats.RxBytes, label+".Net.RxBytes")
+	assert.EqualValues(t, seed+offsetNetRxPackets, *stats.RxPackets, label+".Net.RxPackets")
 	assert.EqualValues(t, seed+offsetNetRxErrors, *stats.RxErrors, label+".Net.RxErrors")
 	assert.EqualValues(t, seed+offsetNetTxBytes, *stats.TxBytes, label+".Net.TxBytes")
+	assert.EqualValues(t, seed+offsetNetTxPackets, *stats.TxPackets, label+".Net.TxPackets")
 	assert.EqualValues(t, seed+offsetNetTxErrors, *stats.TxErrors, label+".Net.TxErrors")
+
+	assert.Len(t, stats.Interfaces, 2, label+".Net.Interfaces")
+	indexInterfaces := make(map[string]kubestats.InterfaceStats, len(stats.Interfaces))
+	for _, inter := range stats.Interfaces {
+		indexInterfaces[inter.Name] = inter
+	}
+	assert.Equal(t, stats.InterfaceStats, indexInterfaces["eth0"], label+".Net.Interfaces[eth0]")
+	cbr0, found := indexInterfaces["cbr0"]
+	assert.True(t, found, label+".Net.Interfaces[cbr0]")
+	assert.EqualValues(t, 100, *cbr0.RxBytes, label+".Net.Interfaces[cbr0].RxBytes")
+	assert.EqualValues(t, 100, *cbr0.TxPackets, label+".Net.Interfaces[cbr0].TxPackets")
 }
 
 func checkCPUStats(t *testing.T, label string, seed int, stats *kubestats.CPUStats) {
@@ -110,17 +110,34 @@ func (s *volumeStatCalculator) calcAndStoreStats() {
 	s.latest.Store(PodVolumeStats{Volumes: stats})
 }
 
-// parsePodVolumeStats converts (internal) volume.Metrics to (external) stats.VolumeStats structures
+// parsePodVolumeStats converts (internal) volume.Metrics to (external) stats.VolumeStats structures.
+// Quantities the volume's MetricsProvider does not report are left unset instead of dropping the
+// volume, so every volume with any metrics is represented in the summary.
 func (s *volumeStatCalculator) parsePodVolumeStats(podName string, metric *volume.Metrics) stats.VolumeStats {
-	available := uint64(metric.Available.Value())
-	capacity := uint64(metric.Capacity.Value())
-	used := uint64(metric.Used.Value())
-	inodes := uint64(metric.Inodes.Value())
-	inodesFree := uint64(metric.InodesFree.Value())
-	inodesUsed := uint64(metric.InodesUsed.Value())
-	return stats.VolumeStats{
-		Name: podName,
-		FsStats: stats.FsStats{AvailableBytes: &available, CapacityBytes: &capacity, UsedBytes: &used,
-			Inodes: &inodes, InodesFree: &inodesFree, InodesUsed: &inodesUsed},
+	result := stats.VolumeStats{Name: podName}
+	if metric.Available != nil {
+		available := uint64(metric.Available.Value())
+		result.AvailableBytes = &available
 	}
+	if metric.Capacity != nil {
+		capacity := uint64(metric.Capacity.Value())
+		result.CapacityBytes = &capacity
+	}
+	if metric.Used != nil {
+		used := uint64(metric.Used.Value())
+		result.UsedBytes = &used
+	}
+	if metric.Inodes != nil {
+		inodes := uint64(metric.Inodes.Value())
+		result.Inodes = &inodes
+	}
+	if metric.InodesFree != nil {
+		inodesFree := uint64(metric.InodesFree.Value())
+		result.InodesFree = &inodesFree
+	}
+	if metric.InodesUsed != nil {
+		inodesUsed := uint64(metric.InodesUsed.Value())
+		result.InodesUsed = &inodesUsed
+	}
+	return result
 }
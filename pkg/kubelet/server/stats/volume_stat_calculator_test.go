@@ -0,0 +1,106 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kubestats "k8s.io/kubernetes/pkg/kubelet/api/v1alpha1/stats"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+const (
+	volAvailable  = int64(1000)
+	volCapacity   = int64(4000)
+	volUsed       = int64(3000)
+	volInodes     = int64(400)
+	volInodesFree = int64(300)
+	volInodesUsed = int64(100)
+)
+
+// fakeVolume returns canned metrics from GetMetrics.
+type fakeVolume struct {
+	metrics *volume.Metrics
+	err     error
+}
+
+func (v *fakeVolume) GetPath() string { return "/fake/path" }
+
+func (v *fakeVolume) GetMetrics() (*volume.Metrics, error) { return v.metrics, v.err }
+
+func TestCalcAndStoreStats(t *testing.T) {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "pod0", Namespace: "test0", UID: "UIDpod0"}}
+	volumes := map[string]volume.Volume{
+		// Reports the full set of filesystem metrics.
+		"full": &fakeVolume{metrics: &volume.Metrics{
+			Available:  resource.NewQuantity(volAvailable, resource.BinarySI),
+			Capacity:   resource.NewQuantity(volCapacity, resource.BinarySI),
+			Used:       resource.NewQuantity(volUsed, resource.BinarySI),
+			Inodes:     resource.NewQuantity(volInodes, resource.BinarySI),
+			InodesFree: resource.NewQuantity(volInodesFree, resource.BinarySI),
+			InodesUsed: resource.NewQuantity(volInodesUsed, resource.BinarySI),
+		}},
+		// Reports byte usage only, as providers without inode support do.
+		"partial": &fakeVolume{metrics: &volume.Metrics{
+			Available: resource.NewQuantity(volAvailable, resource.BinarySI),
+			Capacity:  resource.NewQuantity(volCapacity, resource.BinarySI),
+			Used:      resource.NewQuantity(volUsed, resource.BinarySI),
+		}},
+		// Does not support metrics at all.
+		"unsupported": &fakeVolume{err: volume.NewNotSupportedError()},
+	}
+	statsProvider := &MockStatsProvider{}
+	statsProvider.On("ListVolumesForPod", pod.UID).Return(volumes, true)
+
+	calculator := newVolumeStatCalculator(statsProvider, time.Minute, pod)
+	calculator.calcAndStoreStats()
+
+	podStats, found := calculator.GetLatest()
+	assert.True(t, found)
+	assert.Len(t, podStats.Volumes, 2)
+
+	indexVols := make(map[string]kubestats.VolumeStats, len(podStats.Volumes))
+	for _, vol := range podStats.Volumes {
+		indexVols[vol.Name] = vol
+	}
+
+	full, found := indexVols["full"]
+	assert.True(t, found)
+	assert.EqualValues(t, volAvailable, *full.AvailableBytes)
+	assert.EqualValues(t, volCapacity, *full.CapacityBytes)
+	assert.EqualValues(t, volUsed, *full.UsedBytes)
+	assert.EqualValues(t, volInodes, *full.Inodes)
+	assert.EqualValues(t, volInodesFree, *full.InodesFree)
+	assert.EqualValues(t, volInodesUsed, *full.InodesUsed)
+
+	partial, found := indexVols["partial"]
+	assert.True(t, found)
+	assert.EqualValues(t, volAvailable, *partial.AvailableBytes)
+	assert.EqualValues(t, volCapacity, *partial.CapacityBytes)
+	assert.EqualValues(t, volUsed, *partial.UsedBytes)
+	assert.Nil(t, partial.Inodes)
+	assert.Nil(t, partial.InodesFree)
+	assert.Nil(t, partial.InodesUsed)
+
+	_, found = indexVols["unsupported"]
+	assert.False(t, found, "volumes that do not support metrics should be omitted")
+}
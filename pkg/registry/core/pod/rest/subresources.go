@@ -28,8 +28,8 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/client"
 	"k8s.io/kubernetes/pkg/registry/core/pod"
 	"k8s.io/kubernetes/pkg/registry/generic/registry"
-	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
 	"k8s.io/kubernetes/pkg/runtime"
+	proxyutil "k8s.io/kubernetes/pkg/util/proxy"
 )
 
 // ProxyREST implements the proxy subresource for a Pod
@@ -150,6 +150,50 @@ func (r *ExecREST) ConnectMethods() []string {
 	return upgradeableMethods
 }
 
+// NotifyREST implements the notify subresource for a Pod
+type NotifyREST struct {
+	Store       *registry.Store
+	KubeletConn client.ConnectionInfoGetter
+}
+
+// Implement Connecter
+var _ = rest.Connecter(&NotifyREST{})
+
+// New creates a new Pod object
+func (r *NotifyREST) New() runtime.Object {
+	return &api.Pod{}
+}
+
+// Connect returns a handler for the pod notify proxy
+func (r *NotifyREST) Connect(ctx api.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	notifyOpts, ok := opts.(*api.PodNotifyOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", opts)
+	}
+	location, transport, err := pod.NotifyLocation(r.Store, r.KubeletConn, ctx, name, notifyOpts)
+	if err != nil {
+		return nil, err
+	}
+	// Tell the kubelet who asked for the notification so it can be recorded
+	// in the pod's events.
+	if user, ok := api.UserFrom(ctx); ok {
+		values := location.Query()
+		values.Set("user", user.GetName())
+		location.RawQuery = values.Encode()
+	}
+	return newThrottledUpgradeAwareProxyHandler(location, transport, false, false, false, responder), nil
+}
+
+// NewConnectOptions returns the versioned object that represents notify parameters
+func (r *NotifyREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &api.PodNotifyOptions{}, false, ""
+}
+
+// ConnectMethods returns the methods supported by notify
+func (r *NotifyREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
 // PortForwardREST implements the portforward subresource for a Pod
 type PortForwardREST struct {
 	Store       *registry.Store
@@ -183,8 +227,8 @@ func (r *PortForwardREST) Connect(ctx api.Context, name string, opts runtime.Obj
 	return newThrottledUpgradeAwareProxyHandler(location, transport, false, true, true, responder), nil
 }
 
-func newThrottledUpgradeAwareProxyHandler(location *url.URL, transport http.RoundTripper, wrapTransport, upgradeRequired, interceptRedirects bool, responder rest.Responder) *genericrest.UpgradeAwareProxyHandler {
-	handler := genericrest.NewUpgradeAwareProxyHandler(location, transport, wrapTransport, upgradeRequired, responder)
+func newThrottledUpgradeAwareProxyHandler(location *url.URL, transport http.RoundTripper, wrapTransport, upgradeRequired, interceptRedirects bool, responder rest.Responder) *proxyutil.UpgradeAwareProxyHandler {
+	handler := proxyutil.NewUpgradeAwareProxyHandler(location, transport, wrapTransport, upgradeRequired, responder)
 	handler.InterceptRedirects = interceptRedirects
 	handler.MaxBytesPerSec = capabilities.Get().PerConnectionBandwidthLimitBytesPerSec
 	return handler
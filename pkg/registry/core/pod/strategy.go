@@ -21,6 +21,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -384,6 +385,28 @@ func streamParams(params url.Values, opts runtime.Object) error {
 		if opts.TTY {
 			params.Add(api.ExecTTYParam, "1")
 		}
+	case *api.PodNotifyOptions:
+		if len(opts.Message) > 0 {
+			params.Add("message", opts.Message)
+		}
+		if len(opts.Signal) > 0 {
+			params.Add("signal", opts.Signal)
+		}
+		if len(opts.Payload) > 0 {
+			params.Add("payload", opts.Payload)
+		}
+		for _, param := range opts.Params {
+			params.Add("param", param)
+		}
+		if opts.TimeoutSeconds > 0 {
+			params.Add("timeoutSeconds", strconv.Itoa(int(opts.TimeoutSeconds)))
+		}
+		for _, container := range opts.Containers {
+			params.Add("containers", container)
+		}
+		if opts.Follow {
+			params.Add("follow", "true")
+		}
 	default:
 		return fmt.Errorf("Unknown object for streaming: %v", opts)
 	}
@@ -414,6 +437,129 @@ func ExecLocation(
 	return streamLocation(getter, connInfo, ctx, name, opts, opts.Container, "exec")
 }
 
+// MaxNotifyPayloadSize is the largest notification payload, in bytes, that the
+// notify subresource will forward to the kubelet.
+const MaxNotifyPayloadSize = 4 * 1024
+
+// NotifyLocation returns the notify URL for a pod container. If opts.Container is blank
+// and only one container is present in the pod, that container is used.
+func NotifyLocation(
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	ctx api.Context,
+	name string,
+	opts *api.PodNotifyOptions,
+) (*url.URL, http.RoundTripper, error) {
+	if len(opts.Message) == 0 && len(opts.Signal) == 0 {
+		return nil, nil, errors.NewBadRequest("a notification message or a signal is required")
+	}
+	if len(opts.Message) > 0 && len(opts.Signal) > 0 {
+		return nil, nil, errors.NewBadRequest("a notification message and a signal may not both be specified")
+	}
+	if len(opts.Signal) > 0 && len(opts.Payload) > 0 {
+		return nil, nil, errors.NewBadRequest("a signal may not carry a payload")
+	}
+	if len(opts.Signal) > 0 && len(opts.Params) > 0 {
+		return nil, nil, errors.NewBadRequest("a signal does not accept parameters")
+	}
+	for _, param := range opts.Params {
+		if eq := strings.Index(param, "="); eq < 1 {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("notification parameter %q is not of the form KEY=VALUE", param))
+		}
+	}
+	if len(opts.Payload) > MaxNotifyPayloadSize {
+		return nil, nil, errors.NewBadRequest(fmt.Sprintf("notification payload must not exceed %d bytes", MaxNotifyPayloadSize))
+	}
+	if opts.TimeoutSeconds < 0 {
+		return nil, nil, errors.NewBadRequest("timeoutSeconds must not be negative")
+	}
+	if opts.Follow && len(opts.Containers) > 0 {
+		return nil, nil, errors.NewBadRequest("follow may not be combined with containers")
+	}
+	if opts.Follow && opts.TimeoutSeconds > 0 {
+		return nil, nil, errors.NewBadRequest("timeoutSeconds does not apply to a followed notification")
+	}
+	var loc *url.URL
+	var transport http.RoundTripper
+	var err error
+	if len(opts.Containers) > 0 {
+		if len(opts.Container) > 0 {
+			return nil, nil, errors.NewBadRequest("container and containers may not both be specified")
+		}
+		loc, transport, err = batchNotifyLocation(getter, connInfo, ctx, name, opts)
+	} else {
+		loc, transport, err = streamLocation(getter, connInfo, ctx, name, opts, opts.Container, "notify")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(opts.UID) > 0 {
+		// Address the kubelet's UID-scoped route so delivery fails if the pod
+		// has been replaced by a new instance with the same name.
+		dir, container := path.Split(loc.Path)
+		loc.Path = path.Join(dir, string(opts.UID), container)
+	}
+	return loc, transport, nil
+}
+
+// batchNotifyLocation returns a notify URL addressing several containers of a
+// pod in one kubelet request. The full container list travels as query
+// parameters; the first targeted container doubles as the path element
+// expected by the kubelet's route.
+func batchNotifyLocation(
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	ctx api.Context,
+	name string,
+	opts *api.PodNotifyOptions,
+) (*url.URL, http.RoundTripper, error) {
+	pod, err := getPod(getter, ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	pathContainer := ""
+	for _, container := range opts.Containers {
+		if container == "*" {
+			if len(opts.Containers) > 1 {
+				return nil, nil, errors.NewBadRequest(`"*" may not be combined with named containers`)
+			}
+			break
+		}
+		if !podHasContainerWithName(pod, container) {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("container %s is not valid for pod %s", container, name))
+		}
+		if len(pathContainer) == 0 {
+			pathContainer = container
+		}
+	}
+	if len(pathContainer) == 0 {
+		if len(pod.Spec.Containers) == 0 {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("pod %s has no containers", name))
+		}
+		pathContainer = pod.Spec.Containers[0].Name
+	}
+	nodeName := types.NodeName(pod.Spec.NodeName)
+	if len(nodeName) == 0 {
+		// If pod has not been assigned a host, return an empty location
+		return nil, nil, errors.NewBadRequest(fmt.Sprintf("pod %s does not have a host assigned", name))
+	}
+	nodeInfo, err := connInfo.GetConnectionInfo(ctx, nodeName)
+	if err != nil {
+		return nil, nil, err
+	}
+	params := url.Values{}
+	if err := streamParams(params, opts); err != nil {
+		return nil, nil, err
+	}
+	loc := &url.URL{
+		Scheme:   nodeInfo.Scheme,
+		Host:     net.JoinHostPort(nodeInfo.Hostname, nodeInfo.Port),
+		Path:     fmt.Sprintf("/notify/%s/%s/%s", pod.Namespace, pod.Name, pathContainer),
+		RawQuery: params.Encode(),
+	}
+	return loc, nodeInfo.Transport, nil
+}
+
 func streamLocation(
 	getter ResourceGetter,
 	connInfo client.ConnectionInfoGetter,
@@ -0,0 +1,101 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// AuditRecord describes one proxied request, so callers can emit audit
+// entries for proxied traffic including exec and attach sessions.
+type AuditRecord struct {
+	Method string
+	Path   string
+	// Backend is the host the request was proxied to.
+	Backend string
+	// User is the identity the proxy authenticated, if it knows one.
+	User string
+	// Upgraded is true for connection upgrades (SPDY, WebSocket).
+	Upgraded   bool
+	StatusCode int
+	// Duration covers the whole exchange; for upgrades it is the lifetime of
+	// the upgraded session.
+	Duration time.Duration
+}
+
+// Auditor receives a record for each proxied request once it completes.
+// Implementations must not block; they are called on the request path.
+type Auditor interface {
+	AuditProxiedRequest(record AuditRecord)
+}
+
+// audit emits a record for a finished request if an Auditor is configured.
+func (h *UpgradeAwareProxyHandler) audit(req *http.Request, upgraded bool, status int, start time.Time) {
+	if h.Auditor == nil {
+		return
+	}
+	record := AuditRecord{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Backend:    h.Location.Host,
+		Upgraded:   upgraded,
+		StatusCode: status,
+		Duration:   time.Since(start),
+	}
+	if h.UserHeaders != nil {
+		record.User = h.UserHeaders.User
+	}
+	h.Auditor.AuditProxiedRequest(record)
+}
+
+// auditResponseWriter captures the status code written on the non-upgrade
+// path, passing the streaming interfaces through to the wrapped writer.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func newAuditResponseWriter(w http.ResponseWriter) *auditResponseWriter {
+	return &auditResponseWriter{ResponseWriter: w, status: http.StatusOK}
+}
+
+func (a *auditResponseWriter) WriteHeader(status int) {
+	a.status = status
+	a.ResponseWriter.WriteHeader(status)
+}
+
+func (a *auditResponseWriter) Flush() {
+	if flusher, ok := a.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (a *auditResponseWriter) CloseNotify() <-chan bool {
+	return a.ResponseWriter.(http.CloseNotifier).CloseNotify()
+}
+
+func (a *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := a.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer cannot be hijacked: %T", a.ResponseWriter)
+	}
+	return hijacker.Hijack()
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+type fakeAuditor struct {
+	lock    sync.Mutex
+	records []AuditRecord
+}
+
+func (f *fakeAuditor) AuditProxiedRequest(record AuditRecord) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.records = append(f.records, record)
+}
+
+func (f *fakeAuditor) getRecords() []AuditRecord {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]AuditRecord(nil), f.records...)
+}
+
+func TestAuditPlainRequest(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer backend.Close()
+
+	auditor := &fakeAuditor{}
+	serverURL, _ := url.Parse(backend.URL)
+	serverURL.Path = "/backend"
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:    serverURL,
+		Auditor:     auditor,
+		UserHeaders: &UserHeaders{User: "jane"},
+		Responder:   &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/some/path")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	records := auditor.getRecords()
+	if len(records) != 1 {
+		t.Fatalf("expected one audit record, got %d", len(records))
+	}
+	record := records[0]
+	if record.Method != "GET" {
+		t.Errorf("expected method GET, got %q", record.Method)
+	}
+	if record.Path != "/some/path" {
+		t.Errorf("expected path /some/path, got %q", record.Path)
+	}
+	if record.Backend != serverURL.Host {
+		t.Errorf("expected backend %q, got %q", serverURL.Host, record.Backend)
+	}
+	if record.User != "jane" {
+		t.Errorf("expected user jane, got %q", record.User)
+	}
+	if record.Upgraded {
+		t.Errorf("expected a non-upgraded record")
+	}
+	if record.StatusCode != http.StatusTeapot {
+		t.Errorf("expected status %d, got %d", http.StatusTeapot, record.StatusCode)
+	}
+	if record.Duration <= 0 {
+		t.Errorf("expected a positive duration, got %v", record.Duration)
+	}
+}
+
+func TestAuditUpgradedSession(t *testing.T) {
+	backend := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		defer ws.Close()
+		body := make([]byte, 5)
+		ws.Read(body)
+		ws.Write([]byte("hello " + string(body)))
+	}))
+	defer backend.Close()
+
+	auditor := &fakeAuditor{}
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:  serverURL,
+		Auditor:   auditor,
+		Responder: &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	ws, err := websocket.Dial("ws://"+proxy.Listener.Addr().String()+"/some/path", "", "http://127.0.0.1/")
+	if err != nil {
+		t.Fatalf("websocket dial err: %s", err)
+	}
+	if _, err := ws.Write([]byte("world")); err != nil {
+		t.Fatalf("write err: %s", err)
+	}
+	response := make([]byte, 20)
+	if _, err := ws.Read(response); err != nil {
+		t.Fatalf("read err: %s", err)
+	}
+	ws.Close()
+
+	// The session record is emitted once the connection tears down.
+	var records []AuditRecord
+	for i := 0; i < 100; i++ {
+		if records = auditor.getRecords(); len(records) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected one audit record, got %d", len(records))
+	}
+	record := records[0]
+	if !record.Upgraded {
+		t.Errorf("expected an upgraded record")
+	}
+	if record.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("expected status %d, got %d", http.StatusSwitchingProtocols, record.StatusCode)
+	}
+}
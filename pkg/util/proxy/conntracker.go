@@ -0,0 +1,89 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// UpgradeConnTracker tracks the hijacked (SPDY/WebSocket) connections held
+// open by one or more upgrade-aware proxy handlers, so a server can drain
+// long-lived exec and attach streams before shutting down. The zero value is
+// ready to use; share one tracker between all handlers that should drain
+// together.
+type UpgradeConnTracker struct {
+	lock     sync.Mutex
+	active   int
+	draining bool
+	// idle is closed when the last active connection finishes while draining.
+	idle chan struct{}
+}
+
+// add records a new upgraded connection. It fails if the tracker is draining,
+// so no new streams are accepted during shutdown.
+func (t *UpgradeConnTracker) add() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.draining {
+		return fmt.Errorf("the proxy is shutting down and does not accept new upgrade requests")
+	}
+	t.active++
+	return nil
+}
+
+// remove records that an upgraded connection finished.
+func (t *UpgradeConnTracker) remove() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.active--
+	if t.draining && t.active == 0 && t.idle != nil {
+		close(t.idle)
+		t.idle = nil
+	}
+}
+
+// Drain stops accepting new upgraded connections and waits up to timeout for
+// the existing ones to finish. It returns an error if connections remain open
+// when the timeout expires; those connections stay tracked and keep running.
+func (t *UpgradeConnTracker) Drain(timeout time.Duration) error {
+	t.lock.Lock()
+	t.draining = true
+	if t.active == 0 {
+		t.lock.Unlock()
+		return nil
+	}
+	if t.idle == nil {
+		t.idle = make(chan struct{})
+	}
+	idle := t.idle
+	t.lock.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-time.After(timeout):
+		t.lock.Lock()
+		remaining := t.active
+		t.lock.Unlock()
+		if remaining == 0 {
+			return nil
+		}
+		return fmt.Errorf("%d upgraded connection(s) still open after %v", remaining, timeout)
+	}
+}
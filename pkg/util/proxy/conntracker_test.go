@@ -0,0 +1,79 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+func TestUpgradeConnTrackerDrainTimeout(t *testing.T) {
+	tracker := &UpgradeConnTracker{}
+	if err := tracker.add(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tracker.Drain(time.Millisecond); err == nil {
+		t.Errorf("expected drain to time out while a connection is open")
+	}
+	if err := tracker.add(); err == nil {
+		t.Errorf("expected new connections to be rejected while draining")
+	}
+	tracker.remove()
+	if err := tracker.Drain(wait.ForeverTestTimeout); err != nil {
+		t.Errorf("unexpected error draining an idle tracker: %v", err)
+	}
+}
+
+func TestUpgradeConnTrackerDrainWaits(t *testing.T) {
+	tracker := &UpgradeConnTracker{}
+	if err := tracker.add(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	drained := make(chan error, 1)
+	go func() {
+		drained <- tracker.Drain(wait.ForeverTestTimeout)
+	}()
+	// Wait for the drain to begin, then finish the connection.
+	if err := wait.Poll(time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		tracker.lock.Lock()
+		defer tracker.lock.Unlock()
+		return tracker.draining, nil
+	}); err != nil {
+		t.Fatalf("drain never started: %v", err)
+	}
+	tracker.remove()
+	select {
+	case err := <-drained:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("drain did not finish after the last connection closed")
+	}
+}
+
+func TestUpgradeConnTrackerIdleDrain(t *testing.T) {
+	tracker := &UpgradeConnTracker{}
+	if err := tracker.Drain(time.Millisecond); err != nil {
+		t.Errorf("unexpected error draining an idle tracker: %v", err)
+	}
+	if err := tracker.add(); err == nil {
+		t.Errorf("expected new connections to be rejected after draining")
+	}
+}
@@ -92,8 +92,12 @@ func DialURL(url *url.URL, transport http.RoundTripper) (net.Conn, error) {
 			return tlsConn, nil
 		}
 
-		// Verify
+		// Verify against the host we dialed, unless the config pinned a specific
+		// server name for this backend.
 		host, _, _ := net.SplitHostPort(dialAddr)
+		if tlsConfig != nil && len(tlsConfig.ServerName) > 0 {
+			host = tlsConfig.ServerName
+		}
 		if err := tlsConn.VerifyHostname(host); err != nil {
 			tlsConn.Close()
 			return nil, err
@@ -0,0 +1,91 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api/errors"
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+)
+
+// ProxyErrorHandler builds the response returned to the client when the
+// backend could not be reached. Implementations can render custom error
+// pages; the raw backend error is logged but should not be echoed back to
+// the client verbatim.
+type ProxyErrorHandler interface {
+	// ProxyErrorResponse returns the response to send for the failed request.
+	// It must not return nil.
+	ProxyErrorResponse(req *http.Request, err error) *http.Response
+}
+
+// errorResponse renders a backend failure through the given handler, falling
+// back to an api.Status-shaped 503 when no handler is configured.
+func errorResponse(handler ProxyErrorHandler, req *http.Request, err error) *http.Response {
+	if handler != nil {
+		return handler.ProxyErrorResponse(req, err)
+	}
+	return serviceUnavailableResponse(req)
+}
+
+// serviceUnavailableResponse synthesizes a 503 response carrying an
+// api.Status body with reason ServiceUnavailable, so clients of the proxy see
+// the same error shape the API server produces instead of a raw dial error.
+func serviceUnavailableResponse(req *http.Request) *http.Response {
+	status := errors.NewServiceUnavailable(fmt.Sprintf("the proxy could not reach the backend %q", req.URL.Host)).Status()
+	body, err := json.Marshal(status)
+	if err != nil {
+		// A Status always marshals; fall back to its message just in case.
+		body = []byte(status.Message)
+	}
+	return &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+// errorHandlingTransport converts round trip errors into responses built by
+// the configured ProxyErrorHandler, so backend failures reach the client as
+// structured responses instead of the ReverseProxy's bare 500.
+// Implements pkg/util/net.RoundTripperWrapper
+type errorHandlingTransport struct {
+	handler ProxyErrorHandler
+
+	http.RoundTripper
+}
+
+var _ = utilnet.RoundTripperWrapper(&errorHandlingTransport{})
+
+func (rt *errorHandlingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.RoundTripper.RoundTrip(req)
+	if err != nil {
+		glog.Errorf("Error trying to reach backend %q: %v", req.URL.Host, err)
+		return errorResponse(rt.handler, req, err), nil
+	}
+	return resp, nil
+}
+
+func (rt *errorHandlingTransport) WrappedRoundTripper() http.RoundTripper {
+	return rt.RoundTripper
+}
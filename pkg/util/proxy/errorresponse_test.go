@@ -0,0 +1,154 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// unreachableBackendURL returns a URL whose port was just released, so dialing
+// it fails with a connection error.
+func unreachableBackendURL(t *testing.T) *url.URL {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+	return &url.URL{Scheme: "http", Host: addr, Path: "/backend"}
+}
+
+func TestProxyBackendUnreachable(t *testing.T) {
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:  unreachableBackendURL(t),
+		Responder: &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/some/path")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if cType := resp.Header.Get("Content-Type"); cType != "application/json" {
+		t.Errorf("expected Content-Type application/json, got %q", cType)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status := unversioned.Status{}
+	if err := json.Unmarshal(body, &status); err != nil {
+		t.Fatalf("expected an api.Status body, got %q: %v", string(body), err)
+	}
+	if status.Status != unversioned.StatusFailure {
+		t.Errorf("expected status Failure, got %q", status.Status)
+	}
+	if status.Reason != unversioned.StatusReasonServiceUnavailable {
+		t.Errorf("expected reason %q, got %q", unversioned.StatusReasonServiceUnavailable, status.Reason)
+	}
+	if status.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected code %d, got %d", http.StatusServiceUnavailable, status.Code)
+	}
+	// The raw dial error stays in the logs; the client only learns the backend address.
+	if strings.Contains(status.Message, "connection refused") {
+		t.Errorf("expected the dial error to be withheld, got %q", status.Message)
+	}
+}
+
+type fakeErrorHandler struct {
+	err error
+}
+
+func (f *fakeErrorHandler) ProxyErrorResponse(req *http.Request, err error) *http.Response {
+	f.err = err
+	return &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Header:     http.Header{"Content-Type": []string{"text/plain"}},
+		Body:       ioutil.NopCloser(strings.NewReader("backend is down")),
+	}
+}
+
+func TestProxyCustomErrorHandler(t *testing.T) {
+	handler := &fakeErrorHandler{}
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:     unreachableBackendURL(t),
+		Transport:    http.DefaultTransport,
+		ErrorHandler: handler,
+		Responder:    &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/some/path")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status %d, got %d", http.StatusBadGateway, resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != "backend is down" {
+		t.Errorf("expected the custom error page, got %q", string(body))
+	}
+	if handler.err == nil {
+		t.Errorf("expected the handler to receive the backend error")
+	}
+}
+
+func TestProxyCustomErrorHandlerWrappedTransport(t *testing.T) {
+	handler := &fakeErrorHandler{}
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:     unreachableBackendURL(t),
+		ErrorHandler: handler,
+		Responder:    &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/some/path")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status %d, got %d", http.StatusBadGateway, resp.StatusCode)
+	}
+	if handler.err == nil {
+		t.Errorf("expected the handler to receive the backend error")
+	}
+}
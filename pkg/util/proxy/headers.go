@@ -0,0 +1,119 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+	"strings"
+)
+
+// passAlwaysHeaders lists the headers an allowlist never removes: the headers
+// that negotiate protocol upgrades, and the forwarding headers the proxy
+// itself sets. Filtering these out would break exec/attach streams and the
+// backend's view of the original client.
+var passAlwaysHeaders = []string{
+	"Connection",
+	"Upgrade",
+	"X-Stream-Protocol-Version",
+	"X-Forwarded-For",
+	"X-Forwarded-Host",
+	"X-Forwarded-Proto",
+	"X-Forwarded-Uri",
+	remoteUserHeader,
+	remoteGroupHeader,
+}
+
+const (
+	remoteUserHeader  = "X-Remote-User"
+	remoteGroupHeader = "X-Remote-Group"
+)
+
+// UserHeaders carries the identity of the user the proxy authenticated, to be
+// injected as the standard X-Remote-User/X-Remote-Group headers. The backend
+// can trust the values because any inbound copies of those headers are
+// stripped before injection, enabling an authenticating front-proxy pattern.
+type UserHeaders struct {
+	User   string
+	Groups []string
+}
+
+// inject returns a copy of header with the untrusted X-Remote-* headers
+// removed and the authenticated identity set in their place.
+func (u *UserHeaders) inject(header http.Header) http.Header {
+	injected := http.Header{}
+	for key, values := range header {
+		injected[key] = append([]string(nil), values...)
+	}
+	injected.Del(remoteUserHeader)
+	injected.Del(remoteGroupHeader)
+	if len(u.User) > 0 {
+		injected.Set(remoteUserHeader, u.User)
+	}
+	for _, group := range u.Groups {
+		injected.Add(remoteGroupHeader, group)
+	}
+	return injected
+}
+
+// HeaderFilter controls which client request headers the proxy forwards to
+// the backend. The zero value forwards everything; hop-by-hop headers on the
+// plain path are still removed by the reverse proxy as usual.
+type HeaderFilter struct {
+	// StripHeaders lists headers that are always removed before the request
+	// is forwarded, e.g. credentials meant only for the proxy.
+	StripHeaders []string
+	// PassHeaders, if non-empty, switches the filter to allowlist mode: only
+	// the listed headers, the headers required to complete a protocol
+	// upgrade, and the X-Forwarded-* headers are forwarded. StripHeaders
+	// takes precedence over PassHeaders.
+	PassHeaders []string
+}
+
+// Filter returns a copy of header with the filter applied. The input header
+// is not modified.
+func (f *HeaderFilter) Filter(header http.Header) http.Header {
+	filtered := http.Header{}
+	for key, values := range header {
+		if f.pass(key) {
+			filtered[key] = append([]string(nil), values...)
+		}
+	}
+	return filtered
+}
+
+func (f *HeaderFilter) pass(key string) bool {
+	if headerInList(key, f.StripHeaders) {
+		return false
+	}
+	if len(f.PassHeaders) == 0 {
+		return true
+	}
+	if headerInList(key, passAlwaysHeaders) || headerInList(key, f.PassHeaders) {
+		return true
+	}
+	// WebSocket handshakes negotiate via a family of Sec-WebSocket-* headers.
+	return strings.HasPrefix(strings.ToLower(key), "sec-websocket-")
+}
+
+func headerInList(key string, headers []string) bool {
+	for _, h := range headers {
+		if strings.EqualFold(h, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestHeaderFilter(t *testing.T) {
+	tests := map[string]struct {
+		filter *HeaderFilter
+		input  http.Header
+		want   http.Header
+	}{
+		"zero value passes everything": {
+			filter: &HeaderFilter{},
+			input: http.Header{
+				"Authorization": []string{"Bearer token"},
+				"X-Custom":      []string{"a", "b"},
+			},
+			want: http.Header{
+				"Authorization": []string{"Bearer token"},
+				"X-Custom":      []string{"a", "b"},
+			},
+		},
+		"strip list removes headers": {
+			filter: &HeaderFilter{StripHeaders: []string{"proxy-secret"}},
+			input: http.Header{
+				"Authorization": []string{"Bearer token"},
+				"Proxy-Secret":  []string{"hunter2"},
+			},
+			want: http.Header{
+				"Authorization": []string{"Bearer token"},
+			},
+		},
+		"allowlist keeps listed and upgrade headers": {
+			filter: &HeaderFilter{PassHeaders: []string{"Authorization"}},
+			input: http.Header{
+				"Authorization":             []string{"Bearer token"},
+				"Connection":                []string{"Upgrade"},
+				"Upgrade":                   []string{"websocket"},
+				"Sec-Websocket-Version":     []string{"13"},
+				"X-Forwarded-For":           []string{"1.2.3.4"},
+				"X-Stream-Protocol-Version": []string{"v2.channel.k8s.io"},
+				"Cookie":                    []string{"session=abc"},
+			},
+			want: http.Header{
+				"Authorization":             []string{"Bearer token"},
+				"Connection":                []string{"Upgrade"},
+				"Upgrade":                   []string{"websocket"},
+				"Sec-Websocket-Version":     []string{"13"},
+				"X-Forwarded-For":           []string{"1.2.3.4"},
+				"X-Stream-Protocol-Version": []string{"v2.channel.k8s.io"},
+			},
+		},
+		"strip list wins over allowlist": {
+			filter: &HeaderFilter{
+				StripHeaders: []string{"Authorization"},
+				PassHeaders:  []string{"Authorization"},
+			},
+			input: http.Header{
+				"Authorization": []string{"Bearer token"},
+			},
+			want: http.Header{},
+		},
+	}
+	for name, test := range tests {
+		got := test.filter.Filter(test.input)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", name, test.want, got)
+		}
+	}
+}
+
+func TestUserHeaderInjection(t *testing.T) {
+	headerCh := make(chan http.Header, 1)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headerCh <- r.Header
+		w.Write([]byte("ok"))
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location: serverURL,
+		UserHeaders: &UserHeaders{
+			User:   "jane",
+			Groups: []string{"developers", "admins"},
+		},
+		Responder: &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	req, err := http.NewRequest("GET", proxy.URL, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Spoofed identity headers must not reach the backend.
+	req.Header.Set("X-Remote-User", "root")
+	req.Header.Set("X-Remote-Group", "system:masters")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	backendHeader := <-headerCh
+	if got := backendHeader.Get("X-Remote-User"); got != "jane" {
+		t.Errorf("expected X-Remote-User %q, got %q", "jane", got)
+	}
+	if got := backendHeader["X-Remote-Group"]; !reflect.DeepEqual(got, []string{"developers", "admins"}) {
+		t.Errorf("expected X-Remote-Group %v, got %v", []string{"developers", "admins"}, got)
+	}
+}
+
+func TestProxyHeaderFilterPlainPath(t *testing.T) {
+	headerCh := make(chan http.Header, 1)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headerCh <- r.Header
+		w.Write([]byte("ok"))
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location: serverURL,
+		HeaderFilter: &HeaderFilter{
+			StripHeaders: []string{"X-Proxy-Secret"},
+			PassHeaders:  []string{"X-Backend-Token"},
+		},
+		Responder: &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	req, err := http.NewRequest("GET", proxy.URL, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("X-Backend-Token", "backend-credential")
+	req.Header.Set("X-Proxy-Secret", "proxy-only")
+	req.Header.Set("Cookie", "session=abc")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	backendHeader := <-headerCh
+	if got := backendHeader.Get("X-Backend-Token"); got != "backend-credential" {
+		t.Errorf("expected allowlisted header to survive, got %q", got)
+	}
+	if got := backendHeader.Get("X-Proxy-Secret"); got != "" {
+		t.Errorf("expected stripped header to be removed, got %q", got)
+	}
+	if got := backendHeader.Get("Cookie"); got != "" {
+		t.Errorf("expected non-allowlisted header to be removed, got %q", got)
+	}
+}
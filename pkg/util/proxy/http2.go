@@ -0,0 +1,42 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"golang.org/x/net/http2"
+)
+
+// NewH2CRoundTripper returns a transport that speaks HTTP/2 over cleartext
+// (h2c) to the backend with prior knowledge, for exposing gRPC-style backends
+// that do not terminate TLS themselves. There is no HTTP/1.1 fallback on this
+// transport; backends reached over TLS negotiate HTTP/2 via ALPN (with
+// automatic HTTP/1.1 fallback) through the regular transport defaults
+// instead. Request and response bodies are streamed, so full-duplex backends
+// work through the proxy.
+func NewH2CRoundTripper() http.RoundTripper {
+	return &http2.Transport{
+		AllowHTTP: true,
+		// The backend is cleartext; ignore the TLS config and dial plain TCP.
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}
+}
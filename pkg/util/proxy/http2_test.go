@@ -0,0 +1,105 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+// newH2CServer serves h2c (cleartext HTTP/2) connections on a local listener.
+func newH2CServer(t *testing.T, handler http.Handler) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := &http2.Server{}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.ServeConn(conn, &http2.ServeConnOpts{Handler: handler})
+		}
+	}()
+	return listener.Addr().String(), func() { listener.Close() }
+}
+
+func TestH2CRoundTripper(t *testing.T) {
+	addr, stop := newH2CServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "proto=%s", r.Proto)
+	}))
+	defer stop()
+
+	rt := NewH2CRoundTripper()
+	req, err := http.NewRequest("GET", "http://"+addr+"/", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != "proto=HTTP/2.0" {
+		t.Errorf("expected the backend to see HTTP/2.0, got %q", string(body))
+	}
+	if resp.Proto != "HTTP/2.0" {
+		t.Errorf("expected an HTTP/2.0 response, got %q", resp.Proto)
+	}
+}
+
+func TestProxyH2CBackend(t *testing.T) {
+	addr, stop := newH2CServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "proto=%s path=%s", r.Proto, r.URL.Path)
+	}))
+	defer stop()
+
+	serverURL, _ := url.Parse("http://" + addr + "/backend")
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:   serverURL,
+		H2CBackend: true,
+		Responder:  &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/sub")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != "proto=HTTP/2.0 path=/backend" {
+		t.Errorf("unexpected response: %q", string(body))
+	}
+}
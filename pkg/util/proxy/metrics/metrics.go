@@ -0,0 +1,109 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "proxy_request_count",
+			Help: "Counter of proxied requests broken out by HTTP response code.",
+		},
+		[]string{"code"},
+	)
+	upstreamLatencies = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name: "proxy_upstream_latency_microseconds",
+			Help: "Latency distribution in microseconds of responses from proxied backends.",
+			// Use buckets ranging from 1 ms to about 16 seconds.
+			Buckets: prometheus.ExponentialBuckets(1000, 4.0, 8),
+		},
+	)
+	openUpgradedConnections = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "proxy_open_upgraded_connections",
+			Help: "Number of currently open upgraded (SPDY/WebSocket) proxy connections.",
+		},
+	)
+
+	registerOnce sync.Once
+)
+
+// Register registers the proxy metrics with prometheus. It is safe to call
+// more than once.
+func Register() {
+	registerOnce.Do(func() {
+		prometheus.MustRegister(requestCounter)
+		prometheus.MustRegister(upstreamLatencies)
+		prometheus.MustRegister(openUpgradedConnections)
+	})
+}
+
+// Monitor records the outcome of one proxied request.
+func Monitor(httpCode int, reqStart time.Time) {
+	requestCounter.WithLabelValues(strconv.Itoa(httpCode)).Inc()
+	upstreamLatencies.Observe(float64((time.Since(reqStart)) / time.Microsecond))
+}
+
+// UpgradedConnectionOpened records that an upgraded connection was established.
+func UpgradedConnectionOpened() {
+	openUpgradedConnections.Inc()
+}
+
+// UpgradedConnectionClosed records that an upgraded connection was torn down.
+func UpgradedConnectionClosed() {
+	openUpgradedConnections.Dec()
+}
+
+// InstrumentRoundTripper wraps rt so every round trip is recorded with its
+// response code and upstream latency. Transport errors are counted under the
+// "<error>" code. The wrapper exposes the inner round tripper so transport
+// introspection (TLS config, dialer) keeps working.
+func InstrumentRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &instrumentedRoundTripper{rt}
+}
+
+type instrumentedRoundTripper struct {
+	http.RoundTripper
+}
+
+var _ = utilnet.RoundTripperWrapper(&instrumentedRoundTripper{})
+
+func (rt *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rt.RoundTripper.RoundTrip(req)
+	if err != nil {
+		requestCounter.WithLabelValues("<error>").Inc()
+		return resp, err
+	}
+	Monitor(resp.StatusCode, start)
+	return resp, nil
+}
+
+func (rt *instrumentedRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.RoundTripper
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/golang/glog"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+)
+
+// DisableRetriesHeader is a request header clients can set to skip transient
+// error retries for a single proxied request. The header is stripped before
+// the request is forwarded to the backend.
+const DisableRetriesHeader = "X-Kubernetes-Disable-Proxy-Retries"
+
+const (
+	defaultRetries        = 2
+	defaultInitialBackoff = 50 * time.Millisecond
+)
+
+// RetryRoundTripper retries idempotent requests that fail with a transient
+// connection error (connection refused or reset), so a backend that is briefly
+// unavailable — e.g. a restarting kubelet — does not surface as a user-visible
+// proxy error. Requests with methods other than GET, HEAD and OPTIONS, or with
+// a body, are never retried since they may not be safe to replay.
+type RetryRoundTripper struct {
+	// Retries is the number of additional attempts made after the first
+	// request fails. If zero, a small default is used.
+	Retries int
+	// InitialBackoff is the delay before the first retry; it doubles for each
+	// subsequent attempt. If zero, a small default is used.
+	InitialBackoff time.Duration
+
+	http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (rt *RetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	delegate := rt.RoundTripper
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	retriable := isRetriableRequest(req)
+	if len(req.Header.Get(DisableRetriesHeader)) > 0 {
+		retriable = false
+		req.Header.Del(DisableRetriesHeader)
+	}
+	if !retriable {
+		return delegate.RoundTrip(req)
+	}
+
+	retries := rt.Retries
+	if retries == 0 {
+		retries = defaultRetries
+	}
+	backoff := rt.InitialBackoff
+	if backoff == 0 {
+		backoff = defaultInitialBackoff
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp, err = delegate.RoundTrip(req)
+		if err == nil || attempt >= retries || !isTransientConnectionError(err) {
+			return resp, err
+		}
+		glog.V(4).Infof("Retrying request to %q after transient error: %v", req.URL.String(), err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+var _ = utilnet.RoundTripperWrapper(&RetryRoundTripper{})
+
+func (rt *RetryRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.RoundTripper
+}
+
+// isRetriableRequest returns true if the request is safe to replay: an
+// idempotent method with no body.
+func isRetriableRequest(req *http.Request) bool {
+	if req.Body != nil {
+		return false
+	}
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+	return false
+}
+
+// isTransientConnectionError returns true for errors that indicate the
+// backend's address is valid but nothing accepted the connection, which
+// typically resolves once the backend finishes (re)starting.
+func isTransientConnectionError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	opErr, ok := err.(*net.OpError)
+	if !ok {
+		return false
+	}
+	cause := opErr.Err
+	if sysErr, ok := cause.(*os.SyscallError); ok {
+		cause = sysErr.Err
+	}
+	if errno, ok := cause.(syscall.Errno); ok {
+		return errno == syscall.ECONNREFUSED || errno == syscall.ECONNRESET
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// failingRoundTripper fails the first failures calls with err, then succeeds.
+type failingRoundTripper struct {
+	failures int
+	err      error
+	calls    int
+	header   http.Header
+}
+
+func (rt *failingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.calls++
+	rt.header = req.Header
+	if rt.calls <= rt.failures {
+		return nil, rt.err
+	}
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func connRefusedError() error {
+	return &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	tests := map[string]struct {
+		method        string
+		body          string
+		header        http.Header
+		failures      int
+		err           error
+		expectedCalls int
+		expectErr     bool
+	}{
+		"retries connection refused": {
+			method:        "GET",
+			failures:      2,
+			err:           connRefusedError(),
+			expectedCalls: 3,
+		},
+		"retries connection reset": {
+			method:        "GET",
+			failures:      1,
+			err:           &net.OpError{Op: "read", Err: syscall.ECONNRESET},
+			expectedCalls: 2,
+		},
+		"gives up after bounded attempts": {
+			method:        "GET",
+			failures:      10,
+			err:           connRefusedError(),
+			expectedCalls: 3,
+			expectErr:     true,
+		},
+		"does not retry other errors": {
+			method:        "GET",
+			failures:      1,
+			err:           fmt.Errorf("backend exploded"),
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+		"does not retry non-idempotent methods": {
+			method:        "POST",
+			failures:      1,
+			err:           connRefusedError(),
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+		"does not retry requests with a body": {
+			method:        "GET",
+			body:          "payload",
+			failures:      1,
+			err:           connRefusedError(),
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+		"honors the opt-out header": {
+			method:        "GET",
+			header:        http.Header{DisableRetriesHeader: []string{"true"}},
+			failures:      1,
+			err:           connRefusedError(),
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+	}
+
+	for name, test := range tests {
+		var body *strings.Reader
+		var req *http.Request
+		var err error
+		if len(test.body) > 0 {
+			body = strings.NewReader(test.body)
+			req, err = http.NewRequest(test.method, "http://example.com/", body)
+		} else {
+			req, err = http.NewRequest(test.method, "http://example.com/", nil)
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		for key, values := range test.header {
+			req.Header[key] = values
+		}
+
+		backend := &failingRoundTripper{failures: test.failures, err: test.err}
+		rt := &RetryRoundTripper{
+			InitialBackoff: time.Millisecond,
+			RoundTripper:   backend,
+		}
+		resp, err := rt.RoundTrip(req)
+		if test.expectErr != (err != nil) {
+			t.Errorf("%s: expected error=%v, got %v", name, test.expectErr, err)
+		}
+		if backend.calls != test.expectedCalls {
+			t.Errorf("%s: expected %d attempts, got %d", name, test.expectedCalls, backend.calls)
+		}
+		if err == nil && resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: unexpected status code %d", name, resp.StatusCode)
+		}
+		if len(backend.header.Get(DisableRetriesHeader)) > 0 {
+			t.Errorf("%s: opt-out header was forwarded to the backend", name)
+		}
+	}
+}
+
+func TestIsTransientConnectionError(t *testing.T) {
+	// Dial a port nothing is listening on to get a real connection refused
+	// error, including whatever wrapping the net package applies.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+	_, err = net.Dial("tcp", addr)
+	if err == nil {
+		t.Fatalf("expected connecting to %s to fail", addr)
+	}
+	if !isTransientConnectionError(err) {
+		t.Errorf("expected %v to be transient", err)
+	}
+	if isTransientConnectionError(fmt.Errorf("connection refused")) {
+		t.Errorf("expected plain errors not to be transient")
+	}
+	if isTransientConnectionError(nil) {
+		t.Errorf("expected nil not to be transient")
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// SizeLimits caps the size of proxied request and response bodies, protecting
+// the proxying server from memory and bandwidth exhaustion when a client or
+// backend misbehaves. Upgraded connections are streams and are not subject to
+// body limits; bound those with MaxBytesPerSec and Timeouts instead. Zero
+// values disable the corresponding limit.
+type SizeLimits struct {
+	// MaxRequestBytes rejects requests whose body exceeds this many bytes
+	// with a 413 (request entity too large).
+	MaxRequestBytes int64
+	// MaxResponseBytes rejects responses that declare a larger body with a
+	// 502; responses of unknown length are cut off once the limit is read.
+	MaxResponseBytes int64
+}
+
+// limitRequest enforces the request limit, either rejecting the request
+// outright or arranging for the body to error once the limit is read. It
+// returns false if the request was rejected and a response was written.
+func (l *SizeLimits) limitRequest(w http.ResponseWriter, req *http.Request) bool {
+	if l.MaxRequestBytes <= 0 {
+		return true
+	}
+	if req.ContentLength > l.MaxRequestBytes {
+		http.Error(w, fmt.Sprintf("the request body exceeds the limit of %d bytes", l.MaxRequestBytes), http.StatusRequestEntityTooLarge)
+		return false
+	}
+	if req.Body != nil {
+		// Bodies of unknown length are cut off once the limit is read. The
+		// extra byte lets a body of exactly the limit reach EOF normally.
+		req.Body = &limitReadCloser{ReadCloser: req.Body, remaining: l.MaxRequestBytes + 1}
+	}
+	return true
+}
+
+// limitResponseTransport wraps transport so backend responses are subject to
+// the response limit.
+func (l *SizeLimits) limitResponseTransport(transport http.RoundTripper) http.RoundTripper {
+	if l.MaxResponseBytes <= 0 {
+		return transport
+	}
+	return &sizeLimitRoundTripper{limit: l.MaxResponseBytes, rt: transport}
+}
+
+type sizeLimitRoundTripper struct {
+	limit int64
+	rt    http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (s *sizeLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt := s.rt
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.ContentLength > s.limit {
+		resp.Body.Close()
+		message := fmt.Sprintf("the backend response of %d bytes exceeds the limit of %d bytes", resp.ContentLength, s.limit)
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Body:       ioutil.NopCloser(strings.NewReader(message)),
+		}, nil
+	}
+	resp.Body = &limitReadCloser{ReadCloser: resp.Body, remaining: s.limit + 1}
+	return resp, nil
+}
+
+func (s *sizeLimitRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return s.rt
+}
+
+// limitReadCloser fails with an error once more than the configured number of
+// bytes has been read, which aborts the proxy's copy mid-stream.
+type limitReadCloser struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (l *limitReadCloser) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		return 0, fmt.Errorf("body size limit exceeded")
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.ReadCloser.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestProxyRequestSizeLimit(t *testing.T) {
+	var backendCalled bool
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		backendCalled = true
+		w.Write([]byte("ok"))
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:   serverURL,
+		SizeLimits: &SizeLimits{MaxRequestBytes: 10},
+		Responder:  &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Post(proxy.URL, "text/plain", strings.NewReader(strings.Repeat("x", 100)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, resp.StatusCode)
+	}
+	if backendCalled {
+		t.Errorf("expected the oversized request not to reach the backend")
+	}
+
+	// A request under the limit passes through.
+	resp, err = http.Post(proxy.URL, "text/plain", strings.NewReader("small"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestProxyResponseSizeLimit(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("x", 100)))
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:   serverURL,
+		SizeLimits: &SizeLimits{MaxResponseBytes: 10},
+		Responder:  &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status %d, got %d", http.StatusBadGateway, resp.StatusCode)
+	}
+}
+
+func TestProxyResponseSizeLimitUnknownLength(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Flushing forces a chunked response of unknown length.
+		flusher := w.(http.Flusher)
+		for i := 0; i < 10; i++ {
+			w.Write([]byte(strings.Repeat("x", 10)))
+			flusher.Flush()
+		}
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location:   serverURL,
+		SizeLimits: &SizeLimits{MaxResponseBytes: 25},
+		Responder:  &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	// The body must be cut off at the limit instead of streamed in full.
+	if len(body) > 30 {
+		t.Errorf("expected the response to be cut off near 25 bytes, got %d", len(body))
+	}
+}
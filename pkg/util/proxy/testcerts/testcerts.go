@@ -0,0 +1,164 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testcerts generates throwaway CA, server and client certificates
+// for tests, so tests do not depend on checked-in PEM blobs that expire or
+// use key sizes newer TLS stacks reject.
+package testcerts
+
+import (
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math"
+	"math/big"
+	"net"
+	"time"
+)
+
+const (
+	defaultKeySize   = 2048
+	defaultExpiresIn = 24 * time.Hour
+)
+
+// Config controls the shape of a generated certificate.
+type Config struct {
+	CommonName string
+	// Hosts are the DNS names and IP addresses the certificate is valid for.
+	Hosts []string
+	// KeySize is the RSA key size in bits. If zero, 2048 is used.
+	KeySize int
+	// ExpiresIn is how long the certificate stays valid. If zero, 24 hours
+	// is used; pass a negative duration to generate an expired certificate.
+	ExpiresIn time.Duration
+}
+
+// KeyPair holds a generated certificate and its private key, in both parsed
+// and PEM-encoded forms.
+type KeyPair struct {
+	Cert    *x509.Certificate
+	Key     *rsa.PrivateKey
+	CertPEM []byte
+	KeyPEM  []byte
+}
+
+// NewCA generates a self-signed CA certificate.
+func NewCA(cfg Config) (*KeyPair, error) {
+	key, err := newPrivateKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := newTemplate(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign
+	tmpl.BasicConstraintsValid = true
+	tmpl.IsCA = true
+	return newKeyPair(tmpl, tmpl, key, key)
+}
+
+// NewServerCert generates a serving certificate signed by the CA.
+func (ca *KeyPair) NewServerCert(cfg Config) (*KeyPair, error) {
+	return ca.newSignedCert(cfg, x509.ExtKeyUsageServerAuth)
+}
+
+// NewClientCert generates a client certificate signed by the CA.
+func (ca *KeyPair) NewClientCert(cfg Config) (*KeyPair, error) {
+	return ca.newSignedCert(cfg, x509.ExtKeyUsageClientAuth)
+}
+
+// TLSCertificate returns the pair as a tls.Certificate for serving or client
+// authentication.
+func (p *KeyPair) TLSCertificate() (tls.Certificate, error) {
+	return tls.X509KeyPair(p.CertPEM, p.KeyPEM)
+}
+
+// CertPool returns a pool containing only this certificate, for use as
+// RootCAs or ClientCAs when it is a CA.
+func (p *KeyPair) CertPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(p.Cert)
+	return pool
+}
+
+func (ca *KeyPair) newSignedCert(cfg Config, usage x509.ExtKeyUsage) (*KeyPair, error) {
+	key, err := newPrivateKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := newTemplate(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+	return newKeyPair(tmpl, ca.Cert, key, ca.Key)
+}
+
+func newPrivateKey(cfg Config) (*rsa.PrivateKey, error) {
+	keySize := cfg.KeySize
+	if keySize == 0 {
+		keySize = defaultKeySize
+	}
+	return rsa.GenerateKey(cryptorand.Reader, keySize)
+}
+
+func newTemplate(cfg Config) (*x509.Certificate, error) {
+	serial, err := cryptorand.Int(cryptorand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	if err != nil {
+		return nil, err
+	}
+	expiresIn := cfg.ExpiresIn
+	if expiresIn == 0 {
+		expiresIn = defaultExpiresIn
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cfg.CommonName},
+		NotBefore:    now.Add(-time.Hour).UTC(),
+		NotAfter:     now.Add(expiresIn).UTC(),
+	}
+	for _, host := range cfg.Hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, host)
+		}
+	}
+	return tmpl, nil
+}
+
+func newKeyPair(tmpl, parent *x509.Certificate, key, signingKey *rsa.PrivateKey) (*KeyPair, error) {
+	derBytes, err := x509.CreateCertificate(cryptorand.Reader, tmpl, parent, key.Public(), signingKey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{
+		Cert:    cert,
+		Key:     key,
+		CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}),
+		KeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+	}, nil
+}
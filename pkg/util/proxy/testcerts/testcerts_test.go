@@ -0,0 +1,92 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testcerts
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+)
+
+func TestServerCertVerification(t *testing.T) {
+	ca, err := NewCA(Config{CommonName: "test-ca"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server, err := ca.NewServerCert(Config{
+		CommonName: "backend",
+		Hosts:      []string{"127.0.0.1", "backend.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, host := range []string{"127.0.0.1", "backend.example.com"} {
+		opts := x509.VerifyOptions{Roots: ca.CertPool(), DNSName: host}
+		if _, err := server.Cert.Verify(opts); err != nil {
+			t.Errorf("expected cert to verify for %q: %v", host, err)
+		}
+	}
+	opts := x509.VerifyOptions{Roots: ca.CertPool(), DNSName: "other.example.com"}
+	if _, err := server.Cert.Verify(opts); err == nil {
+		t.Errorf("expected verification to fail for an unlisted host")
+	}
+	otherCA, err := NewCA(Config{CommonName: "other-ca"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	opts = x509.VerifyOptions{Roots: otherCA.CertPool(), DNSName: "backend.example.com"}
+	if _, err := server.Cert.Verify(opts); err == nil {
+		t.Errorf("expected verification to fail against a different CA")
+	}
+}
+
+func TestExpiredCert(t *testing.T) {
+	ca, err := NewCA(Config{CommonName: "test-ca"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server, err := ca.NewServerCert(Config{Hosts: []string{"127.0.0.1"}, ExpiresIn: -time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	opts := x509.VerifyOptions{Roots: ca.CertPool()}
+	if _, err := server.Cert.Verify(opts); err == nil {
+		t.Errorf("expected verification of an expired cert to fail")
+	}
+}
+
+func TestClientCert(t *testing.T) {
+	ca, err := NewCA(Config{CommonName: "test-ca"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client, err := ca.NewClientCert(Config{CommonName: "test-user"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.TLSCertificate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	opts := x509.VerifyOptions{
+		Roots:     ca.CertPool(),
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if _, err := client.Cert.Verify(opts); err != nil {
+		t.Errorf("expected client cert to verify: %v", err)
+	}
+}
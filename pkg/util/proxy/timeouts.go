@@ -0,0 +1,89 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+)
+
+// TimeoutConfig bounds how long a proxied request may take, so hung backends
+// do not pin goroutines and sockets forever. Zero values disable the
+// corresponding timeout.
+type TimeoutConfig struct {
+	// Dial bounds establishing the connection to the backend.
+	Dial time.Duration
+	// ResponseHeader bounds how long the backend may take to start responding
+	// to a non-upgrade request.
+	ResponseHeader time.Duration
+	// Idle tears an upgraded connection down when no bytes flow in either
+	// direction for this long.
+	Idle time.Duration
+	// UpgradeLifetime caps the total lifetime of an upgraded connection, even
+	// if it is still carrying traffic.
+	UpgradeLifetime time.Duration
+}
+
+// applyTransportTimeouts returns transport with the dial and response-header
+// timeouts applied. A nil transport gets a new default transport with the
+// timeouts set; an *http.Transport has them filled in where it does not
+// already configure them; other round trippers are returned unchanged.
+func (t *TimeoutConfig) applyTransportTimeouts(transport http.RoundTripper) http.RoundTripper {
+	httpTransport, ok := transport.(*http.Transport)
+	if transport == nil {
+		httpTransport = utilnet.SetTransportDefaults(&http.Transport{})
+	} else if !ok {
+		return transport
+	}
+	if httpTransport.Dial == nil && t.Dial > 0 {
+		httpTransport.Dial = (&net.Dialer{Timeout: t.Dial}).Dial
+	}
+	if httpTransport.ResponseHeaderTimeout == 0 {
+		httpTransport.ResponseHeaderTimeout = t.ResponseHeader
+	}
+	return httpTransport
+}
+
+// idleConn returns conn wrapped so that the connection deadline advances on
+// every read and write; the connection then errors out once Idle elapses with
+// no traffic. A zero Idle timeout returns conn unchanged.
+func (t *TimeoutConfig) idleConn(conn net.Conn) net.Conn {
+	if t.Idle <= 0 {
+		return conn
+	}
+	return &idleTimeoutConn{Conn: conn, timeout: t.Idle}
+}
+
+// idleTimeoutConn resets the connection deadline before each read and write,
+// so a blocked copy fails once the idle timeout passes without traffic.
+type idleTimeoutConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *idleTimeoutConn) Read(b []byte) (int, error) {
+	c.Conn.SetDeadline(time.Now().Add(c.timeout))
+	return c.Conn.Read(b)
+}
+
+func (c *idleTimeoutConn) Write(b []byte) (int, error) {
+	c.Conn.SetDeadline(time.Now().Add(c.timeout))
+	return c.Conn.Write(b)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+func TestApplyTransportTimeouts(t *testing.T) {
+	timeouts := &TimeoutConfig{Dial: time.Second, ResponseHeader: 2 * time.Second}
+
+	rt := timeouts.applyTransportTimeouts(nil)
+	transport, ok := rt.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", rt)
+	}
+	if transport.Dial == nil {
+		t.Errorf("expected a dialer with the dial timeout")
+	}
+	if transport.ResponseHeaderTimeout != 2*time.Second {
+		t.Errorf("expected response header timeout to be set, got %v", transport.ResponseHeaderTimeout)
+	}
+
+	// Timeouts already configured on the transport are left alone.
+	preconfigured := &http.Transport{ResponseHeaderTimeout: time.Minute}
+	rt = timeouts.applyTransportTimeouts(preconfigured)
+	if rt.(*http.Transport).ResponseHeaderTimeout != time.Minute {
+		t.Errorf("expected preconfigured response header timeout to be kept")
+	}
+
+	// Round trippers we do not recognize pass through unchanged.
+	custom := &fakeTransport{}
+	if rt := timeouts.applyTransportTimeouts(custom); rt != custom {
+		t.Errorf("expected custom round tripper to pass through, got %T", rt)
+	}
+}
+
+type fakeTransport struct{}
+
+func (f *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, nil
+}
+
+func TestProxyUpgradeIdleTimeout(t *testing.T) {
+	backend := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		body := make([]byte, 5)
+		ws.Read(body)
+		ws.Write([]byte("hello " + string(body)))
+		// Hold the connection open without traffic; the idle timeout must
+		// tear it down.
+		ws.Read(body)
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location: serverURL,
+		Timeouts: &TimeoutConfig{Idle: 100 * time.Millisecond},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	ws, err := websocket.Dial("ws://"+proxy.Listener.Addr().String()+"/some/path", "", "http://127.0.0.1/")
+	if err != nil {
+		t.Fatalf("websocket dial err: %s", err)
+	}
+	defer ws.Close()
+
+	if _, err := ws.Write([]byte("world")); err != nil {
+		t.Fatalf("write err: %s", err)
+	}
+	response := make([]byte, 20)
+	if _, err := ws.Read(response); err != nil {
+		t.Fatalf("read err: %s", err)
+	}
+
+	// With no traffic flowing, the proxy must hang up on its own. The
+	// deadline only bounds how long the test waits if it does not.
+	ws.SetReadDeadline(time.Now().Add(wait.ForeverTestTimeout))
+	if _, err := ws.Read(response); err == nil {
+		t.Fatalf("expected the connection to be torn down after the idle timeout")
+	}
+}
+
+func TestProxyUpgradeLifetime(t *testing.T) {
+	backend := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		// Stream continuously so the connection is never idle.
+		for {
+			if _, err := ws.Write([]byte("tick")); err != nil {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location: serverURL,
+		Timeouts: &TimeoutConfig{UpgradeLifetime: 200 * time.Millisecond},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	ws, err := websocket.Dial("ws://"+proxy.Listener.Addr().String()+"/some/path", "", "http://127.0.0.1/")
+	if err != nil {
+		t.Fatalf("websocket dial err: %s", err)
+	}
+	defer ws.Close()
+
+	// Even though traffic keeps flowing, the lifetime cap must end the
+	// connection. The deadline only bounds how long the test waits.
+	ws.SetReadDeadline(time.Now().Add(wait.ForeverTestTimeout))
+	response := make([]byte, 20)
+	for {
+		if _, err := ws.Read(response); err != nil {
+			break
+		}
+	}
+}
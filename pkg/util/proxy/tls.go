@@ -0,0 +1,79 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+)
+
+// BackendTLSConfig describes how to verify the serving certificate of a single
+// proxied backend. It allows backends with distinct CAs (kubelets, services)
+// to be verified individually instead of sharing one global client config.
+type BackendTLSConfig struct {
+	// CAData is a PEM-encoded CA bundle used to verify the backend's serving
+	// certificate. If empty, the host's root CA set is used.
+	CAData []byte
+	// ServerName is the name the backend's serving certificate is expected to
+	// be valid for. If empty, the hostname of the dialed address is used.
+	ServerName string
+	// InsecureSkipVerify disables verification of the backend's serving
+	// certificate entirely.
+	InsecureSkipVerify bool
+	// CertData and KeyData are a PEM-encoded client certificate and key the
+	// proxy presents to the backend, for backends that require mutual TLS.
+	CertData []byte
+	KeyData  []byte
+}
+
+// TLSClientConfig returns the tls.Config described by the options. An error is
+// returned if the CA bundle cannot be parsed.
+func (c *BackendTLSConfig) TLSClientConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if len(c.CAData) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(c.CAData) {
+			return nil, fmt.Errorf("no certificates could be parsed from the backend CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(c.CertData) > 0 || len(c.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(c.CertData, c.KeyData)
+		if err != nil {
+			return nil, fmt.Errorf("could not load the backend client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// RoundTripper returns a transport that dials backends with the described TLS
+// verification settings.
+func (c *BackendTLSConfig) RoundTripper() (http.RoundTripper, error) {
+	tlsConfig, err := c.TLSClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return utilnet.SetTransportDefaults(&http.Transport{TLSClientConfig: tlsConfig}), nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+	"k8s.io/kubernetes/pkg/util/proxy/testcerts"
+)
+
+func TestBackendTLSConfig(t *testing.T) {
+	cfg := &BackendTLSConfig{
+		ServerName:         "kubelet.example.com",
+		InsecureSkipVerify: true,
+	}
+	tlsConfig, err := cfg.TLSClientConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig.ServerName != cfg.ServerName {
+		t.Errorf("expected server name %q, got %q", cfg.ServerName, tlsConfig.ServerName)
+	}
+	if !tlsConfig.InsecureSkipVerify {
+		t.Errorf("expected InsecureSkipVerify to be carried over")
+	}
+	if tlsConfig.RootCAs != nil {
+		t.Errorf("expected no CA pool without CA data, got %v", tlsConfig.RootCAs)
+	}
+}
+
+func TestBackendTLSConfigInvalidCA(t *testing.T) {
+	cfg := &BackendTLSConfig{CAData: []byte("this is not PEM data")}
+	if _, err := cfg.TLSClientConfig(); err == nil {
+		t.Errorf("expected error for unparseable CA bundle")
+	}
+	if _, err := cfg.RoundTripper(); err == nil {
+		t.Errorf("expected error for unparseable CA bundle")
+	}
+}
+
+func TestBackendTLSConfigClientCert(t *testing.T) {
+	ca, err := testcerts.NewCA(testcerts.Config{CommonName: "proxy-test-ca"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := ca.NewClientCert(testcerts.Config{CommonName: "front-proxy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &BackendTLSConfig{CertData: client.CertPEM, KeyData: client.KeyPEM}
+	tlsConfig, err := cfg.TLSClientConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("expected one client certificate, got %d", len(tlsConfig.Certificates))
+	}
+
+	cfg = &BackendTLSConfig{CertData: []byte("bogus"), KeyData: client.KeyPEM}
+	if _, err := cfg.TLSClientConfig(); err == nil {
+		t.Errorf("expected error for unparseable client certificate")
+	}
+}
+
+func TestBackendTLSClientCertAuthentication(t *testing.T) {
+	ca, err := testcerts.NewCA(testcerts.Config{CommonName: "proxy-test-ca"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := ca.NewServerCert(testcerts.Config{Hosts: []string{"127.0.0.1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverTLSCert, err := server.TLSCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := ca.NewClientCert(testcerts.Config{CommonName: "front-proxy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The backend requires a client certificate signed by the CA.
+	backend := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("authenticated as " + r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	backend.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverTLSCert},
+		ClientCAs:    ca.CertPool(),
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	backend.StartTLS()
+	defer backend.Close()
+
+	serverURL, _ := url.Parse(backend.URL)
+	proxyHandler := &UpgradeAwareProxyHandler{
+		Location: serverURL,
+		BackendTLS: &BackendTLSConfig{
+			CAData:   ca.CertPEM,
+			CertData: client.CertPEM,
+			KeyData:  client.KeyPEM,
+		},
+		Responder: &fakeResponder{t: t},
+	}
+	proxy := httptest.NewServer(proxyHandler)
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != "authenticated as front-proxy" {
+		t.Errorf("unexpected response: %q", string(body))
+	}
+}
+
+func TestBackendTLSConfigRoundTripper(t *testing.T) {
+	cfg := &BackendTLSConfig{ServerName: "kubelet.example.com"}
+	rt, err := cfg.RoundTripper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", rt)
+	}
+	tlsConfig, _ := utilnet.TLSClientConfig(transport)
+	if tlsConfig == nil || tlsConfig.ServerName != cfg.ServerName {
+		t.Errorf("expected transport TLS config with server name %q, got %#v", cfg.ServerName, tlsConfig)
+	}
+}
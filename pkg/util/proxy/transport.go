@@ -74,6 +74,15 @@ type Transport struct {
 	Scheme      string
 	Host        string
 	PathPrepend string
+	// RewriteHosts lists additional backend addresses whose absolute URLs are
+	// rewritten to the proxy address, the same way URLs referring to the
+	// proxied host itself are. Use it for backends that redirect to an
+	// internal address other than the one that was dialed, such as a cluster
+	// IP or an alternate port on the same node.
+	RewriteHosts []string
+	// ErrorHandler optionally customizes the response synthesized when the
+	// backend cannot be reached. If nil, an api.Status-shaped 503 is returned.
+	ErrorHandler ProxyErrorHandler
 
 	http.RoundTripper
 }
@@ -100,12 +109,8 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	resp, err := rt.RoundTrip(req)
 
 	if err != nil {
-		message := fmt.Sprintf("Error: '%s'\nTrying to reach: '%v'", err.Error(), req.URL.String())
-		resp = &http.Response{
-			StatusCode: http.StatusServiceUnavailable,
-			Body:       ioutil.NopCloser(strings.NewReader(message)),
-		}
-		return resp, nil
+		glog.Errorf("Error trying to reach backend %q: %v", req.URL.Host, err)
+		return errorResponse(t.ErrorHandler, req, err), nil
 	}
 
 	if redirect := resp.Header.Get("Location"); redirect != "" {
@@ -131,14 +136,15 @@ func (rt *Transport) WrappedRoundTripper() http.RoundTripper {
 
 // rewriteURL rewrites a single URL to go through the proxy, if the URL refers
 // to the same host as sourceURL, which is the page on which the target URL
-// occurred. If any error occurs (e.g. parsing), it returns targetURL.
+// occurred, or to one of the configured RewriteHosts. If any error occurs
+// (e.g. parsing), it returns targetURL.
 func (t *Transport) rewriteURL(targetURL string, sourceURL *url.URL) string {
 	url, err := url.Parse(targetURL)
 	if err != nil {
 		return targetURL
 	}
 
-	isDifferentHost := url.Host != "" && url.Host != sourceURL.Host
+	isDifferentHost := url.Host != "" && url.Host != sourceURL.Host && !t.isRewriteHost(url.Host)
 	isRelative := !strings.HasPrefix(url.Path, "/")
 	if isDifferentHost || isRelative {
 		return targetURL
@@ -160,6 +166,17 @@ func (t *Transport) rewriteURL(targetURL string, sourceURL *url.URL) string {
 	return url.String()
 }
 
+// isRewriteHost returns true if host matches one of the configured
+// RewriteHosts.
+func (t *Transport) isRewriteHost(host string) bool {
+	for _, h := range t.RewriteHosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
 // rewriteHTML scans the HTML for tags with url-valued attributes, and updates
 // those values with the urlRewriter function. The updated HTML is output to the
 // writer.
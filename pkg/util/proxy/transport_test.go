@@ -53,6 +53,12 @@ func TestProxyTransport(t *testing.T) {
 		Host:        "foo.com",
 		PathPrepend: "/proxy/node/node1:10250",
 	}
+	rewriteHostTransport := &Transport{
+		Scheme:       "http",
+		Host:         "foo.com",
+		PathPrepend:  "/proxy/node/node1:10250",
+		RewriteHosts: []string{"10.0.0.1:10250", "node1.internal:10250"},
+	}
 	type Item struct {
 		input        string
 		sourceURL    string
@@ -158,6 +164,34 @@ func TestProxyTransport(t *testing.T) {
 			redirectWant: "http://example.com/redirected/target/",
 			forwardedURI: "/proxy/node/node1:10250/redirect",
 		},
+		"redirect rel with rewrite hosts": {
+			sourceURL:    "http://mynode.com/redirect",
+			transport:    rewriteHostTransport,
+			redirect:     "/redirected/target/",
+			redirectWant: "http://foo.com/proxy/node/node1:10250/redirected/target/",
+			forwardedURI: "/proxy/node/node1:10250/redirect",
+		},
+		"redirect abs rewrite host": {
+			sourceURL:    "http://mynode.com/redirect",
+			transport:    rewriteHostTransport,
+			redirect:     "http://10.0.0.1:10250/redirected/target/",
+			redirectWant: "http://foo.com/proxy/node/node1:10250/redirected/target/",
+			forwardedURI: "/proxy/node/node1:10250/redirect",
+		},
+		"redirect abs second rewrite host": {
+			sourceURL:    "http://mynode.com/redirect",
+			transport:    rewriteHostTransport,
+			redirect:     "http://node1.internal:10250/redirected/target/",
+			redirectWant: "http://foo.com/proxy/node/node1:10250/redirected/target/",
+			forwardedURI: "/proxy/node/node1:10250/redirect",
+		},
+		"redirect abs host not in rewrite list": {
+			sourceURL:    "http://mynode.com/redirect",
+			transport:    rewriteHostTransport,
+			redirect:     "http://example.com/redirected/target/",
+			redirectWant: "http://example.com/redirected/target/",
+			forwardedURI: "/proxy/node/node1:10250/redirect",
+		},
 		"source contains the redirect already": {
 			input:        `<pre><a href="kubelet.log">kubelet.log</a><a href="http://foo.com/proxy/node/node1:10250/google.log">google.log</a></pre>`,
 			sourceURL:    "http://foo.com/logs/log.log",
@@ -0,0 +1,84 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+)
+
+// TransportCache caches proxy transports keyed by their backend TLS options,
+// so handlers constructed per request reuse pooled connections instead of
+// redoing TCP and TLS handshakes and exhausting ephemeral ports under
+// high-volume service proxying. Share one cache between all handlers that
+// proxy to the same class of backends.
+type TransportCache struct {
+	// maxIdleConnsPerHost caps the idle connections kept per backend on each
+	// cached transport. Zero keeps the http.Transport default.
+	maxIdleConnsPerHost int
+
+	lock       sync.Mutex
+	transports map[string]http.RoundTripper
+}
+
+// NewTransportCache returns an empty cache whose transports keep up to
+// maxIdleConnsPerHost idle connections per backend.
+func NewTransportCache(maxIdleConnsPerHost int) *TransportCache {
+	return &TransportCache{
+		maxIdleConnsPerHost: maxIdleConnsPerHost,
+		transports:          make(map[string]http.RoundTripper),
+	}
+}
+
+// RoundTripperFor returns the cached transport for the given backend TLS
+// options, constructing and caching one on first use. A nil config yields a
+// shared cleartext transport.
+func (c *TransportCache) RoundTripperFor(tlsConfig *BackendTLSConfig) (http.RoundTripper, error) {
+	key := transportCacheKey(tlsConfig)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if transport, ok := c.transports[key]; ok {
+		return transport, nil
+	}
+
+	var transport *http.Transport
+	if tlsConfig == nil {
+		transport = utilnet.SetTransportDefaults(&http.Transport{})
+	} else {
+		clientConfig, err := tlsConfig.TLSClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport = utilnet.SetTransportDefaults(&http.Transport{TLSClientConfig: clientConfig})
+	}
+	transport.MaxIdleConnsPerHost = c.maxIdleConnsPerHost
+	c.transports[key] = transport
+	return transport, nil
+}
+
+// transportCacheKey derives the cache key from every field that changes how
+// the backend connection is established.
+func transportCacheKey(tlsConfig *BackendTLSConfig) string {
+	if tlsConfig == nil {
+		return ""
+	}
+	return fmt.Sprintf("%x|%x|%x|%s|%v", tlsConfig.CAData, tlsConfig.CertData, tlsConfig.KeyData, tlsConfig.ServerName, tlsConfig.InsecureSkipVerify)
+}
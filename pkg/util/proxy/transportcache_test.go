@@ -0,0 +1,80 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net/http"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/util/proxy/testcerts"
+)
+
+func TestTransportCacheReuse(t *testing.T) {
+	ca, err := testcerts.NewCA(testcerts.Config{CommonName: "proxy-test-ca"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewTransportCache(10)
+
+	cfg := &BackendTLSConfig{CAData: ca.CertPEM, ServerName: "kubelet.example.com"}
+	first, err := cache.RoundTripperFor(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// An equal config built separately must hit the same transport.
+	second, err := cache.RoundTripperFor(&BackendTLSConfig{CAData: ca.CertPEM, ServerName: "kubelet.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != second {
+		t.Errorf("expected equal configs to share one transport")
+	}
+
+	other, err := cache.RoundTripperFor(&BackendTLSConfig{CAData: ca.CertPEM, ServerName: "other.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if other == first {
+		t.Errorf("expected different configs to get distinct transports")
+	}
+
+	cleartext, err := cache.RoundTripperFor(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cleartext == first {
+		t.Errorf("expected the cleartext transport to be distinct")
+	}
+
+	transport, ok := first.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", first)
+	}
+	if transport.MaxIdleConnsPerHost != 10 {
+		t.Errorf("expected idle connection limit 10, got %d", transport.MaxIdleConnsPerHost)
+	}
+}
+
+func TestTransportCacheInvalidConfig(t *testing.T) {
+	cache := NewTransportCache(0)
+	if _, err := cache.RoundTripperFor(&BackendTLSConfig{CAData: []byte("bogus")}); err == nil {
+		t.Errorf("expected error for unparseable CA bundle")
+	}
+	if len(cache.transports) != 0 {
+		t.Errorf("expected nothing to be cached on error")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"net"
+	"net/http"
+
+	utilnet "k8s.io/kubernetes/pkg/util/net"
+)
+
+// unixSocketRoundTripper proxies requests to a backend listening on a unix
+// domain socket, such as a node-local daemon.
+type unixSocketRoundTripper struct {
+	// socketPath is the filesystem path of the unix socket to dial.
+	socketPath string
+
+	rt http.RoundTripper
+}
+
+// NewUnixSocketRoundTripper returns a round tripper that sends every request
+// to the backend listening on the unix domain socket at socketPath. Request
+// URLs may use the "unix" scheme; the URL's path selects the resource on the
+// backend while the socket named at construction time is always the one
+// dialed. The URL's host, or "localhost" if it has none, is sent as the Host
+// header so the backend never sees the socket path in the request line.
+func NewUnixSocketRoundTripper(socketPath string) http.RoundTripper {
+	return &unixSocketRoundTripper{
+		socketPath: socketPath,
+		rt: &http.Transport{
+			Dial: func(network, addr string) (net.Conn, error) {
+				return net.Dial("unix", socketPath)
+			},
+		},
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (rt *unixSocketRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	newReq := *req
+	newURL := *req.URL
+	newReq.URL = &newURL
+	if newReq.URL.Scheme == "unix" || len(newReq.URL.Scheme) == 0 {
+		newReq.URL.Scheme = "http"
+	}
+	if len(newReq.URL.Host) == 0 {
+		newReq.URL.Host = "localhost"
+	}
+	if len(newReq.Host) == 0 {
+		newReq.Host = newReq.URL.Host
+	}
+	return rt.rt.RoundTrip(&newReq)
+}
+
+var _ = utilnet.RoundTripperWrapper(&unixSocketRoundTripper{})
+
+func (rt *unixSocketRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
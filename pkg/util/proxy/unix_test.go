@@ -0,0 +1,128 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newUnixSocketServer starts an httptest server listening on a unix domain
+// socket. The caller must close the server and remove the socket directory.
+func newUnixSocketServer(t *testing.T, handler http.Handler) (*httptest.Server, string, string) {
+	dir, err := ioutil.TempDir("", "proxy-unix-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	socketPath := filepath.Join(dir, "backend.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.Listener.Close()
+	server.Listener = listener
+	server.Start()
+	return server, socketPath, dir
+}
+
+func TestUnixSocketRoundTripper(t *testing.T) {
+	server, socketPath, dir := newUnixSocketServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "host=%s path=%s", r.Host, r.URL.Path)
+	}))
+	defer server.Close()
+	defer os.RemoveAll(dir)
+
+	rt := NewUnixSocketRoundTripper(socketPath)
+	tests := map[string]struct {
+		url  string
+		want string
+	}{
+		"unix scheme with host": {
+			url:  "unix://daemon/stats/summary",
+			want: "host=daemon path=/stats/summary",
+		},
+		"unix scheme without host": {
+			url:  "unix:///logs",
+			want: "host=localhost path=/logs",
+		},
+		"http scheme": {
+			url:  "http://ignored.example.com/healthz",
+			want: "host=ignored.example.com path=/healthz",
+		},
+	}
+	for name, test := range tests {
+		req, err := http.NewRequest("GET", test.url, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if string(body) != test.want {
+			t.Errorf("%s: expected body %q, got %q", name, test.want, string(body))
+		}
+	}
+}
+
+func TestUnixSocketRoundTripperWithTransport(t *testing.T) {
+	server, socketPath, dir := newUnixSocketServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<a href="/logs/kubelet.log">log</a>`)
+	}))
+	defer server.Close()
+	defer os.RemoveAll(dir)
+
+	transport := &Transport{
+		Scheme:       "http",
+		Host:         "foo.com",
+		PathPrepend:  "/proxy/node/node1",
+		RoundTripper: NewUnixSocketRoundTripper(socketPath),
+	}
+	req, err := http.NewRequest("GET", "unix:///logs/", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `<a href="http://foo.com/proxy/node/node1/logs/kubelet.log">log</a>`
+	if string(body) != want {
+		t.Errorf("expected body %q, got %q", want, string(body))
+	}
+}
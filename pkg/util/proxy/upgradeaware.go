@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package rest
+package proxy
 
 import (
 	"bufio"
@@ -26,34 +26,79 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
 	"k8s.io/kubernetes/pkg/api/errors"
 	utilconfig "k8s.io/kubernetes/pkg/util/config"
 	"k8s.io/kubernetes/pkg/util/httpstream"
 	utilnet "k8s.io/kubernetes/pkg/util/net"
-	"k8s.io/kubernetes/pkg/util/proxy"
+	"k8s.io/kubernetes/pkg/util/proxy/metrics"
 	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
 
 	"github.com/golang/glog"
 	"github.com/mxk/go-flowrate/flowrate"
 )
 
-// UpgradeAwareProxyHandler is a handler for proxy requests that may require an upgrade
+// UpgradeAwareProxyHandler is a handler for proxy requests that may require an upgrade,
+// such as SPDY or WebSocket connections.
 type UpgradeAwareProxyHandler struct {
 	UpgradeRequired bool
 	Location        *url.URL
 	// Transport provides an optional round tripper to use to proxy. If nil, the default proxy transport is used
 	Transport http.RoundTripper
+	// BackendTLS optionally describes how to verify this backend's serving certificate.
+	// It is only consulted when Transport is nil.
+	BackendTLS *BackendTLSConfig
+	// TransportCache optionally shares pooled transports between handlers
+	// proxying to backends with the same TLS options. It is only consulted
+	// when Transport is nil.
+	TransportCache *TransportCache
+	// H2CBackend indicates the backend speaks HTTP/2 over cleartext (h2c) with
+	// prior knowledge, as gRPC servers without TLS do. It is only consulted when
+	// Transport is nil; backends reached over TLS negotiate HTTP/2 via ALPN.
+	H2CBackend bool
+	// ConnTracker optionally registers this handler's hijacked connections so
+	// they can be drained on shutdown. While the tracker is draining, upgrade
+	// requests are rejected with a 503.
+	ConnTracker *UpgradeConnTracker
 	// WrapTransport indicates whether the provided Transport should be wrapped with default proxy transport behavior (URL rewriting, X-Forwarded-* header setting)
 	WrapTransport bool
 	// InterceptRedirects determines whether the proxy should sniff backend responses for redirects,
 	// following them as necessary.
 	InterceptRedirects bool
-	FlushInterval      time.Duration
-	MaxBytesPerSec     int64
-	Responder          ErrorResponder
+	// InjectForwardedHeaders determines whether the proxy records the original client's
+	// address, scheme and host in the standard X-Forwarded-For/Proto/Host headers.
+	// Inbound values of those headers are dropped first so a client cannot spoof them.
+	InjectForwardedHeaders bool
+	// HeaderFilter optionally restricts which client request headers are forwarded
+	// to the backend, on both the plain and upgrade paths.
+	HeaderFilter *HeaderFilter
+	// UserHeaders optionally carries the identity the proxy authenticated, injected
+	// as X-Remote-User/X-Remote-Group after inbound values are stripped.
+	UserHeaders *UserHeaders
+	// Timeouts optionally bounds dialing, response headers, and the idle time
+	// and total lifetime of upgraded connections.
+	Timeouts *TimeoutConfig
+	// SizeLimits optionally caps request and response body sizes on the
+	// non-upgrade path.
+	SizeLimits *SizeLimits
+	// Auditor is optionally notified of each proxied request once it
+	// completes, including upgraded sessions.
+	Auditor Auditor
+	// ErrorHandler optionally customizes the response sent when the backend
+	// cannot be reached on the non-upgrade path. If nil, an api.Status-shaped
+	// 503 with reason ServiceUnavailable is returned.
+	ErrorHandler ProxyErrorHandler
+	// FlushInterval controls how often buffered response data is flushed to the
+	// client on the non-upgrade path, so long-poll and streaming backends (watch,
+	// log streaming) are not held up by response buffering.
+	FlushInterval time.Duration
+	// StreamBufferSize is the size in bytes of the buffers used to copy data
+	// between the client and the backend on upgraded connections. If zero, the
+	// io.Copy default (32kB) is used; larger buffers help high-throughput transfers.
+	StreamBufferSize int
+	MaxBytesPerSec   int64
+	Responder        ErrorResponder
 }
 
 const defaultFlushInterval = 200 * time.Millisecond
@@ -79,9 +124,41 @@ func NewUpgradeAwareProxyHandler(location *url.URL, transport http.RoundTripper,
 
 // ServeHTTP handles the proxy request
 func (h *UpgradeAwareProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	metrics.Register()
 	if len(h.Location.Scheme) == 0 {
 		h.Location.Scheme = "http"
 	}
+	if h.Transport == nil && h.TransportCache != nil {
+		transport, err := h.TransportCache.RoundTripperFor(h.BackendTLS)
+		if err != nil {
+			h.Responder.Error(err)
+			return
+		}
+		h.Transport = transport
+	}
+	if h.Transport == nil && h.BackendTLS != nil {
+		transport, err := h.BackendTLS.RoundTripper()
+		if err != nil {
+			h.Responder.Error(err)
+			return
+		}
+		h.Transport = transport
+	}
+	if h.Transport == nil && h.H2CBackend {
+		h.Transport = NewH2CRoundTripper()
+	}
+	if h.Timeouts != nil {
+		h.Transport = h.Timeouts.applyTransportTimeouts(h.Transport)
+	}
+	if h.InjectForwardedHeaders {
+		req.Header = forwardedHeader(req)
+	}
+	if h.UserHeaders != nil {
+		req.Header = h.UserHeaders.inject(req.Header)
+	}
+	if h.HeaderFilter != nil {
+		req.Header = h.HeaderFilter.Filter(req.Header)
+	}
 	if h.tryUpgrade(w, req) {
 		return
 	}
@@ -117,6 +194,22 @@ func (h *UpgradeAwareProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Re
 		h.Transport = h.defaultProxyTransport(req.URL, h.Transport)
 	}
 
+	transport := h.Transport
+	if h.SizeLimits != nil {
+		if !h.SizeLimits.limitRequest(w, req) {
+			return
+		}
+		transport = h.SizeLimits.limitResponseTransport(transport)
+	}
+	transport = &errorHandlingTransport{handler: h.ErrorHandler, RoundTripper: transport}
+
+	if h.Auditor != nil {
+		servingStart := time.Now()
+		rw := newAuditResponseWriter(w)
+		w = rw
+		defer func() { h.audit(req, false, rw.status, servingStart) }()
+	}
+
 	newReq, err := http.NewRequest(req.Method, loc.String(), req.Body)
 	if err != nil {
 		h.Responder.Error(err)
@@ -129,17 +222,30 @@ func (h *UpgradeAwareProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Re
 	newReq.TransferEncoding = req.TransferEncoding
 
 	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: h.Location.Scheme, Host: h.Location.Host})
-	proxy.Transport = h.Transport
+	proxy.Transport = metrics.InstrumentRoundTripper(transport)
 	proxy.FlushInterval = h.FlushInterval
 	proxy.ServeHTTP(w, newReq)
 }
 
-// tryUpgrade returns true if the request was handled.
+// tryUpgrade returns true if the request was handled. Any request carrying
+// connection upgrade headers is proxied at the connection level, so SPDY and
+// WebSocket upgrades are both supported.
 func (h *UpgradeAwareProxyHandler) tryUpgrade(w http.ResponseWriter, req *http.Request) bool {
 	if !httpstream.IsUpgradeRequest(req) {
 		return false
 	}
 
+	sessionStart := time.Now()
+	if h.ConnTracker != nil {
+		if err := h.ConnTracker.add(); err != nil {
+			h.Responder.Error(errors.NewServiceUnavailable(err.Error()))
+			h.audit(req, true, http.StatusServiceUnavailable, sessionStart)
+			return true
+		}
+		defer h.ConnTracker.remove()
+	}
+
+	connectStart := time.Now()
 	var (
 		backendConn net.Conn
 		rawResponse []byte
@@ -152,6 +258,7 @@ func (h *UpgradeAwareProxyHandler) tryUpgrade(w http.ResponseWriter, req *http.R
 	}
 	if err != nil {
 		h.Responder.Error(err)
+		h.audit(req, true, http.StatusBadGateway, sessionStart)
 		return true
 	}
 	defer backendConn.Close()
@@ -161,15 +268,34 @@ func (h *UpgradeAwareProxyHandler) tryUpgrade(w http.ResponseWriter, req *http.R
 	requestHijacker, ok := w.(http.Hijacker)
 	if !ok {
 		h.Responder.Error(fmt.Errorf("request connection cannot be hijacked: %T", w))
+		h.audit(req, true, http.StatusInternalServerError, sessionStart)
 		return true
 	}
 	requestHijackedConn, _, err := requestHijacker.Hijack()
 	if err != nil {
 		h.Responder.Error(fmt.Errorf("error hijacking request connection: %v", err))
+		h.audit(req, true, http.StatusInternalServerError, sessionStart)
 		return true
 	}
 	defer requestHijackedConn.Close()
 
+	if h.Timeouts != nil {
+		backendConn = h.Timeouts.idleConn(backendConn)
+		requestHijackedConn = h.Timeouts.idleConn(requestHijackedConn)
+		if h.Timeouts.UpgradeLifetime > 0 {
+			// Tearing both halves down unblocks the copies below.
+			lifetime := time.AfterFunc(h.Timeouts.UpgradeLifetime, func() {
+				backendConn.Close()
+				requestHijackedConn.Close()
+			})
+			defer lifetime.Stop()
+		}
+	}
+
+	metrics.Monitor(http.StatusSwitchingProtocols, connectStart)
+	metrics.UpgradedConnectionOpened()
+	defer metrics.UpgradedConnectionClosed()
+
 	// Forward raw response bytes back to client.
 	if len(rawResponse) > 0 {
 		if _, err = requestHijackedConn.Write(rawResponse); err != nil {
@@ -178,8 +304,8 @@ func (h *UpgradeAwareProxyHandler) tryUpgrade(w http.ResponseWriter, req *http.R
 	}
 
 	// Proxy the connection.
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
+	writerComplete := make(chan struct{})
+	readerComplete := make(chan struct{})
 
 	go func() {
 		var writer io.WriteCloser
@@ -188,11 +314,11 @@ func (h *UpgradeAwareProxyHandler) tryUpgrade(w http.ResponseWriter, req *http.R
 		} else {
 			writer = backendConn
 		}
-		_, err := io.Copy(writer, requestHijackedConn)
+		err := h.copyStream(writer, requestHijackedConn)
 		if err != nil && !strings.Contains(err.Error(), "use of closed network connection") {
 			glog.Errorf("Error proxying data from client to backend: %v", err)
 		}
-		wg.Done()
+		close(writerComplete)
 	}()
 
 	go func() {
@@ -202,17 +328,39 @@ func (h *UpgradeAwareProxyHandler) tryUpgrade(w http.ResponseWriter, req *http.R
 		} else {
 			reader = backendConn
 		}
-		_, err := io.Copy(requestHijackedConn, reader)
+		err := h.copyStream(requestHijackedConn, reader)
 		if err != nil && !strings.Contains(err.Error(), "use of closed network connection") {
 			glog.Errorf("Error proxying data from backend to client: %v", err)
 		}
-		wg.Done()
+		close(readerComplete)
 	}()
 
-	wg.Wait()
+	// Wait for one half of the connection to finish, then tear both halves down
+	// so the close propagates to the other side. WebSocket backends signal
+	// completion by closing their end while the client keeps reading; waiting on
+	// both copies would hold the client connection open until the client timed
+	// out.
+	select {
+	case <-writerComplete:
+	case <-readerComplete:
+	}
+
+	h.audit(req, true, http.StatusSwitchingProtocols, sessionStart)
 	return true
 }
 
+// copyStream copies one half of an upgraded connection, using a buffer of the
+// configured size if one was requested.
+func (h *UpgradeAwareProxyHandler) copyStream(dst io.Writer, src io.Reader) error {
+	var err error
+	if h.StreamBufferSize > 0 {
+		_, err = io.CopyBuffer(dst, src, make([]byte, h.StreamBufferSize))
+	} else {
+		_, err = io.Copy(dst, src)
+	}
+	return err
+}
+
 // connectBackend dials the backend at location and forwards a copy of the client request.
 func (h *UpgradeAwareProxyHandler) connectBackend(method string, location *url.URL, header http.Header, body io.Reader) (conn net.Conn, err error) {
 	defer func() {
@@ -228,7 +376,7 @@ func (h *UpgradeAwareProxyHandler) connectBackend(method string, location *url.U
 	}
 	beReq.Header = header
 
-	conn, err = proxy.DialURL(location, h.Transport)
+	conn, err = DialURL(location, h.Transport)
 	if err != nil {
 		return conn, fmt.Errorf("error dialing backend: %v", err)
 	}
@@ -320,6 +468,27 @@ redirectLoop:
 	return backendConn, rawResponse.Bytes(), nil
 }
 
+// forwardedHeader returns a copy of the request's header with the standard
+// forwarding headers replaced by the address, scheme and host the client used.
+// Inbound values are dropped so a client cannot spoof them.
+func forwardedHeader(req *http.Request) http.Header {
+	header := http.Header{}
+	for k, v := range req.Header {
+		header[k] = append([]string(nil), v...)
+	}
+	header.Del("X-Forwarded-For")
+	if clientIP, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		header.Set("X-Forwarded-For", clientIP)
+	}
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	header.Set("X-Forwarded-Proto", scheme)
+	header.Set("X-Forwarded-Host", req.Host)
+	return header
+}
+
 func (h *UpgradeAwareProxyHandler) defaultProxyTransport(url *url.URL, internalTransport http.RoundTripper) http.RoundTripper {
 	scheme := url.Scheme
 	host := url.Host
@@ -328,10 +497,11 @@ func (h *UpgradeAwareProxyHandler) defaultProxyTransport(url *url.URL, internalT
 		suffix += "/"
 	}
 	pathPrepend := strings.TrimSuffix(url.Path, suffix)
-	rewritingTransport := &proxy.Transport{
+	rewritingTransport := &Transport{
 		Scheme:       scheme,
 		Host:         host,
 		PathPrepend:  pathPrepend,
+		ErrorHandler: h.ErrorHandler,
 		RoundTripper: internalTransport,
 	}
 	return &corsRemovingTransport{
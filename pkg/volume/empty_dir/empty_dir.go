@@ -245,8 +245,28 @@ func (ed *emptyDir) setupTmpfs(dir string) error {
 		return nil
 	}
 
-	glog.V(3).Infof("pod %v: mounting tmpfs for volume %v", ed.pod.UID, ed.volName)
-	return ed.mounter.Mount("tmpfs", dir, "tmpfs", nil /* options */)
+	options := ed.tmpfsMountOptions()
+	glog.V(3).Infof("pod %v: mounting tmpfs for volume %v with options %v", ed.pod.UID, ed.volName, options)
+	return ed.mounter.Mount("tmpfs", dir, "tmpfs", options)
+}
+
+// tmpfsMountOptions returns the mount options for the volume's tmpfs. If
+// every container in the pod declares a memory limit, the tmpfs is sized to
+// their sum so that the volume cannot consume more memory than the pod's
+// cgroup allows. Otherwise the kernel default size is used.
+func (ed *emptyDir) tmpfsMountOptions() []string {
+	memoryLimit := int64(0)
+	for _, container := range ed.pod.Spec.Containers {
+		limit := container.Resources.Limits.Memory()
+		if limit.IsZero() {
+			return nil
+		}
+		memoryLimit += limit.Value()
+	}
+	if memoryLimit == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("size=%d", memoryLimit)}
 }
 
 // setupDir creates the directory with the specified SELinux context and
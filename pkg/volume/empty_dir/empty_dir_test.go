@@ -19,11 +19,14 @@ limitations under the License.
 package empty_dir
 
 import (
+	"fmt"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util/mount"
 	utiltesting "k8s.io/kubernetes/pkg/util/testing"
@@ -272,3 +275,42 @@ func TestMetrics(t *testing.T) {
 		t.Errorf("Expected Available to be greater than 0")
 	}
 }
+
+func TestTmpfsMountOptions(t *testing.T) {
+	makeContainer := func(memoryLimit string) api.Container {
+		container := api.Container{}
+		if memoryLimit != "" {
+			container.Resources.Limits = api.ResourceList{
+				api.ResourceMemory: resource.MustParse(memoryLimit),
+			}
+		}
+		return container
+	}
+
+	testCases := map[string]struct {
+		containers []api.Container
+		expected   []string
+	}{
+		"all-containers-limited": {
+			containers: []api.Container{makeContainer("100Mi"), makeContainer("200Mi")},
+			expected:   []string{fmt.Sprintf("size=%d", 300*1024*1024)},
+		},
+		"one-container-unlimited": {
+			containers: []api.Container{makeContainer("100Mi"), makeContainer("")},
+			expected:   nil,
+		},
+		"no-containers": {
+			containers: []api.Container{},
+			expected:   nil,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		ed := &emptyDir{
+			pod: &api.Pod{Spec: api.PodSpec{Containers: testCase.containers}},
+		}
+		if actual := ed.tmpfsMountOptions(); !reflect.DeepEqual(actual, testCase.expected) {
+			t.Errorf("%v: unexpected mount options: expected %v, got %v", testName, testCase.expected, actual)
+		}
+	}
+}
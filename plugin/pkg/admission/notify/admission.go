@@ -0,0 +1,165 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/auth/user"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/util/yaml"
+)
+
+func init() {
+	admission.RegisterPlugin("NotifyPolicy", func(client clientset.Interface, config io.Reader) (admission.Interface, error) {
+		pluginConfig := readConfig(config)
+		return NewNotifyPolicy(pluginConfig.NotifyPolicyPluginConfig.Rules), nil
+	})
+}
+
+// notifyPolicy is an implementation of admission.Interface which restricts
+// which notifications a caller may deliver through the pods/notify
+// subresource.
+type notifyPolicy struct {
+	*admission.Handler
+	rules []policyRule
+}
+
+type pluginConfig struct {
+	NotifyPolicyPluginConfig policyConfig
+}
+
+type policyConfig struct {
+	Rules []policyRule
+}
+
+// policyRule allows a set of callers to deliver a set of notifications. A
+// request is admitted when at least one rule matches its namespace, its user
+// and the notification name.
+type policyRule struct {
+	// Namespaces the rule applies to. Empty or "*" matches every namespace.
+	Namespaces []string
+	// Users the rule applies to, matched against the authenticated user name.
+	Users []string
+	// Groups the rule applies to, matched against the authenticated user's
+	// groups. A rule with neither users nor groups applies to every caller.
+	Groups []string
+	// Notifications the matched callers may deliver. "*" allows every
+	// notification; raw signals are matched as "signal:<NAME>".
+	Notifications []string
+}
+
+// readConfig reads the notify policy rules from the file provided with
+// --admission-control-config-file. If the file is not supplied, no rules are
+// configured and every notification is admitted. The format in a file:
+// notifyPolicyPluginConfig:
+//  rules:
+//  - namespaces: ["*"]
+//    groups: ["system:masters"]
+//    notifications: ["*"]
+//  - namespaces: ["dev"]
+//    notifications: ["reload"]
+func readConfig(config io.Reader) *pluginConfig {
+	defaultConfig := &pluginConfig{}
+	if config == nil || reflect.ValueOf(config).IsNil() {
+		return defaultConfig
+	}
+	d := yaml.NewYAMLOrJSONDecoder(config, 4096)
+	for {
+		if err := d.Decode(defaultConfig); err != nil {
+			if err != io.EOF {
+				continue
+			}
+		}
+		break
+	}
+	return defaultConfig
+}
+
+// NewNotifyPolicy creates a new admission controller that only admits a
+// notify request when one of the given rules allows it. With no rules every
+// request is admitted.
+func NewNotifyPolicy(rules []policyRule) admission.Interface {
+	return &notifyPolicy{
+		Handler: admission.NewHandler(admission.Connect),
+		rules:   rules,
+	}
+}
+
+func (p *notifyPolicy) Admit(a admission.Attributes) (err error) {
+	connectRequest, ok := a.GetObject().(*rest.ConnectRequest)
+	if !ok {
+		return errors.NewBadRequest("a connect request was received, but could not convert the request object.")
+	}
+	if connectRequest.ResourcePath != "pods/notify" {
+		return nil
+	}
+	if len(p.rules) == 0 {
+		return nil
+	}
+	opts, ok := connectRequest.Options.(*api.PodNotifyOptions)
+	if !ok {
+		return errors.NewBadRequest("a notify request was received, but could not convert the request options.")
+	}
+	notification := opts.Message
+	if len(opts.Signal) > 0 {
+		notification = "signal:" + opts.Signal
+	}
+	for _, rule := range p.rules {
+		if rule.matches(a.GetNamespace(), a.GetUserInfo(), notification) {
+			return nil
+		}
+	}
+	return admission.NewForbidden(a, fmt.Errorf("delivering notification %q to namespace %q is not allowed by the notify policy", notification, a.GetNamespace()))
+}
+
+// matches returns true when the rule applies to the namespace and caller and
+// allows the notification.
+func (r *policyRule) matches(namespace string, caller user.Info, notification string) bool {
+	if len(r.Namespaces) > 0 && !contains(r.Namespaces, namespace) {
+		return false
+	}
+	if len(r.Users) > 0 || len(r.Groups) > 0 {
+		if caller == nil {
+			return false
+		}
+		matched := contains(r.Users, caller.GetName())
+		for _, group := range caller.GetGroups() {
+			matched = matched || contains(r.Groups, group)
+		}
+		if !matched {
+			return false
+		}
+	}
+	return contains(r.Notifications, notification)
+}
+
+// contains returns true when the list holds the value or the "*" wildcard.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == "*" || item == value {
+			return true
+		}
+	}
+	return false
+}
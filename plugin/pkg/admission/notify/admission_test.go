@@ -0,0 +1,150 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+func TestNotifyPolicy(t *testing.T) {
+	rules := []policyRule{
+		{Namespaces: []string{"*"}, Groups: []string{"system:masters"}, Notifications: []string{"*"}},
+		{Namespaces: []string{"dev"}, Notifications: []string{"reload"}},
+		{Namespaces: []string{"prod"}, Users: []string{"alice"}, Notifications: []string{"reload", "signal:SIGHUP"}},
+	}
+
+	tests := []struct {
+		name         string
+		namespace    string
+		user         user.Info
+		message      string
+		signal       string
+		shouldAccept bool
+	}{
+		{
+			name:         "admin may deliver anything anywhere",
+			namespace:    "prod",
+			user:         &user.DefaultInfo{Name: "bob", Groups: []string{"system:masters"}},
+			message:      "debug-dump",
+			shouldAccept: true,
+		},
+		{
+			name:         "anyone may reload in dev",
+			namespace:    "dev",
+			user:         &user.DefaultInfo{Name: "carol"},
+			message:      "reload",
+			shouldAccept: true,
+		},
+		{
+			name:         "debug-dump requires elevated rights",
+			namespace:    "dev",
+			user:         &user.DefaultInfo{Name: "carol"},
+			message:      "debug-dump",
+			shouldAccept: false,
+		},
+		{
+			name:         "named user may reload in prod",
+			namespace:    "prod",
+			user:         &user.DefaultInfo{Name: "alice"},
+			message:      "reload",
+			shouldAccept: true,
+		},
+		{
+			name:         "signals are matched with a prefix",
+			namespace:    "prod",
+			user:         &user.DefaultInfo{Name: "alice"},
+			signal:       "SIGHUP",
+			shouldAccept: true,
+		},
+		{
+			name:         "other users may not reload in prod",
+			namespace:    "prod",
+			user:         &user.DefaultInfo{Name: "carol"},
+			message:      "reload",
+			shouldAccept: false,
+		},
+	}
+
+	handler := NewNotifyPolicy(rules)
+	for _, test := range tests {
+		req := &rest.ConnectRequest{
+			Name:         "foo",
+			ResourcePath: "pods/notify",
+			Options:      &api.PodNotifyOptions{Message: test.message, Signal: test.signal},
+		}
+		err := handler.(*notifyPolicy).Admit(admission.NewAttributesRecord(req, nil, api.Kind("Pod").WithVersion("version"), test.namespace, "foo", api.Resource("pods").WithVersion("version"), "notify", admission.Connect, test.user))
+		if test.shouldAccept && err != nil {
+			t.Errorf("%s: unexpected error returned from admission handler: %v", test.name, err)
+		}
+		if !test.shouldAccept && err == nil {
+			t.Errorf("%s: an error was expected from the admission handler, received nil", test.name)
+		}
+	}
+}
+
+func TestNotifyPolicyIgnoresOtherSubresources(t *testing.T) {
+	handler := NewNotifyPolicy([]policyRule{{Notifications: []string{"reload"}}})
+	req := &rest.ConnectRequest{Name: "foo", ResourcePath: "pods/exec"}
+	err := handler.(*notifyPolicy).Admit(admission.NewAttributesRecord(req, nil, api.Kind("Pod").WithVersion("version"), "dev", "foo", api.Resource("pods").WithVersion("version"), "exec", admission.Connect, &user.DefaultInfo{Name: "carol"}))
+	if err != nil {
+		t.Errorf("unexpected error returned from admission handler: %v", err)
+	}
+}
+
+func TestNotifyPolicyWithoutRulesAdmitsEverything(t *testing.T) {
+	handler := NewNotifyPolicy(nil)
+	req := &rest.ConnectRequest{
+		Name:         "foo",
+		ResourcePath: "pods/notify",
+		Options:      &api.PodNotifyOptions{Message: "debug-dump"},
+	}
+	err := handler.(*notifyPolicy).Admit(admission.NewAttributesRecord(req, nil, api.Kind("Pod").WithVersion("version"), "dev", "foo", api.Resource("pods").WithVersion("version"), "notify", admission.Connect, &user.DefaultInfo{Name: "carol"}))
+	if err != nil {
+		t.Errorf("unexpected error returned from admission handler: %v", err)
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	config := bytes.NewBufferString(`notifyPolicyPluginConfig:
+ rules:
+ - namespaces: ["dev"]
+   notifications: ["reload"]
+ - groups: ["system:masters"]
+   notifications: ["*"]
+`)
+	pluginConfig := readConfig(config)
+	rules := pluginConfig.NotifyPolicyPluginConfig.Rules
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if len(rules[0].Namespaces) != 1 || rules[0].Namespaces[0] != "dev" {
+		t.Errorf("unexpected namespaces in first rule: %v", rules[0].Namespaces)
+	}
+	if len(rules[1].Groups) != 1 || rules[1].Groups[0] != "system:masters" {
+		t.Errorf("unexpected groups in second rule: %v", rules[1].Groups)
+	}
+
+	if got := readConfig(nil); len(got.NotifyPolicyPluginConfig.Rules) != 0 {
+		t.Errorf("expected no rules without a config file, got %v", got.NotifyPolicyPluginConfig.Rules)
+	}
+}
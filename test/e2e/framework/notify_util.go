@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/fields"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+// SendNotification delivers a notification to a pod through the notify
+// subresource and returns the handler result reported by the kubelet.
+func SendNotification(c clientset.Interface, namespace, podName string, opts *api.PodNotifyOptions) (*kubecontainer.NotificationResult, error) {
+	body, err := c.Core().Pods(namespace).Notify(podName, opts).DoRaw()
+	if err != nil {
+		return nil, err
+	}
+	result := &kubecontainer.NotificationResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, fmt.Errorf("error parsing notify response %q: %v", string(body), err)
+	}
+	return result, nil
+}
+
+// WaitForNotificationEvent waits until the kubelet records an event with the
+// given reason (NotificationDelivered or FailedNotification) for the pod.
+func WaitForNotificationEvent(c clientset.Interface, namespace, podName, reason string, timeout time.Duration) error {
+	return wait.Poll(Poll, timeout, func() (bool, error) {
+		selector := fields.Set{
+			"involvedObject.kind":      "Pod",
+			"involvedObject.name":      podName,
+			"involvedObject.namespace": namespace,
+			"reason":                   reason,
+		}.AsSelector()
+		options := api.ListOptions{FieldSelector: selector}
+		events, err := c.Core().Events(namespace).List(options)
+		if err != nil {
+			return false, err
+		}
+		return len(events.Items) > 0, nil
+	})
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	kubeletevents "k8s.io/kubernetes/pkg/kubelet/events"
+	"k8s.io/kubernetes/test/e2e/framework"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const notifyEventTimeout = 1 * time.Minute
+
+// notifyPod returns a pod declaring a "reload" notification whose exec
+// handler echoes a marker, and a "hang" notification that never completes.
+func notifyPod(name string) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"name": name},
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name:    "notify-target",
+					Image:   "gcr.io/google_containers/busybox:1.24",
+					Command: []string{"sh", "-c", "sleep 3600"},
+					Notifications: []api.ContainerNotification{
+						{
+							Name:    "reload",
+							Handler: api.NotificationHandler{Exec: &api.ExecAction{Command: []string{"echo", "reloaded"}}},
+						},
+						{
+							Name:    "hang",
+							Handler: api.NotificationHandler{Exec: &api.ExecAction{Command: []string{"sleep", "3600"}}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = framework.KubeDescribe("Notify", func() {
+	f := framework.NewDefaultFramework("notify")
+
+	It("should run a declared notification handler and report its output", func() {
+		podClient := f.ClientSet.Core().Pods(f.Namespace.Name)
+
+		By("creating a pod with a declared notification handler")
+		pod := notifyPod("notify-handler")
+		defer podClient.Delete(pod.Name, nil)
+		_, err := podClient.Create(pod)
+		Expect(err).NotTo(HaveOccurred())
+		framework.ExpectNoError(f.WaitForPodRunning(pod.Name))
+
+		By("delivering the notification")
+		result, err := framework.SendNotification(f.ClientSet, f.Namespace.Name, pod.Name, &api.PodNotifyOptions{
+			Container: "notify-target",
+			Message:   "reload",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Code).To(Equal(0))
+		Expect(string(result.Output)).To(ContainSubstring("reloaded"))
+
+		By("waiting for the delivery event")
+		framework.ExpectNoError(framework.WaitForNotificationEvent(f.ClientSet, f.Namespace.Name, pod.Name, kubeletevents.NotificationDelivered, notifyEventTimeout))
+	})
+
+	It("should fan out to all pods of a replication controller via kubectl", func() {
+		podClient := f.ClientSet.Core().Pods(f.Namespace.Name)
+		nsFlag := fmt.Sprintf("--namespace=%v", f.Namespace.Name)
+
+		By("creating pods behind a replication controller")
+		const replicas = 2
+		pod := notifyPod("notify-fanout")
+		rc := &api.ReplicationController{
+			ObjectMeta: api.ObjectMeta{Name: "notify-fanout"},
+			Spec: api.ReplicationControllerSpec{
+				Replicas: replicas,
+				Selector: pod.Labels,
+				Template: &api.PodTemplateSpec{
+					ObjectMeta: api.ObjectMeta{Labels: pod.Labels},
+					Spec:       pod.Spec,
+				},
+			},
+		}
+		rcClient := f.ClientSet.Core().ReplicationControllers(f.Namespace.Name)
+		defer rcClient.Delete(rc.Name, nil)
+		_, err := rcClient.Create(rc)
+		Expect(err).NotTo(HaveOccurred())
+		framework.ExpectNoError(framework.VerifyPods(f.ClientSet, f.Namespace.Name, rc.Name, false, replicas))
+
+		By("notifying the replication controller with kubectl")
+		output := framework.RunKubectlOrDie("notify", "rc/"+rc.Name, "reload", "--max-concurrent=100%", nsFlag)
+		Expect(strings.Count(output, "reloaded")).To(Equal(replicas))
+
+		By("waiting for a delivery event on every pod")
+		pods, err := podClient.List(api.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		for _, p := range pods.Items {
+			framework.ExpectNoError(framework.WaitForNotificationEvent(f.ClientSet, f.Namespace.Name, p.Name, kubeletevents.NotificationDelivered, notifyEventTimeout))
+		}
+	})
+
+	It("should reject undeclared notifications and disallowed signals", func() {
+		podClient := f.ClientSet.Core().Pods(f.Namespace.Name)
+
+		By("creating a pod with a declared notification handler")
+		pod := notifyPod("notify-reject")
+		defer podClient.Delete(pod.Name, nil)
+		_, err := podClient.Create(pod)
+		Expect(err).NotTo(HaveOccurred())
+		framework.ExpectNoError(f.WaitForPodRunning(pod.Name))
+
+		By("delivering a notification the container does not declare")
+		_, err = framework.SendNotification(f.ClientSet, f.Namespace.Name, pod.Name, &api.PodNotifyOptions{
+			Container: "notify-target",
+			Message:   "no-such-notification",
+		})
+		Expect(err).To(HaveOccurred())
+
+		By("delivering a signal the kubelet does not allow")
+		_, err = framework.SendNotification(f.ClientSet, f.Namespace.Name, pod.Name, &api.PodNotifyOptions{
+			Container: "notify-target",
+			Signal:    "SIGKILL",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail when the handler misses its deadline", func() {
+		podClient := f.ClientSet.Core().Pods(f.Namespace.Name)
+
+		By("creating a pod with a hanging notification handler")
+		pod := notifyPod("notify-timeout")
+		defer podClient.Delete(pod.Name, nil)
+		_, err := podClient.Create(pod)
+		Expect(err).NotTo(HaveOccurred())
+		framework.ExpectNoError(f.WaitForPodRunning(pod.Name))
+
+		By("delivering the notification with a short deadline")
+		_, err = framework.SendNotification(f.ClientSet, f.Namespace.Name, pod.Name, &api.PodNotifyOptions{
+			Container:      "notify-target",
+			Message:        "hang",
+			TimeoutSeconds: 2,
+		})
+		Expect(err).To(HaveOccurred())
+
+		By("waiting for the failure event")
+		framework.ExpectNoError(framework.WaitForNotificationEvent(f.ClientSet, f.Namespace.Name, pod.Name, kubeletevents.FailedNotification, notifyEventTimeout))
+	})
+})
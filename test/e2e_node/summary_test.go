@@ -125,11 +125,17 @@ var _ = framework.KubeDescribe("Summary API", func() {
 					}),
 				}),
 				"Network": ptrMatchAllFields(gstruct.Fields{
-					"Time":     recent(maxStatsAge),
-					"RxBytes":  bounded(10, 10*mb),
-					"RxErrors": bounded(0, 1000),
-					"TxBytes":  bounded(10, 10*mb),
-					"TxErrors": bounded(0, 1000),
+					"Time": recent(maxStatsAge),
+					"InterfaceStats": gstruct.MatchAllFields(gstruct.Fields{
+						"Name":      Equal("eth0"),
+						"RxBytes":   bounded(10, 10*mb),
+						"RxPackets": bounded(10, 1E6),
+						"RxErrors":  bounded(0, 1000),
+						"TxBytes":   bounded(10, 10*mb),
+						"TxPackets": bounded(10, 1E6),
+						"TxErrors":  bounded(0, 1000),
+					}),
+					"Interfaces": Not(BeNil()),
 				}),
 				"VolumeStats": gstruct.MatchAllElements(summaryObjectID, gstruct.Elements{
 					"test-empty-dir": gstruct.MatchAllFields(gstruct.Fields{
@@ -169,11 +175,17 @@ var _ = framework.KubeDescribe("Summary API", func() {
 					}),
 					// TODO(#28407): Handle non-eth0 network interface names.
 					"Network": Or(BeNil(), ptrMatchAllFields(gstruct.Fields{
-						"Time":     recent(maxStatsAge),
-						"RxBytes":  bounded(1*mb, 100*gb),
-						"RxErrors": bounded(0, 100000),
-						"TxBytes":  bounded(10*kb, 10*gb),
-						"TxErrors": bounded(0, 100000),
+						"Time": recent(maxStatsAge),
+						"InterfaceStats": gstruct.MatchAllFields(gstruct.Fields{
+							"Name":      Equal("eth0"),
+							"RxBytes":   bounded(1*mb, 100*gb),
+							"RxPackets": bounded(1000, 1E12),
+							"RxErrors":  bounded(0, 100000),
+							"TxBytes":   bounded(10*kb, 10*gb),
+							"TxPackets": bounded(1000, 1E12),
+							"TxErrors":  bounded(0, 100000),
+						}),
+						"Interfaces": Not(BeNil()),
 					})),
 					"Fs": ptrMatchAllFields(gstruct.Fields{
 						"AvailableBytes": fsCapacityBounds,